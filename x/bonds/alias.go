@@ -7,13 +7,34 @@ import (
 
 //noinspection GoUnusedConst
 const (
-	QueryBonds          = keeper.QueryBonds
-	QueryBond           = keeper.QueryBond
-	QueryCurrentPrice   = keeper.QueryCurrentPrice
-	QueryCurrentReserve = keeper.QueryCurrentReserve
-	QueryCustomPrice    = keeper.QueryCustomPrice
-	QueryBuyPrice       = keeper.QueryBuyPrice
-	QuerySellReturn     = keeper.QuerySellReturn
+	QueryBonds               = keeper.QueryBonds
+	QueryBondsDetailed       = keeper.QueryBondsDetailed
+	QueryBondsByCreator      = keeper.QueryBondsByCreator
+	QueryBondsBySigner       = keeper.QueryBondsBySigner
+	QueryBondsByReserveDenom = keeper.QueryBondsByReserveDenom
+	QueryBond                = keeper.QueryBond
+	QueryOrderBook           = keeper.QueryOrderBook
+	QueryOrdersByAddress     = keeper.QueryOrdersByAddress
+	QueryAccountPositions    = keeper.QueryAccountPositions
+	QueryCurrentPrice        = keeper.QueryCurrentPrice
+	QueryCurrentReserve      = keeper.QueryCurrentReserve
+	QueryReserveSurplus      = keeper.QueryReserveSurplus
+	QueryClaimableRewards    = keeper.QueryClaimableRewards
+	QueryTap                 = keeper.QueryTap
+	QueryReserveStaking      = keeper.QueryReserveStaking
+	QueryRoundingSurplus     = keeper.QueryRoundingSurplus
+	QueryReferrals           = keeper.QueryReferrals
+	QueryBondStats           = keeper.QueryBondStats
+	QueryCustomPrice         = keeper.QueryCustomPrice
+	QueryPriceCurve          = keeper.QueryPriceCurve
+	QueryCandles             = keeper.QueryCandles
+	QueryTwap                = keeper.QueryTwap
+	QueryPriceObservations   = keeper.QueryPriceObservations
+	QueryBuyPrice            = keeper.QueryBuyPrice
+	QuerySellReturn          = keeper.QuerySellReturn
+	QueryQuoteBuy            = keeper.QueryQuoteBuy
+	QueryQuoteSell           = keeper.QueryQuoteSell
+	QueryParams              = keeper.QueryParams
 
 	DefaultCodeSpace = types.DefaultCodespace
 
@@ -42,28 +63,80 @@ const (
 	CodeOrderQuantityLimitExceeded           = types.CodeOrderLimitExceeded
 	CodeSanityRateViolated                   = types.CodeSanityRateViolated
 	CodeFeeTooLarge                          = types.CodeFeeTooLarge
+	CodeBatchBlocksOutOfRange                = types.CodeBatchBlocksOutOfRange
+	CodeMaxOrdersReached                     = types.CodeMaxOrdersReached
+	CodeBondCannotBeClosed                   = types.CodeBondCannotBeClosed
+	CodeBondPaused                           = types.CodeBondPaused
+	CodeBondHalted                           = types.CodeBondHalted
+	CodeMaxPriceMoveExceeded                 = types.CodeMaxPriceMoveExceeded
+	CodeInvalidRole                          = types.CodeInvalidRole
+	CodeSignersNotAuthorized                 = types.CodeSignersNotAuthorized
+	CodeInvalidFeeRecipients                 = types.CodeInvalidFeeRecipients
+	CodeNoRewardsToClaim                     = types.CodeNoRewardsToClaim
+	CodeInvalidFeeSchedule                   = types.CodeInvalidFeeSchedule
+	CodeInvalidTap                           = types.CodeInvalidTap
+	CodeNoTapFundsToDraw                     = types.CodeNoTapFundsToDraw
+	CodeBondDissolved                        = types.CodeBondDissolved
+	CodeBondNotDissolved                     = types.CodeBondNotDissolved
+	CodeNoTapRateProposed                    = types.CodeNoTapRateProposed
+	CodeInvalidReserveStaking                = types.CodeInvalidReserveStaking
+	CodeStakingCapExceeded                   = types.CodeStakingCapExceeded
+	CodeInsufficientLiquidReserve            = types.CodeInsufficientLiquidReserve
+	CodeNoRoundingSurplusToSweep             = types.CodeNoRoundingSurplusToSweep
+	CodeInvalidReferrer                      = types.CodeInvalidReferrer
+	CodeInvalidPriceCurve                    = types.CodeInvalidPriceCurve
+	CodeInvalidTwapWindow                    = types.CodeInvalidTwapWindow
+	CodeNoPriceObservation                   = types.CodeNoPriceObservation
+	CodeInvalidParams                        = types.CodeInvalidParams
+	CodeNoBondTokensToVoteWith               = types.CodeNoBondTokensToVoteWith
+	CodeSwapQuoteUnavailable                 = types.CodeSwapQuoteUnavailable
 
 	BondsMintBurnAccount       = types.BondsMintBurnAccount
 	BatchesIntermediaryAccount = types.BatchesIntermediaryAccount
+	BondsDepositAccount        = types.BondsDepositAccount
+	BondsRewardsAccount        = types.BondsRewardsAccount
+	BondsReserveAccount        = types.BondsReserveAccount
 
-	ModuleName   = types.ModuleName
-	StoreKey     = types.StoreKey
-	QuerierRoute = types.QuerierRoute
-	RouterKey    = types.RouterKey
+	ModuleName        = types.ModuleName
+	StoreKey          = types.StoreKey
+	DefaultParamspace = types.DefaultParamspace
+	QuerierRoute      = types.QuerierRoute
+
+	RoleAdmin          = types.RoleAdmin
+	RoleMetadataEditor = types.RoleMetadataEditor
+	RoleFeeManager     = types.RoleFeeManager
+	RolePauser         = types.RolePauser
+	RoleWithdrawer     = types.RoleWithdrawer
+	RoleStaker         = types.RoleStaker
+	RouterKey          = types.RouterKey
+
+	MinVolatilityBatches = types.MinVolatilityBatches
+	MaxVolatilityBatches = types.MaxVolatilityBatches
+	MaxPriceCurveSamples = types.MaxPriceCurveSamples
+
+	DefaultBondsDetailedLimit = types.DefaultBondsDetailedLimit
+	MaxBondsDetailedLimit     = types.MaxBondsDetailedLimit
+
+	TapVoteRaise    = types.TapVoteRaise
+	TapVoteDissolve = types.TapVoteDissolve
 )
 
 //noinspection GoUnusedGlobalVariable,GoNameStartsWithPackageName
 var (
 	// function aliases
-	RegisterInvariants = keeper.RegisterInvariants
-	AllInvariants      = keeper.AllInvariants
-	SupplyInvariant    = keeper.SupplyInvariant
-	NewKeeper          = keeper.NewKeeper
-	NewQuerier         = keeper.NewQuerier
-	RegisterCodec      = types.RegisterCodec
+	RegisterInvariants       = keeper.RegisterInvariants
+	AllInvariants            = keeper.AllInvariants
+	SupplyInvariant          = keeper.SupplyInvariant
+	RoundingSurplusInvariant = keeper.RoundingSurplusInvariant
+	BondIndexesInvariant     = keeper.BondIndexesInvariant
+	NewKeeper                = keeper.NewKeeper
+	NewBankKeeperWithHooks   = keeper.NewBankKeeperWithHooks
+	NewQuerier               = keeper.NewQuerier
+	RegisterCodec            = types.RegisterCodec
 
 	ErrArgumentCannotBeEmpty                = types.ErrArgumentCannotBeEmpty
 	ErrArgumentCannotBeNegative             = types.ErrArgumentCannotBeNegative
+	ErrArgumentExceedsMaximum               = types.ErrArgumentExceedsMaximum
 	ErrFunctionParameterMissingOrNonInteger = types.ErrFunctionParameterMissingOrNonInteger
 	ErrArgumentMissingOrNonFloat            = types.ErrArgumentMissingOrNonFloat
 	ErrArgumentMissingOrNonInteger          = types.ErrArgumentMissingOrNonInteger
@@ -94,61 +167,254 @@ var (
 	ErrOrderQuantityLimitExceeded           = types.ErrOrderQuantityLimitExceeded
 	ErrValuesViolateSanityRate              = types.ErrValuesViolateSanityRate
 	ErrFeesCannotBeOrExceed100Percent       = types.ErrFeesCannotBeOrExceed100Percent
+	ErrBondTokenIsDenylisted                = types.ErrBondTokenIsDenylisted
+	ErrReserveTokenIsDenylisted             = types.ErrReserveTokenIsDenylisted
+	ErrFeeExceedsMaxFee                     = types.ErrFeeExceedsMaxFee
+	ErrBatchBlocksOutOfRange                = types.ErrBatchBlocksOutOfRange
+	ErrMaxOrdersPerBatchReached             = types.ErrMaxOrdersPerBatchReached
+	ErrBondHasNonZeroSupply                 = types.ErrBondHasNonZeroSupply
+	ErrBondHasPendingOrders                 = types.ErrBondHasPendingOrders
+	ErrBondHasStakedReserve                 = types.ErrBondHasStakedReserve
+	ErrBondHasQueuedSells                   = types.ErrBondHasQueuedSells
+	ErrBondIsPaused                         = types.ErrBondIsPaused
+	ErrBondIsHalted                         = types.ErrBondIsHalted
+	ErrMaxPriceMoveExceeded                 = types.ErrMaxPriceMoveExceeded
+	ErrUnrecognizedCircuitBreakerMode       = types.ErrUnrecognizedCircuitBreakerMode
+	ErrUnrecognizedRole                     = types.ErrUnrecognizedRole
+	ErrDuplicateRoleAddress                 = types.ErrDuplicateRoleAddress
+	ErrInvalidRoleThreshold                 = types.ErrInvalidRoleThreshold
+	ErrSignersNotAuthorizedForRole          = types.ErrSignersNotAuthorizedForRole
+	ErrDuplicateFeeRecipient                = types.ErrDuplicateFeeRecipient
+	ErrFeeRecipientSharesDoNotSumTo100      = types.ErrFeeRecipientSharesDoNotSumTo100
+	ErrFeeAddressNotAFeeRecipient           = types.ErrFeeAddressNotAFeeRecipient
+	ErrNoRewardsToClaim                     = types.ErrNoRewardsToClaim
+	ErrFeeTierThresholdsNotAscending        = types.ErrFeeTierThresholdsNotAscending
+	ErrVolatilityBatchesOutOfRange          = types.ErrVolatilityBatchesOutOfRange
+	ErrTapFloorPercentageOutOfRange         = types.ErrTapFloorPercentageOutOfRange
+	ErrTapFloorCannotBeLowered              = types.ErrTapFloorCannotBeLowered
+	ErrBondDoesNotHaveTap                   = types.ErrBondDoesNotHaveTap
+	ErrNoTapFundsToDraw                     = types.ErrNoTapFundsToDraw
+	ErrNoTapRateProposed                    = types.ErrNoTapRateProposed
+	ErrUnrecognizedTapVoteOption            = types.ErrUnrecognizedTapVoteOption
+	ErrBondIsDissolved                      = types.ErrBondIsDissolved
+	ErrBondIsNotDissolved                   = types.ErrBondIsNotDissolved
+	ErrStakingCapPercentageOutOfRange       = types.ErrStakingCapPercentageOutOfRange
+	ErrReserveIsNotStakingDenom             = types.ErrReserveIsNotStakingDenom
+	ErrBondDoesNotHaveReserveStaking        = types.ErrBondDoesNotHaveReserveStaking
+	ErrStakingCapExceeded                   = types.ErrStakingCapExceeded
+	ErrInsufficientLiquidReserve            = types.ErrInsufficientLiquidReserve
+	ErrNoRoundingSurplusToSweep             = types.ErrNoRoundingSurplusToSweep
+	ErrReferrerIsBuyer                      = types.ErrReferrerIsBuyer
+	ErrInvalidPriceCurveRange               = types.ErrInvalidPriceCurveRange
+	ErrInvalidTwapWindow                    = types.ErrInvalidTwapWindow
+	ErrNoPriceObservation                   = types.ErrNoPriceObservation
+	ErrPriceCurveSamplesOutOfRange          = types.ErrPriceCurveSamplesOutOfRange
+	ErrInvalidParams                        = types.ErrInvalidParams
+	ErrNoBondTokensToVoteWith               = types.ErrNoBondTokensToVoteWith
+	ErrSwapReserveIsEmpty                   = types.ErrSwapReserveIsEmpty
+	ErrSwapSpotRateIsZero                   = types.ErrSwapSpotRateIsZero
 
 	NewGenesisState     = types.NewGenesisState
 	DefaultGenesisState = types.DefaultGenesisState
 	ValidateGenesis     = types.ValidateGenesis
 
-	SquareRootDec       = types.SquareRootDec
-	SquareRootInt       = types.SquareRootInt
-	RoundReservePrice   = types.RoundReservePrice
-	RoundReserveReturn  = types.RoundReserveReturn
-	RoundFee            = types.RoundFee
-	RoundReservePrices  = types.RoundReservePrices
-	RoundReserveReturns = types.RoundReserveReturns
-
-	NewFunctionParam = types.NewFunctionParam
-	NewBond          = types.NewBond
-	NewBatch         = types.NewBatch
-	NewBaseOrder     = types.NewBaseOrder
-	NewBuyOrder      = types.NewBuyOrder
-	NewSellOrder     = types.NewSellOrder
-	NewSwapOrder     = types.NewSwapOrder
-	NewMsgCreateBond = types.NewMsgCreateBond
-	NewMsgEditBond   = types.NewMsgEditBond
-	NewMsgBuy        = types.NewMsgBuy
-	NewMsgSell       = types.NewMsgSell
-	NewMsgSwap       = types.NewMsgSwap
+	NewParams     = types.NewParams
+	DefaultParams = types.DefaultParams
+	ParamKeyTable = types.ParamKeyTable
+
+	SquareRootDec              = types.SquareRootDec
+	SquareRootInt              = types.SquareRootInt
+	RoundReservePrice          = types.RoundReservePrice
+	RoundReserveReturn         = types.RoundReserveReturn
+	RoundFee                   = types.RoundFee
+	RoundReservePrices         = types.RoundReservePrices
+	RoundReserveReturns        = types.RoundReserveReturns
+	GetPriceMovePercentage     = types.GetPriceMovePercentage
+	GetReserveAddress          = types.GetReserveAddress
+	GetHolderRewardsKey        = types.GetHolderRewardsKey
+	GetHolderLotsKey           = types.GetHolderLotsKey
+	GetTapVotesKey             = types.GetTapVotesKey
+	GetReferrerTotalsKey       = types.GetReferrerTotalsKey
+	GetCreatorIndexKey         = types.GetCreatorIndexKey
+	GetSignerIndexKey          = types.GetSignerIndexKey
+	GetReserveDenomIndexKey    = types.GetReserveDenomIndexKey
+	GetOrderAddressIndexKey    = types.GetOrderAddressIndexKey
+	GetBondIndexKeys           = types.GetBondIndexKeys
+	GetPriceCurveSupplies      = types.GetPriceCurveSupplies
+	GetCandlesPrefix           = types.GetCandlesPrefix
+	GetCandleKey               = types.GetCandleKey
+	GetCandleStartHeight       = types.GetCandleStartHeight
+	RollUpCandles              = types.RollUpCandles
+	GetPriceObservationsPrefix = types.GetPriceObservationsPrefix
+	GetPriceObservationKey     = types.GetPriceObservationKey
+	GetBondStatsKey            = types.GetBondStatsKey
+	GetBondBuyersPrefix        = types.GetBondBuyersPrefix
+	GetBondBuyerKey            = types.GetBondBuyerKey
+	GetStakedReserveIndexKey   = types.GetStakedReserveIndexKey
+
+	NewFunctionParam            = types.NewFunctionParam
+	NewBond                     = types.NewBond
+	NewBatch                    = types.NewBatch
+	NewOrderBook                = types.NewOrderBook
+	NewBondRole                 = types.NewBondRole
+	NewDefaultBondRoles         = types.NewDefaultBondRoles
+	IsValidRole                 = types.IsValidRole
+	NewFeeRecipient             = types.NewFeeRecipient
+	NewDefaultFeeRecipients     = types.NewDefaultFeeRecipients
+	NewFeePayout                = types.NewFeePayout
+	NewFeeTier                  = types.NewFeeTier
+	NewFeeSchedule              = types.NewFeeSchedule
+	NewDefaultFeeSchedule       = types.NewDefaultFeeSchedule
+	NewHolderRewards            = types.NewHolderRewards
+	NewLot                      = types.NewLot
+	NewHolderLots               = types.NewHolderLots
+	NewTap                      = types.NewTap
+	NewTapVote                  = types.NewTapVote
+	IsValidTapVoteOption        = types.IsValidTapVoteOption
+	NewReferrerTotal            = types.NewReferrerTotal
+	NewQueryBondsDetailedParams = types.NewQueryBondsDetailedParams
+	NewQueryCandlesParams       = types.NewQueryCandlesParams
+	NewBatchCandle              = types.NewBatchCandle
+	NewPriceObservation         = types.NewPriceObservation
+	NewBondStats                = types.NewBondStats
+	NewBondBuyer                = types.NewBondBuyer
+	NewTwap                     = types.NewTwap
+	NewPriceImpacts             = types.NewPriceImpacts
+	NewQueuedSell               = types.NewQueuedSell
+	NewReserveStaking           = types.NewReserveStaking
+	NewReserveDelegation        = types.NewReserveDelegation
+	NewBaseOrder                = types.NewBaseOrder
+	NewBuyOrder                 = types.NewBuyOrder
+	NewSellOrder                = types.NewSellOrder
+	NewSwapOrder                = types.NewSwapOrder
+	NewMsgCreateBond            = types.NewMsgCreateBond
+	NewMsgEditBond              = types.NewMsgEditBond
+	NewMsgCloseBond             = types.NewMsgCloseBond
+	NewMsgSetBondPaused         = types.NewMsgSetBondPaused
+	NewMsgSetCircuitBreaker     = types.NewMsgSetCircuitBreaker
+	NewMsgUpdateBondRole        = types.NewMsgUpdateBondRole
+	NewMsgSetFeeRecipients      = types.NewMsgSetFeeRecipients
+	NewMsgSetFeeSchedule        = types.NewMsgSetFeeSchedule
+	NewMsgClaimBondRewards      = types.NewMsgClaimBondRewards
+	NewMsgSetTap                = types.NewMsgSetTap
+	NewMsgWithdrawTap           = types.NewMsgWithdrawTap
+	NewMsgVoteTap               = types.NewMsgVoteTap
+	NewMsgRefund                = types.NewMsgRefund
+	NewMsgSetReserveStaking     = types.NewMsgSetReserveStaking
+	NewMsgDelegateReserve       = types.NewMsgDelegateReserve
+	NewMsgUndelegateReserve     = types.NewMsgUndelegateReserve
+	NewMsgSweepRoundingSurplus  = types.NewMsgSweepRoundingSurplus
+	NewMsgBuy                   = types.NewMsgBuy
+	NewMsgSell                  = types.NewMsgSell
+	NewMsgSwap                  = types.NewMsgSwap
 
 	// variable aliases
-	ModuleCdc            = types.ModuleCdc
-	BondsKeyPrefix       = types.BondsKeyPrefix
-	BatchesKeyPrefix     = types.BatchesKeyPrefix
-	LastBatchesKeyPrefix = types.LastBatchesKeyPrefix
+	ModuleCdc                   = types.ModuleCdc
+	BondsKeyPrefix              = types.BondsKeyPrefix
+	BatchesKeyPrefix            = types.BatchesKeyPrefix
+	LastBatchesKeyPrefix        = types.LastBatchesKeyPrefix
+	HolderRewardsKeyPrefix      = types.HolderRewardsKeyPrefix
+	HolderLotsKeyPrefix         = types.HolderLotsKeyPrefix
+	TapVotesKeyPrefix           = types.TapVotesKeyPrefix
+	ReferrerTotalsKeyPrefix     = types.ReferrerTotalsKeyPrefix
+	CreatorIndexKeyPrefix       = types.CreatorIndexKeyPrefix
+	SignerIndexKeyPrefix        = types.SignerIndexKeyPrefix
+	ReserveDenomIndexKeyPrefix  = types.ReserveDenomIndexKeyPrefix
+	CandlesKeyPrefix            = types.CandlesKeyPrefix
+	PriceObservationsKeyPrefix  = types.PriceObservationsKeyPrefix
+	BondStatsKeyPrefix          = types.BondStatsKeyPrefix
+	BondBuyersKeyPrefix         = types.BondBuyersKeyPrefix
+	OrderAddressIndexKeyPrefix  = types.OrderAddressIndexKeyPrefix
+	StakedReserveIndexKeyPrefix = types.StakedReserveIndexKeyPrefix
+	AllRoles                    = types.AllRoles
 )
 
 type (
-	Keeper       = keeper.Keeper
-	CodeType     = types.CodeType
-	GenesisState = types.GenesisState
-
-	MsgCreateBond = types.MsgCreateBond
-	MsgEditBond   = types.MsgEditBond
-	MsgBuy        = types.MsgBuy
-	MsgSell       = types.MsgSell
-	MsgSwap       = types.MsgSwap
-
-	FunctionParam  = types.FunctionParam
-	FunctionParams = types.FunctionParams
-	Bond           = types.Bond
-	Batch          = types.Batch
-	Order          = types.BaseOrder
-	BuyOrder       = types.BuyOrder
-	SellOrder      = types.SellOrder
-	SwapOrder      = types.SwapOrder
-
-	QueryResBonds      = types.QueryBonds
-	QueryResBuyPrice   = types.QueryBuyPrice
-	QueryResSellReturn = types.QuerySellReturn
-	QueryResSwapReturn = types.QuerySwapReturn
+	Keeper              = keeper.Keeper
+	Hooks               = keeper.Hooks
+	BankKeeperWithHooks = keeper.BankKeeperWithHooks
+	BankHooks           = types.BankHooks
+	CodeType            = types.CodeType
+	GenesisState        = types.GenesisState
+	Params              = types.Params
+
+	MsgCreateBond           = types.MsgCreateBond
+	MsgEditBond             = types.MsgEditBond
+	MsgCloseBond            = types.MsgCloseBond
+	MsgSetBondPaused        = types.MsgSetBondPaused
+	MsgSetCircuitBreaker    = types.MsgSetCircuitBreaker
+	MsgUpdateBondRole       = types.MsgUpdateBondRole
+	MsgSetFeeRecipients     = types.MsgSetFeeRecipients
+	MsgSetFeeSchedule       = types.MsgSetFeeSchedule
+	MsgClaimBondRewards     = types.MsgClaimBondRewards
+	MsgSetTap               = types.MsgSetTap
+	MsgWithdrawTap          = types.MsgWithdrawTap
+	MsgVoteTap              = types.MsgVoteTap
+	MsgRefund               = types.MsgRefund
+	MsgSetReserveStaking    = types.MsgSetReserveStaking
+	MsgDelegateReserve      = types.MsgDelegateReserve
+	MsgUndelegateReserve    = types.MsgUndelegateReserve
+	MsgSweepRoundingSurplus = types.MsgSweepRoundingSurplus
+	MsgBuy                  = types.MsgBuy
+	MsgSell                 = types.MsgSell
+	MsgSwap                 = types.MsgSwap
+
+	FunctionParam     = types.FunctionParam
+	FunctionParams    = types.FunctionParams
+	Bond              = types.Bond
+	Batch             = types.Batch
+	BondRole          = types.BondRole
+	BondRoles         = types.BondRoles
+	FeeRecipient      = types.FeeRecipient
+	FeeRecipients     = types.FeeRecipients
+	FeePayout         = types.FeePayout
+	FeeTier           = types.FeeTier
+	FeeTiers          = types.FeeTiers
+	FeeSchedule       = types.FeeSchedule
+	HolderRewards     = types.HolderRewards
+	Lot               = types.Lot
+	HolderLots        = types.HolderLots
+	Tap               = types.Tap
+	TapVote           = types.TapVote
+	ReferrerTotal     = types.ReferrerTotal
+	Candle            = types.Candle
+	PriceObservation  = types.PriceObservation
+	BondStats         = types.BondStats
+	BondBuyer         = types.BondBuyer
+	Twap              = types.Twap
+	PriceImpact       = types.PriceImpact
+	QueuedSell        = types.QueuedSell
+	ReserveStaking    = types.ReserveStaking
+	ReserveDelegation = types.ReserveDelegation
+	Order             = types.BaseOrder
+	BuyOrder          = types.BuyOrder
+	SellOrder         = types.SellOrder
+	SwapOrder         = types.SwapOrder
+	BatchOrders       = types.BatchOrders
+	AddressOrders     = types.AddressOrders
+	BuyDepthLevel     = types.BuyDepthLevel
+	SwapDepth         = types.SwapDepth
+	OrderBook         = types.OrderBook
+
+	QueryResBonds             = types.QueryBonds
+	QueryBondsDetailedParams  = types.QueryBondsDetailedParams
+	BondDetails               = types.BondDetails
+	QueryResBondsDetailed     = types.QueryBondsDetailed
+	QueryResBuyPrice          = types.QueryBuyPrice
+	QueryResSellReturn        = types.QuerySellReturn
+	QueryResSwapReturn        = types.QuerySwapReturn
+	QueryResBuyQuote          = types.QueryBuyQuote
+	QueryResSellQuote         = types.QuerySellQuote
+	QueryResTap               = types.QueryTap
+	QueryResReserveStaking    = types.QueryReserveStaking
+	QueryResRoundingSurplus   = types.QueryRoundingSurplus
+	QueryResReferrals         = types.QueryReferrals
+	PriceCurvePoint           = types.PriceCurvePoint
+	QueryResPriceCurve        = types.QueryPriceCurve
+	QueryCandlesParams        = types.QueryCandlesParams
+	QueryResCandles           = types.QueryCandles
+	QueryResPriceObservations = types.QueryPriceObservations
+	QueryResOrdersByAddress   = types.QueryOrdersByAddress
+	AccountPosition           = types.AccountPosition
+	QueryResAccountPositions  = types.QueryAccountPositions
 )
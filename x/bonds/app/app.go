@@ -68,6 +68,8 @@ var (
 		gov.ModuleName:                   {supply.Burner},
 		types.BondsMintBurnAccount:       {supply.Minter, supply.Burner},
 		types.BatchesIntermediaryAccount: nil,
+		types.BondsDepositAccount:        nil,
+		types.BondsRewardsAccount:        nil,
 	}
 )
 
@@ -146,6 +148,7 @@ func NewSimApp(
 	slashingSubspace := app.ParamsKeeper.Subspace(slashing.DefaultParamspace)
 	govSubspace := app.ParamsKeeper.Subspace(gov.DefaultParamspace).WithKeyTable(gov.ParamKeyTable())
 	crisisSubspace := app.ParamsKeeper.Subspace(crisis.DefaultParamspace)
+	bondsSubspace := app.ParamsKeeper.Subspace(bonds.DefaultParamspace)
 
 	// The AccountKeeper handles address -> account lookups
 	app.AccountKeeper = auth.NewAccountKeeper(
@@ -156,12 +159,15 @@ func NewSimApp(
 	)
 
 	// The BankKeeper allows you perform sdk.Coins interactions
-	app.BankKeeper = bank.NewBaseKeeper(
+	// NOTE: BankKeeper is wrapped so that bonds hooks are called before balance
+	// changes; it is passed by reference so that the hooks can be set later
+	bankKeeper := bonds.NewBankKeeperWithHooks(bank.NewBaseKeeper(
 		app.AccountKeeper,
 		bankSupspace,
 		bank.DefaultCodespace,
 		app.ModuleAccountAddrs(),
-	)
+	))
+	app.BankKeeper = bankKeeper
 
 	// The SupplyKeeper collects transaction fees and renders them to the fee distribution module
 	app.SupplyKeeper = supply.NewKeeper(
@@ -220,14 +226,20 @@ func NewSimApp(
 		app.SupplyKeeper,
 		app.AccountKeeper,
 		app.StakingKeeper,
+		app.DistrKeeper,
 		keys[bonds.StoreKey],
+		bondsSubspace,
 		app.cdc,
 	)
 
+	// register the bank hooks
+	bankKeeper.SetHooks(app.BondsKeeper.Hooks())
+
 	// register the proposal types
 	govRouter := gov.NewRouter()
 	govRouter.AddRoute(gov.RouterKey, gov.ProposalHandler).
-		AddRoute(params.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
+		AddRoute(params.RouterKey, bonds.NewParamChangeProposalHandler(app.BondsKeeper,
+			params.NewParamChangeProposalHandler(app.ParamsKeeper))).
 		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper))
 	app.GovKeeper = gov.NewKeeper(app.cdc, keys[gov.StoreKey], govSubspace,
 		app.SupplyKeeper, &stakingKeeper, gov.DefaultCodespace, govRouter)
@@ -335,6 +347,10 @@ func (app *SimApp) Codec() *codec.Codec {
 	return app.cdc
 }
 
+func (app *SimApp) GetKey(storeKey string) *sdk.KVStoreKey {
+	return app.keys[storeKey]
+}
+
 //_________________________________________________________
 
 //noinspection GoUnusedParameter
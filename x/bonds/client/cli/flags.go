@@ -6,34 +6,76 @@ import (
 )
 
 const (
-	FlagToken                  = "token"
-	FlagName                   = "name"
-	FlagDescription            = "description"
-	FlagFunctionType           = "function-type"
-	FlagFunctionParameters     = "function-parameters"
-	FlagReserveTokens          = "reserve-tokens"
-	FlagTxFeePercentage        = "tx-fee-percentage"
-	FlagExitFeePercentage      = "exit-fee-percentage"
-	FlagFeeAddress             = "fee-address"
-	FlagMaxSupply              = "max-supply"
-	FlagOrderQuantityLimits    = "order-quantity-limits"
-	FlagSanityRate             = "sanity-rate"
-	FlagSanityMarginPercentage = "sanity-margin-percentage"
-	FlagAllowSells             = "allow-sells"
-	FlagSigners                = "signers"
-	FlagBatchBlocks            = "batch-blocks"
+	FlagToken                   = "token"
+	FlagName                    = "name"
+	FlagDescription             = "description"
+	FlagFunctionType            = "function-type"
+	FlagFunctionParameters      = "function-parameters"
+	FlagReserveTokens           = "reserve-tokens"
+	FlagTxFeePercentage         = "tx-fee-percentage"
+	FlagExitFeePercentage       = "exit-fee-percentage"
+	FlagFeeAddress              = "fee-address"
+	FlagMaxSupply               = "max-supply"
+	FlagOrderQuantityLimits     = "order-quantity-limits"
+	FlagSanityRate              = "sanity-rate"
+	FlagSanityMarginPercentage  = "sanity-margin-percentage"
+	FlagAllowSells              = "allow-sells"
+	FlagSigners                 = "signers"
+	FlagBatchBlocks             = "batch-blocks"
+	FlagPaused                  = "paused"
+	FlagMaxPriceMovePercentage  = "max-price-move-percentage"
+	FlagCircuitBreakerMode      = "circuit-breaker-mode"
+	FlagCircuitBreakerCooldown  = "circuit-breaker-cooldown"
+	FlagRole                    = "role"
+	FlagAddresses               = "addresses"
+	FlagThreshold               = "threshold"
+	FlagFeeRecipients           = "fee-recipients"
+	FlagHolderRewardsPercentage = "holder-rewards-percentage"
+	FlagLiquidityFeePercentage  = "liquidity-fee-percentage"
+	FlagReferralFeePercentage   = "referral-fee-percentage"
+	FlagMinExitFeePercentage    = "min-exit-fee-percentage"
+	FlagExitFeeHoldingPeriod    = "exit-fee-holding-period"
+	FlagSizeTiers               = "size-tiers"
+	FlagVolatilityTiers         = "volatility-tiers"
+	FlagVolatilityBatches       = "volatility-batches"
+	FlagBeneficiary             = "beneficiary"
+	FlagTapRate                 = "tap-rate"
+	FlagTapFloorPercentage      = "tap-floor-percentage"
+	FlagStakingCapPercentage    = "staking-cap-percentage"
+	FlagRewardsToReserve        = "rewards-to-reserve"
+	FlagValidator               = "validator"
+	FlagAmount                  = "amount"
+	FlagReferrer                = "referrer"
+	FlagOffset                  = "offset"
+	FlagLimit                   = "limit"
+	FlagReserveToken            = "reserve-token"
+	FlagCreator                 = "creator"
+	FlagDissolved               = "dissolved"
+	FlagCSV                     = "csv"
+	FlagIntervalBlocks          = "interval-blocks"
 )
 
 var (
-	fsBondGeneral = flag.NewFlagSet("", flag.ContinueOnError)
-	fsBondCreate  = flag.NewFlagSet("", flag.ContinueOnError)
-	fsBondEdit    = flag.NewFlagSet("", flag.ContinueOnError)
+	fsBondGeneral     = flag.NewFlagSet("", flag.ContinueOnError)
+	fsBondCreate      = flag.NewFlagSet("", flag.ContinueOnError)
+	fsBondEdit        = flag.NewFlagSet("", flag.ContinueOnError)
+	fsBondPause       = flag.NewFlagSet("", flag.ContinueOnError)
+	fsBondBreaker     = flag.NewFlagSet("", flag.ContinueOnError)
+	fsBondRole        = flag.NewFlagSet("", flag.ContinueOnError)
+	fsBondFees        = flag.NewFlagSet("", flag.ContinueOnError)
+	fsBondFeeSchedule = flag.NewFlagSet("", flag.ContinueOnError)
+	fsBondTap         = flag.NewFlagSet("", flag.ContinueOnError)
+	fsBondStaking     = flag.NewFlagSet("", flag.ContinueOnError)
+	fsBondDelegation  = flag.NewFlagSet("", flag.ContinueOnError)
+	fsBondBuy         = flag.NewFlagSet("", flag.ContinueOnError)
+	fsBondsDetailed   = flag.NewFlagSet("", flag.ContinueOnError)
+	fsCandles         = flag.NewFlagSet("", flag.ContinueOnError)
 )
 
 func init() {
 
 	fsBondGeneral.String(FlagToken, "", "The bond's token")
-	fsBondGeneral.String(FlagSigners, "", "The list of signers required to create/edit the bond")
+	fsBondGeneral.String(FlagSigners, "", "The list of signers required to create the bond, or holding the role required by the action")
 
 	fsBondCreate.String(FlagName, "", "The bond's name")
 	fsBondCreate.String(FlagDescription, "", "The bond's description")
@@ -43,6 +85,12 @@ func init() {
 	fsBondCreate.String(FlagTxFeePercentage, "", "The percentage fee charged on buys and sells")
 	fsBondCreate.String(FlagExitFeePercentage, "", "The percentage fee charged on sells")
 	fsBondCreate.String(FlagFeeAddress, "", "The address that will hold any charged fees")
+	fsBondCreate.String(FlagFeeRecipients, "", "The addresses that charged fees are split among, with percentage shares (e.g. addr1:60,addr2:40)")
+	fsBondCreate.String(FlagHolderRewardsPercentage, "0", "The percentage of charged fees paid out to token holders as rewards")
+	fsBondCreate.String(FlagLiquidityFeePercentage, "0", "For swappers, the percentage of swap fees kept in the reserve for liquidity providers")
+	fsBondCreate.String(FlagReferralFeePercentage, "0", "The percentage of the tx fee charged on referred buys that is paid to the referrer")
+	fsBondCreate.String(FlagMinExitFeePercentage, "", "The exit fee percentage charged on tokens held for the full holding period (defaults to the exit fee percentage)")
+	fsBondCreate.String(FlagExitFeeHoldingPeriod, "0", "The number of blocks over which the exit fee decreases to the min exit fee percentage")
 	fsBondCreate.String(FlagMaxSupply, "", "The maximum supply that can be achieved")
 	fsBondCreate.String(FlagOrderQuantityLimits, "", "The max number of tokens bought/sold/swapped per order")
 	fsBondCreate.String(FlagSanityRate, "", "For swappers, this is the typical t1 per t2 rate")
@@ -55,4 +103,46 @@ func init() {
 	fsBondEdit.String(FlagOrderQuantityLimits, types.DoNotModifyField, "The max number of tokens bought/sold/swapped per order")
 	fsBondEdit.String(FlagSanityRate, types.DoNotModifyField, "For swappers, this is the typical t1 per t2 rate")
 	fsBondEdit.String(FlagSanityMarginPercentage, types.DoNotModifyField, "For swappers, this is the acceptable deviation from the sanity rate")
+
+	fsBondPause.String(FlagPaused, "", "Whether or not the bond will be paused")
+
+	fsBondBreaker.String(FlagMaxPriceMovePercentage, "", "The max percentage price move per batch (0 to disable)")
+	fsBondBreaker.String(FlagCircuitBreakerMode, types.CircuitBreakerCancel, "The action taken when the max price move is exceeded (cancel/halt)")
+	fsBondBreaker.String(FlagCircuitBreakerCooldown, "0", "The number of blocks for which the bond is halted (halt mode only)")
+
+	fsBondRole.String(FlagRole, "", "The role being updated (admin/metadata_editor/fee_manager/pauser/withdrawer/staker)")
+	fsBondRole.String(FlagAddresses, "", "The list of addresses that will hold the role")
+	fsBondRole.String(FlagThreshold, "", "The number of role holders required to sign for the role")
+
+	fsBondFees.String(FlagFeeRecipients, "", "The addresses that charged fees are split among, with percentage shares (e.g. addr1:60,addr2:40)")
+	fsBondFees.String(FlagFeeAddress, "", "The fee recipient that will receive any rounding remainders")
+
+	fsBondFeeSchedule.String(FlagSizeTiers, "", "The fee multipliers applied from each order size threshold (e.g. 1000:0.75,10000:0.5)")
+	fsBondFeeSchedule.String(FlagVolatilityTiers, "", "The fee multipliers applied from each percentage volatility threshold (e.g. 5:1.5,20:2)")
+	fsBondFeeSchedule.String(FlagVolatilityBatches, "0", "The number of recent batches across which volatility is measured")
+
+	fsBondTap.String(FlagBeneficiary, "", "The address that receives the funds withdrawn through the tap")
+	fsBondTap.String(FlagTapRate, "", "The amount of each reserve token that the tap releases per block")
+	fsBondTap.String(FlagTapFloorPercentage, "0", "The percentage of the curve's reserve that the tap can never withdraw")
+
+	fsBondStaking.String(FlagStakingCapPercentage, "", "The max percentage of the bond's reserve that can be staked")
+	fsBondStaking.String(FlagRewardsToReserve, "", "Whether staking rewards are added to the reserve rather than paid to the fee address")
+
+	fsBondDelegation.String(FlagValidator, "", "The address of the validator")
+	fsBondDelegation.String(FlagAmount, "", "The amount of the bond's reserve (e.g. 100stake)")
+
+	fsBondBuy.String(FlagReferrer, "", "The address that referred the buy, which is paid a share of the tx fee (optional)")
+
+	fsBondsDetailed.String(FlagOffset, "0", "The number of matching bonds to skip")
+	fsBondsDetailed.String(FlagLimit, "0", "The max number of bonds to return (0 for the default of 100, at most 500)")
+	fsBondsDetailed.String(FlagFunctionType, "", "Only list bonds with this function type")
+	fsBondsDetailed.String(FlagReserveToken, "", "Only list bonds with this reserve token")
+	fsBondsDetailed.String(FlagCreator, "", "Only list bonds created by this address")
+	fsBondsDetailed.String(FlagAllowSells, "", "Only list bonds that do (true) or do not (false) allow sells")
+	fsBondsDetailed.String(FlagPaused, "", "Only list bonds that are (true) or are not (false) paused")
+	fsBondsDetailed.String(FlagDissolved, "", "Only list bonds that are (true) or are not (false) dissolved")
+
+	fsCandles.String(FlagOffset, "0", "The number of candles to skip, starting from the newest")
+	fsCandles.String(FlagLimit, "0", "The max number of candles to return (0 for no limit)")
+	fsCandles.String(FlagIntervalBlocks, "0", "The number of blocks that candles are rolled up into (0 for the recorded candles)")
 }
@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"github.com/cosmos/cosmos-sdk/client"
+	client2 "github.com/ixoworld/bonds/x/bonds/client"
 	"github.com/ixoworld/bonds/x/bonds/internal/types"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -22,15 +23,37 @@ func GetQueryCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 
 	bondsQueryCmd.AddCommand(client.GetCommands(
 		GetCmdBonds(storeKey, cdc),
+		GetCmdBondsDetailed(storeKey, cdc),
+		GetCmdBondsByCreator(storeKey, cdc),
+		GetCmdBondsBySigner(storeKey, cdc),
+		GetCmdBondsByReserveDenom(storeKey, cdc),
 		GetCmdBond(storeKey, cdc),
 		GetCmdBatch(storeKey, cdc),
 		GetCmdLastBatch(storeKey, cdc),
+		GetCmdOrderBook(storeKey, cdc),
+		GetCmdOrdersByAddress(storeKey, cdc),
+		GetCmdAccountPositions(storeKey, cdc),
 		GetCmdCurrentPrice(storeKey, cdc),
 		GetCmdCurrentReserve(storeKey, cdc),
+		GetCmdReserveSurplus(storeKey, cdc),
+		GetCmdClaimableRewards(storeKey, cdc),
+		GetCmdTap(storeKey, cdc),
+		GetCmdReserveStaking(storeKey, cdc),
+		GetCmdRoundingSurplus(storeKey, cdc),
+		GetCmdReferrals(storeKey, cdc),
+		GetCmdBondStats(storeKey, cdc),
 		GetCmdCustomPrice(storeKey, cdc),
+		GetCmdPriceCurve(storeKey, cdc),
+		GetCmdCandles(storeKey, cdc),
+		GetCmdTwap(storeKey, cdc),
+		GetCmdPriceObservations(storeKey, cdc),
 		GetCmdBuyPrice(storeKey, cdc),
 		GetCmdSellReturn(storeKey, cdc),
 		GetCmdSwapReturn(storeKey, cdc),
+		GetCmdSwapInput(storeKey, cdc),
+		GetCmdQuoteBuy(storeKey, cdc),
+		GetCmdQuoteSell(storeKey, cdc),
+		GetCmdParams(storeKey, cdc),
 	)...)
 
 	return bondsQueryCmd
@@ -59,6 +82,124 @@ func GetCmdBonds(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+func GetCmdBondsDetailed(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "bonds-list-detailed",
+		Example: "bonds-list-detailed --function-type power_function --limit 10",
+		Short:   "List of bonds with a summary of each, optionally filtered and paginated",
+		Args:    cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			flags := cmd.Flags()
+			_offset, _ := flags.GetString(FlagOffset)
+			_limit, _ := flags.GetString(FlagLimit)
+			_functionType, _ := flags.GetString(FlagFunctionType)
+			_reserveToken, _ := flags.GetString(FlagReserveToken)
+			_creator, _ := flags.GetString(FlagCreator)
+			_allowSells, _ := flags.GetString(FlagAllowSells)
+			_paused, _ := flags.GetString(FlagPaused)
+			_dissolved, _ := flags.GetString(FlagDissolved)
+
+			params, err := client2.ParseBondsDetailedParams(_offset, _limit,
+				_functionType, _reserveToken, _creator, _allowSells, _paused, _dissolved)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(
+				fmt.Sprintf("custom/%s/bonds_detailed",
+					queryRoute), cdc.MustMarshalJSON(params))
+			if err != nil {
+				fmt.Printf("%s", err.Error())
+				return nil
+			}
+
+			var out types.QueryBondsDetailed
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsBondsDetailed)
+
+	return cmd
+}
+
+func GetCmdBondsByCreator(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "bonds-by-creator [creator-address]",
+		Example: "bonds-by-creator cosmos1qns07zjjsllfc6w7486f7v2nvyfsq30myn3nje",
+		Short:   "List of bonds created by an address",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			creatorAddress := args[0]
+
+			res, _, err := cliCtx.QueryWithData(
+				fmt.Sprintf("custom/%s/bonds_by_creator/%s",
+					queryRoute, creatorAddress), nil)
+			if err != nil {
+				fmt.Printf("%s", err.Error())
+				return nil
+			}
+
+			var out types.QueryBonds
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdBondsBySigner(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "bonds-by-signer [signer-address]",
+		Example: "bonds-by-signer cosmos1qns07zjjsllfc6w7486f7v2nvyfsq30myn3nje",
+		Short:   "List of bonds in which an address holds any role",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			signerAddress := args[0]
+
+			res, _, err := cliCtx.QueryWithData(
+				fmt.Sprintf("custom/%s/bonds_by_signer/%s",
+					queryRoute, signerAddress), nil)
+			if err != nil {
+				fmt.Printf("%s", err.Error())
+				return nil
+			}
+
+			var out types.QueryBonds
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdBondsByReserveDenom(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "bonds-by-reserve-denom [reserve-denom]",
+		Example: "bonds-by-reserve-denom res",
+		Short:   "List of bonds that use a denom as one of their reserve tokens",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			reserveDenom := args[0]
+
+			res, _, err := cliCtx.QueryWithData(
+				fmt.Sprintf("custom/%s/bonds_by_reserve_denom/%s",
+					queryRoute, reserveDenom), nil)
+			if err != nil {
+				fmt.Printf("%s", err.Error())
+				return nil
+			}
+
+			var out types.QueryBonds
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
 func GetCmdBond(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
 		Use:   "bond [bond-token]",
@@ -131,6 +272,81 @@ func GetCmdLastBatch(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+func GetCmdOrderBook(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "order-book [bond-token]",
+		Example: "order-book abc",
+		Short:   "Query the aggregated depth of the pending orders in a bond's current batch",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			bondToken := args[0]
+
+			res, _, err := cliCtx.QueryWithData(
+				fmt.Sprintf("custom/%s/order_book/%s",
+					queryRoute, bondToken), nil)
+			if err != nil {
+				fmt.Printf("%s", err.Error())
+				return nil
+			}
+
+			var out types.OrderBook
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdOrdersByAddress(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "orders-by-address [address]",
+		Example: "orders-by-address cosmos1...",
+		Short:   "Query an address' pending and last settled orders across all bonds",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			address := args[0]
+
+			res, _, err := cliCtx.QueryWithData(
+				fmt.Sprintf("custom/%s/orders_by_address/%s",
+					queryRoute, address), nil)
+			if err != nil {
+				fmt.Printf("%s", err.Error())
+				return nil
+			}
+
+			var out types.QueryOrdersByAddress
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdAccountPositions(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "account-positions [address]",
+		Example: "account-positions cosmos1...",
+		Short:   "Query an address' bond holdings valued at current prices, and its pending orders",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			address := args[0]
+
+			res, _, err := cliCtx.QueryWithData(
+				fmt.Sprintf("custom/%s/account_positions/%s",
+					queryRoute, address), nil)
+			if err != nil {
+				fmt.Printf("%s", err.Error())
+				return nil
+			}
+
+			var out types.QueryAccountPositions
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
 func GetCmdCurrentPrice(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
 		Use:   "current-price [bond-token]",
@@ -180,6 +396,182 @@ func GetCmdCurrentReserve(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+func GetCmdReserveSurplus(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "reserve-surplus [bond-token]",
+		Example: "reserve-surplus abc",
+		Short:   "Query balance(s) held by the reserve address but not tracked as reserve",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			bondToken := args[0]
+
+			res, _, err := cliCtx.QueryWithData(
+				fmt.Sprintf("custom/%s/reserve_surplus/%s",
+					queryRoute, bondToken), nil)
+			if err != nil {
+				fmt.Printf("%s", err.Error())
+				return nil
+			}
+
+			var out sdk.Coins
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdTap(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "tap [bond-token]",
+		Example: "tap abc",
+		Short:   "Query a bond's tap, its available funds and the holder votes",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			bondToken := args[0]
+
+			res, _, err := cliCtx.QueryWithData(
+				fmt.Sprintf("custom/%s/tap/%s",
+					queryRoute, bondToken), nil)
+			if err != nil {
+				fmt.Printf("%s", err.Error())
+				return nil
+			}
+
+			var out types.QueryTap
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdReserveStaking(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "reserve-staking [bond-token]",
+		Example: "reserve-staking abc",
+		Short:   "Query a bond's reserve staking, its delegations and any queued sells",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			bondToken := args[0]
+
+			res, _, err := cliCtx.QueryWithData(
+				fmt.Sprintf("custom/%s/reserve_staking/%s",
+					queryRoute, bondToken), nil)
+			if err != nil {
+				fmt.Printf("%s", err.Error())
+				return nil
+			}
+
+			var out types.QueryReserveStaking
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdRoundingSurplus(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "rounding-surplus [bond-token]",
+		Example: "rounding-surplus abc",
+		Short:   "Query a bond's rounding surplus and how much of it can be swept",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			bondToken := args[0]
+
+			res, _, err := cliCtx.QueryWithData(
+				fmt.Sprintf("custom/%s/rounding_surplus/%s",
+					queryRoute, bondToken), nil)
+			if err != nil {
+				fmt.Printf("%s", err.Error())
+				return nil
+			}
+
+			var out types.QueryRoundingSurplus
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdReferrals(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "referrals [bond-token]",
+		Example: "referrals abc",
+		Short:   "Query a bond's referral fee percentage and the totals paid to its referrers",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			bondToken := args[0]
+
+			res, _, err := cliCtx.QueryWithData(
+				fmt.Sprintf("custom/%s/referrals/%s",
+					queryRoute, bondToken), nil)
+			if err != nil {
+				fmt.Printf("%s", err.Error())
+				return nil
+			}
+
+			var out types.QueryReferrals
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdBondStats(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "bond-stats [bond-token]",
+		Example: "bond-stats abc",
+		Short:   "Query a bond's cumulative statistics over all of its performed orders",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			bondToken := args[0]
+
+			res, _, err := cliCtx.QueryWithData(
+				fmt.Sprintf("custom/%s/bond_stats/%s",
+					queryRoute, bondToken), nil)
+			if err != nil {
+				fmt.Printf("%s", err.Error())
+				return nil
+			}
+
+			var out types.BondStats
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdClaimableRewards(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "claimable-rewards [bond-token] [address]",
+		Example: "claimable-rewards abc cosmos1...",
+		Short:   "Query holder rewards that an address can currently claim from a bond",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			bondToken := args[0]
+			address := args[1]
+
+			res, _, err := cliCtx.QueryWithData(
+				fmt.Sprintf("custom/%s/claimable_rewards/%s/%s",
+					queryRoute, bondToken, address), nil)
+			if err != nil {
+				fmt.Printf("%s", err.Error())
+				return nil
+			}
+
+			var out sdk.Coins
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
 func GetCmdCustomPrice(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
 		Use:     "price [bond-token-with-amount]",
@@ -212,6 +604,133 @@ func GetCmdCustomPrice(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+func GetCmdPriceCurve(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "price-curve [bond-token] [from-supply] [to-supply] [samples]",
+		Example: "price-curve abc 0 1000 50 --csv",
+		Short:   fmt.Sprintf("Query the bond's price(s) and curve integral at up to %d evenly spaced supplies", types.MaxPriceCurveSamples),
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			bondToken := args[0]
+			fromSupply := args[1]
+			toSupply := args[2]
+			samples := args[3]
+
+			res, _, err := cliCtx.QueryWithData(
+				fmt.Sprintf("custom/%s/price_curve/%s/%s/%s/%s",
+					queryRoute, bondToken, fromSupply, toSupply, samples), nil)
+			if err != nil {
+				fmt.Printf("%s", err.Error())
+				return nil
+			}
+
+			var out types.QueryPriceCurve
+			cdc.MustUnmarshalJSON(res, &out)
+
+			if asCSV, _ := cmd.Flags().GetBool(FlagCSV); asCSV {
+				fmt.Println(out.CSV())
+				return nil
+			}
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	cmd.Flags().Bool(FlagCSV, false, "Print the price curve as CSV")
+
+	return cmd
+}
+
+func GetCmdCandles(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "candles [bond-token]",
+		Example: "candles abc --interval-blocks 1000 --limit 24",
+		Short:   "Query the bond's recent candles (open, high, low, close, and volume), from newest to oldest",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			bondToken := args[0]
+			flags := cmd.Flags()
+			_offset, _ := flags.GetString(FlagOffset)
+			_limit, _ := flags.GetString(FlagLimit)
+			_intervalBlocks, _ := flags.GetString(FlagIntervalBlocks)
+
+			params, err := client2.ParseCandlesParams(_offset, _limit, _intervalBlocks)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(
+				fmt.Sprintf("custom/%s/candles/%s",
+					queryRoute, bondToken), cdc.MustMarshalJSON(params))
+			if err != nil {
+				fmt.Printf("%s", err.Error())
+				return nil
+			}
+
+			var out types.QueryCandles
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsCandles)
+
+	return cmd
+}
+
+func GetCmdTwap(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "twap [bond-token] [from-height] [to-height]",
+		Example: "twap abc 100 200",
+		Short:   "Query a bond's time-weighted average price(s) between two block heights",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			bondToken := args[0]
+			fromHeight := args[1]
+			toHeight := args[2]
+
+			res, _, err := cliCtx.QueryWithData(
+				fmt.Sprintf("custom/%s/twap/%s/%s/%s",
+					queryRoute, bondToken, fromHeight, toHeight), nil)
+			if err != nil {
+				fmt.Printf("%s", err.Error())
+				return nil
+			}
+
+			var out types.Twap
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdPriceObservations(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "price-observations [bond-token]",
+		Example: "price-observations abc",
+		Short:   "Query a bond's stored price observations, used to calculate time-weighted average prices",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			bondToken := args[0]
+
+			res, _, err := cliCtx.QueryWithData(
+				fmt.Sprintf("custom/%s/price_observations/%s",
+					queryRoute, bondToken), nil)
+			if err != nil {
+				fmt.Printf("%s", err.Error())
+				return nil
+			}
+
+			var out types.QueryPriceObservations
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
 func GetCmdBuyPrice(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
 		Use:     "buy-price [bond-token-with-amount]",
@@ -246,9 +765,46 @@ func GetCmdBuyPrice(queryRoute string, cdc *codec.Codec) *cobra.Command {
 
 func GetCmdSellReturn(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
-		Use:     "sell-return [bond-token-with-amount]",
-		Example: "sell-return 10abc",
-		Short:   "Query return(s) on selling an amount of tokens of the bond",
+		Use:     "sell-return [bond-token-with-amount] [seller-address]",
+		Example: "sell-return 10abc cosmos1...",
+		Short:   "Query return(s) on selling an amount of tokens of the bond, optionally by a specific seller",
+		Args:    cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			bondTokenWithAmount := args[0]
+
+			bondCoinWithAmount, err := sdk.ParseCoin(bondTokenWithAmount)
+			if err != nil {
+				fmt.Printf("%s", err.Error())
+				return nil
+			}
+
+			// Seller address (if any) determines the holding period exit fee
+			queryPath := fmt.Sprintf("custom/%s/sell_return/%s/%s",
+				queryRoute, bondCoinWithAmount.Denom,
+				bondCoinWithAmount.Amount.String())
+			if len(args) == 2 {
+				queryPath += "/" + args[1]
+			}
+
+			res, _, err := cliCtx.QueryWithData(queryPath, nil)
+			if err != nil {
+				fmt.Printf("%s", err.Error())
+				return nil
+			}
+
+			var out types.QuerySellReturn
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdQuoteBuy(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "quote-buy [bond-token-with-amount]",
+		Example: "quote-buy 10abc",
+		Short:   "Query the batch-wide price(s) and total cost of adding a buy of an amount of tokens of the bond to its current batch",
 		Args:    cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
@@ -261,7 +817,7 @@ func GetCmdSellReturn(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			}
 
 			res, _, err := cliCtx.QueryWithData(
-				fmt.Sprintf("custom/%s/sell_return/%s/%s",
+				fmt.Sprintf("custom/%s/quote_buy/%s/%s",
 					queryRoute, bondCoinWithAmount.Denom,
 					bondCoinWithAmount.Amount.String()), nil)
 			if err != nil {
@@ -269,7 +825,44 @@ func GetCmdSellReturn(queryRoute string, cdc *codec.Codec) *cobra.Command {
 				return nil
 			}
 
-			var out types.QuerySellReturn
+			var out types.QueryBuyQuote
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdQuoteSell(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "quote-sell [bond-token-with-amount] [seller-address]",
+		Example: "quote-sell 10abc cosmos1...",
+		Short:   "Query the batch-wide price(s) and total return(s) of adding a sell of an amount of tokens of the bond to its current batch, optionally by a specific seller",
+		Args:    cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			bondTokenWithAmount := args[0]
+
+			bondCoinWithAmount, err := sdk.ParseCoin(bondTokenWithAmount)
+			if err != nil {
+				fmt.Printf("%s", err.Error())
+				return nil
+			}
+
+			// Seller address (if any) determines the holding period exit fee
+			queryPath := fmt.Sprintf("custom/%s/quote_sell/%s/%s",
+				queryRoute, bondCoinWithAmount.Denom,
+				bondCoinWithAmount.Amount.String())
+			if len(args) == 2 {
+				queryPath += "/" + args[1]
+			}
+
+			res, _, err := cliCtx.QueryWithData(queryPath, nil)
+			if err != nil {
+				fmt.Printf("%s", err.Error())
+				return nil
+			}
+
+			var out types.QuerySellQuote
 			cdc.MustUnmarshalJSON(res, &out)
 			return cliCtx.PrintOutput(out)
 		},
@@ -309,3 +902,59 @@ func GetCmdSwapReturn(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+func GetCmdSwapInput(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "swap-input [bond-token] [to-token-with-amount] [from-token]",
+		Example: "swap-input abc 10res2 res1",
+		Short:   "Query amount of tokens needed to get an exact amount of another token by swapping",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			bondToken := args[0]
+			toTokenWithAmount := args[1]
+			fromToken := args[2]
+
+			toCoinWithAmount, err := sdk.ParseCoin(toTokenWithAmount)
+			if err != nil {
+				fmt.Printf("%s", err.Error())
+				return nil
+			}
+
+			res, _, err := cliCtx.QueryWithData(
+				fmt.Sprintf("custom/%s/swap_input/%s/%s/%s/%s",
+					queryRoute, bondToken, toCoinWithAmount.Denom,
+					toCoinWithAmount.Amount.String(), fromToken), nil)
+			if err != nil {
+				fmt.Printf("%s", err.Error())
+				return nil
+			}
+
+			var out types.QuerySwapReturn
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "Query the current bonds parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(
+				fmt.Sprintf("custom/%s/params", queryRoute), nil)
+			if err != nil {
+				fmt.Printf("%s", err.Error())
+				return nil
+			}
+
+			var out types.Params
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
@@ -27,6 +27,21 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	bondsTxCmd.AddCommand(client.PostCommands(
 		GetCmdCreateBond(cdc),
 		GetCmdEditBond(cdc),
+		GetCmdCloseBond(cdc),
+		GetCmdSetBondPaused(cdc),
+		GetCmdSetCircuitBreaker(cdc),
+		GetCmdUpdateBondRole(cdc),
+		GetCmdSetFeeRecipients(cdc),
+		GetCmdSetFeeSchedule(cdc),
+		GetCmdClaimBondRewards(cdc),
+		GetCmdSetTap(cdc),
+		GetCmdWithdrawTap(cdc),
+		GetCmdVoteTap(cdc),
+		GetCmdRefund(cdc),
+		GetCmdSetReserveStaking(cdc),
+		GetCmdDelegateReserve(cdc),
+		GetCmdUndelegateReserve(cdc),
+		GetCmdSweepRoundingSurplus(cdc),
 		GetCmdBuy(cdc),
 		GetCmdSell(cdc),
 		GetCmdSwap(cdc),
@@ -49,6 +64,12 @@ func GetCmdCreateBond(cdc *codec.Codec) *cobra.Command {
 			_txFeePercentage := viper.GetString(FlagTxFeePercentage)
 			_exitFeePercentage := viper.GetString(FlagExitFeePercentage)
 			_feeAddress := viper.GetString(FlagFeeAddress)
+			_feeRecipients := viper.GetString(FlagFeeRecipients)
+			_holderRewardsPercentage := viper.GetString(FlagHolderRewardsPercentage)
+			_liquidityFeePercentage := viper.GetString(FlagLiquidityFeePercentage)
+			_referralFeePercentage := viper.GetString(FlagReferralFeePercentage)
+			_minExitFeePercentage := viper.GetString(FlagMinExitFeePercentage)
+			_exitFeeHoldingPeriod := viper.GetString(FlagExitFeeHoldingPeriod)
 			_maxSupply := viper.GetString(FlagMaxSupply)
 			_orderQuantityLimits := viper.GetString(FlagOrderQuantityLimits)
 			_sanityRate := viper.GetString(FlagSanityRate)
@@ -92,6 +113,34 @@ func GetCmdCreateBond(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
+			// Parse fee recipients (optional)
+			feeRecipients, err := client2.ParseFeeRecipients(_feeRecipients)
+			if err != nil {
+				return err
+			}
+
+			holderRewardsPercentage, err := sdk.NewDecFromStr(_holderRewardsPercentage)
+			if err != nil {
+				return fmt.Errorf(types.ErrArgumentMissingOrNonFloat(types.DefaultCodespace, "holder rewards percentage").Error())
+			}
+
+			liquidityFeePercentage, err := sdk.NewDecFromStr(_liquidityFeePercentage)
+			if err != nil {
+				return fmt.Errorf(types.ErrArgumentMissingOrNonFloat(types.DefaultCodespace, "liquidity fee percentage").Error())
+			}
+
+			referralFeePercentage, err := sdk.NewDecFromStr(_referralFeePercentage)
+			if err != nil {
+				return fmt.Errorf(types.ErrArgumentMissingOrNonFloat(types.DefaultCodespace, "referral fee percentage").Error())
+			}
+
+			// Parse min exit fee percentage (optional) and holding period
+			minExitFeePercentage, exitFeeHoldingPeriod, err := client2.ParseHoldingPeriodExitFee(
+				_minExitFeePercentage, _exitFeeHoldingPeriod)
+			if err != nil {
+				return fmt.Errorf(err.Error())
+			}
+
 			maxSupply, err := client2.ParseMaxSupply(_maxSupply, _token)
 			if err != nil {
 				return err
@@ -123,8 +172,9 @@ func GetCmdCreateBond(cdc *codec.Codec) *cobra.Command {
 			msg := types.NewMsgCreateBond(_token, _name, _description,
 				cliCtx.GetFromAddress(), _functionType, functionParams,
 				reserveTokens, txFeePercentage, exitFeePercentage, feeAddress,
-				maxSupply, orderQuantityLimits, sanityRate,
-				sanityMarginPercentage, _allowSells, signers, batchBlocks)
+				feeRecipients, holderRewardsPercentage, liquidityFeePercentage,
+				referralFeePercentage, minExitFeePercentage, exitFeeHoldingPeriod, maxSupply, orderQuantityLimits, sanityRate, sanityMarginPercentage,
+				_allowSells, signers, batchBlocks)
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
@@ -193,6 +243,572 @@ func GetCmdEditBond(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+func GetCmdCloseBond(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "close-bond",
+		Short: "Close bond",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			_token := viper.GetString(FlagToken)
+			_signers := viper.GetString(FlagSigners)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Parse signers
+			signers, err := client2.ParseSigners(_signers)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCloseBond(_token, cliCtx.GetFromAddress(), signers)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsBondGeneral)
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	_ = cmd.MarkFlagRequired(FlagToken)
+	_ = cmd.MarkFlagRequired(FlagSigners)
+
+	return cmd
+}
+
+func GetCmdSetBondPaused(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-bond-paused",
+		Short: "Pause or unpause bond",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			_token := viper.GetString(FlagToken)
+			_paused := viper.GetString(FlagPaused)
+			_signers := viper.GetString(FlagSigners)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Parse signers
+			signers, err := client2.ParseSigners(_signers)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetBondPaused(_token, _paused, cliCtx.GetFromAddress(), signers)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsBondGeneral)
+	cmd.Flags().AddFlagSet(fsBondPause)
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	_ = cmd.MarkFlagRequired(FlagToken)
+	_ = cmd.MarkFlagRequired(FlagPaused)
+	_ = cmd.MarkFlagRequired(FlagSigners)
+
+	return cmd
+}
+
+func GetCmdSetCircuitBreaker(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-circuit-breaker",
+		Short: "Set bond's circuit breaker",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			_token := viper.GetString(FlagToken)
+			_maxPriceMovePercentage := viper.GetString(FlagMaxPriceMovePercentage)
+			_circuitBreakerMode := viper.GetString(FlagCircuitBreakerMode)
+			_circuitBreakerCooldown := viper.GetString(FlagCircuitBreakerCooldown)
+			_signers := viper.GetString(FlagSigners)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Parse circuit breaker values
+			maxPriceMovePercentage, circuitBreakerCooldown, err := client2.ParseCircuitBreakerValues(
+				_maxPriceMovePercentage, _circuitBreakerCooldown)
+			if err != nil {
+				return fmt.Errorf(err.Error())
+			}
+
+			// Parse signers
+			signers, err := client2.ParseSigners(_signers)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetCircuitBreaker(_token, maxPriceMovePercentage,
+				_circuitBreakerMode, circuitBreakerCooldown, cliCtx.GetFromAddress(), signers)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsBondGeneral)
+	cmd.Flags().AddFlagSet(fsBondBreaker)
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	_ = cmd.MarkFlagRequired(FlagToken)
+	_ = cmd.MarkFlagRequired(FlagMaxPriceMovePercentage)
+	_ = cmd.MarkFlagRequired(FlagSigners)
+
+	return cmd
+}
+
+func GetCmdUpdateBondRole(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-bond-role",
+		Short: "Update the addresses and threshold of a bond's role",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			_token := viper.GetString(FlagToken)
+			_role := viper.GetString(FlagRole)
+			_addresses := viper.GetString(FlagAddresses)
+			_threshold := viper.GetString(FlagThreshold)
+			_signers := viper.GetString(FlagSigners)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Parse role addresses
+			addresses, err := client2.ParseSigners(_addresses)
+			if err != nil {
+				return err
+			}
+
+			// Parse role threshold
+			threshold, err := client2.ParseRoleThreshold(_threshold)
+			if err != nil {
+				return err
+			}
+
+			// Parse signers
+			signers, err := client2.ParseSigners(_signers)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateBondRole(_token, _role, addresses,
+				threshold, cliCtx.GetFromAddress(), signers)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsBondGeneral)
+	cmd.Flags().AddFlagSet(fsBondRole)
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	_ = cmd.MarkFlagRequired(FlagToken)
+	_ = cmd.MarkFlagRequired(FlagRole)
+	_ = cmd.MarkFlagRequired(FlagAddresses)
+	_ = cmd.MarkFlagRequired(FlagThreshold)
+	_ = cmd.MarkFlagRequired(FlagSigners)
+
+	return cmd
+}
+
+func GetCmdSetFeeRecipients(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-fee-recipients",
+		Short: "Set the addresses that a bond's fees are split among",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			_token := viper.GetString(FlagToken)
+			_feeRecipients := viper.GetString(FlagFeeRecipients)
+			_feeAddress := viper.GetString(FlagFeeAddress)
+			_signers := viper.GetString(FlagSigners)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Parse fee recipients
+			feeRecipients, err := client2.ParseFeeRecipients(_feeRecipients)
+			if err != nil {
+				return err
+			}
+
+			feeAddress, err := sdk.AccAddressFromBech32(_feeAddress)
+			if err != nil {
+				return err
+			}
+
+			// Parse signers
+			signers, err := client2.ParseSigners(_signers)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetFeeRecipients(_token, feeRecipients,
+				feeAddress, cliCtx.GetFromAddress(), signers)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsBondGeneral)
+	cmd.Flags().AddFlagSet(fsBondFees)
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	_ = cmd.MarkFlagRequired(FlagToken)
+	_ = cmd.MarkFlagRequired(FlagFeeRecipients)
+	_ = cmd.MarkFlagRequired(FlagFeeAddress)
+	_ = cmd.MarkFlagRequired(FlagSigners)
+
+	return cmd
+}
+
+func GetCmdSetFeeSchedule(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-fee-schedule",
+		Short: "Set the order size and volatility tiers that scale a bond's fees",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			_token := viper.GetString(FlagToken)
+			_sizeTiers := viper.GetString(FlagSizeTiers)
+			_volatilityTiers := viper.GetString(FlagVolatilityTiers)
+			_volatilityBatches := viper.GetString(FlagVolatilityBatches)
+			_signers := viper.GetString(FlagSigners)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Parse fee schedule
+			feeSchedule, err := client2.ParseFeeSchedule(
+				_sizeTiers, _volatilityTiers, _volatilityBatches)
+			if err != nil {
+				return err
+			}
+
+			// Parse signers
+			signers, err := client2.ParseSigners(_signers)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetFeeSchedule(_token, feeSchedule,
+				cliCtx.GetFromAddress(), signers)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsBondGeneral)
+	cmd.Flags().AddFlagSet(fsBondFeeSchedule)
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	_ = cmd.MarkFlagRequired(FlagToken)
+	_ = cmd.MarkFlagRequired(FlagSigners)
+
+	return cmd
+}
+
+func GetCmdClaimBondRewards(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "claim-bond-rewards [bond-token]",
+		Example: "claim-bond-rewards abc",
+		Short:   "Claim holder rewards accrued by holding a bond's tokens",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			msg := types.NewMsgClaimBondRewards(args[0], cliCtx.GetFromAddress())
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	return cmd
+}
+
+func GetCmdSetTap(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-tap",
+		Short: "Set the tap through which a bond's reserve is released to a beneficiary",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			_token := viper.GetString(FlagToken)
+			_beneficiary := viper.GetString(FlagBeneficiary)
+			_tapRate := viper.GetString(FlagTapRate)
+			_tapFloorPercentage := viper.GetString(FlagTapFloorPercentage)
+			_signers := viper.GetString(FlagSigners)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Parse tap values
+			beneficiary, tapRate, tapFloorPercentage, err := client2.ParseTapValues(
+				_beneficiary, _tapRate, _tapFloorPercentage)
+			if err != nil {
+				return err
+			}
+
+			// Parse signers
+			signers, err := client2.ParseSigners(_signers)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetTap(_token, beneficiary, tapRate,
+				tapFloorPercentage, cliCtx.GetFromAddress(), signers)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsBondGeneral)
+	cmd.Flags().AddFlagSet(fsBondTap)
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	_ = cmd.MarkFlagRequired(FlagToken)
+	_ = cmd.MarkFlagRequired(FlagBeneficiary)
+	_ = cmd.MarkFlagRequired(FlagTapRate)
+	_ = cmd.MarkFlagRequired(FlagSigners)
+
+	return cmd
+}
+
+func GetCmdWithdrawTap(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-tap",
+		Short: "Withdraw the funds available through a bond's tap to its beneficiary",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			_token := viper.GetString(FlagToken)
+			_signers := viper.GetString(FlagSigners)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Parse signers
+			signers, err := client2.ParseSigners(_signers)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawTap(_token, cliCtx.GetFromAddress(), signers)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsBondGeneral)
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	_ = cmd.MarkFlagRequired(FlagToken)
+	_ = cmd.MarkFlagRequired(FlagSigners)
+
+	return cmd
+}
+
+func GetCmdVoteTap(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "vote-tap [bond-token] [option]",
+		Example: "" +
+			"vote-tap abc raise\n" +
+			"vote-tap abc dissolve",
+		Short: "Vote, weighted by bond token balance, to raise a bond's tap or to dissolve the bond",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			msg := types.NewMsgVoteTap(args[0], args[1], cliCtx.GetFromAddress())
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	return cmd
+}
+
+func GetCmdRefund(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "refund [bond-token-with-amount]",
+		Example: "refund 10abc",
+		Short:   "Burn tokens of a dissolved bond for a pro-rata share of its reserve",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bondCoinWithAmount, err := sdk.ParseCoin(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRefund(cliCtx.GetFromAddress(), bondCoinWithAmount)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	return cmd
+}
+
+func GetCmdSetReserveStaking(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-reserve-staking",
+		Short: "Allow part of a bond's reserve to be staked to validators",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			_token := viper.GetString(FlagToken)
+			_capPercentage := viper.GetString(FlagStakingCapPercentage)
+			_rewardsToReserve := viper.GetString(FlagRewardsToReserve)
+			_signers := viper.GetString(FlagSigners)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Parse staking cap percentage
+			capPercentage, err := client2.ParseStakingCapPercentage(_capPercentage)
+			if err != nil {
+				return err
+			}
+
+			// Parse signers
+			signers, err := client2.ParseSigners(_signers)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetReserveStaking(_token, capPercentage,
+				_rewardsToReserve, cliCtx.GetFromAddress(), signers)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsBondGeneral)
+	cmd.Flags().AddFlagSet(fsBondStaking)
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	_ = cmd.MarkFlagRequired(FlagToken)
+	_ = cmd.MarkFlagRequired(FlagStakingCapPercentage)
+	_ = cmd.MarkFlagRequired(FlagRewardsToReserve)
+	_ = cmd.MarkFlagRequired(FlagSigners)
+
+	return cmd
+}
+
+func GetCmdDelegateReserve(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegate-reserve",
+		Short: "Delegate part of a bond's reserve to a validator",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			_token := viper.GetString(FlagToken)
+			_validator := viper.GetString(FlagValidator)
+			_amount := viper.GetString(FlagAmount)
+			_signers := viper.GetString(FlagSigners)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Parse validator and amount
+			validator, amount, err := client2.ParseReserveDelegationValues(_validator, _amount)
+			if err != nil {
+				return err
+			}
+
+			// Parse signers
+			signers, err := client2.ParseSigners(_signers)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDelegateReserve(_token, validator, amount,
+				cliCtx.GetFromAddress(), signers)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsBondGeneral)
+	cmd.Flags().AddFlagSet(fsBondDelegation)
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	_ = cmd.MarkFlagRequired(FlagToken)
+	_ = cmd.MarkFlagRequired(FlagValidator)
+	_ = cmd.MarkFlagRequired(FlagAmount)
+	_ = cmd.MarkFlagRequired(FlagSigners)
+
+	return cmd
+}
+
+func GetCmdUndelegateReserve(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "undelegate-reserve",
+		Short: "Undelegate part of a bond's reserve from a validator",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			_token := viper.GetString(FlagToken)
+			_validator := viper.GetString(FlagValidator)
+			_amount := viper.GetString(FlagAmount)
+			_signers := viper.GetString(FlagSigners)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Parse validator and amount
+			validator, amount, err := client2.ParseReserveDelegationValues(_validator, _amount)
+			if err != nil {
+				return err
+			}
+
+			// Parse signers
+			signers, err := client2.ParseSigners(_signers)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUndelegateReserve(_token, validator, amount,
+				cliCtx.GetFromAddress(), signers)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsBondGeneral)
+	cmd.Flags().AddFlagSet(fsBondDelegation)
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	_ = cmd.MarkFlagRequired(FlagToken)
+	_ = cmd.MarkFlagRequired(FlagValidator)
+	_ = cmd.MarkFlagRequired(FlagAmount)
+	_ = cmd.MarkFlagRequired(FlagSigners)
+
+	return cmd
+}
+
+func GetCmdSweepRoundingSurplus(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sweep-rounding-surplus",
+		Short: "Sweep a bond's rounding surplus to its fee address",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			_token := viper.GetString(FlagToken)
+			_signers := viper.GetString(FlagSigners)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Parse signers
+			signers, err := client2.ParseSigners(_signers)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSweepRoundingSurplus(_token, cliCtx.GetFromAddress(), signers)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsBondGeneral)
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	_ = cmd.MarkFlagRequired(FlagToken)
+	_ = cmd.MarkFlagRequired(FlagSigners)
+
+	return cmd
+}
+
 func GetCmdBuy(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use: "buy [bond-token-with-amount] [max-prices]",
@@ -217,11 +833,21 @@ func GetCmdBuy(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
+			// Parse referrer (optional)
+			var referrer sdk.AccAddress
+			if _referrer := viper.GetString(FlagReferrer); _referrer != "" {
+				referrer, err = sdk.AccAddressFromBech32(_referrer)
+				if err != nil {
+					return err
+				}
+			}
+
 			msg := types.NewMsgBuy(cliCtx.GetFromAddress(),
-				bondCoinWithAmount, maxPrices)
+				bondCoinWithAmount, maxPrices, referrer)
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
+	cmd.Flags().AddFlagSet(fsBondBuy)
 	_ = cmd.MarkFlagRequired(client.FlagFrom)
 	return cmd
 }
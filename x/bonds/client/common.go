@@ -196,6 +196,166 @@ func ParseBatchBlocks(batchBlocksStr string) (batchBlocks sdk.Uint, err error) {
 	return batchBlocks, nil
 }
 
+func ParseCircuitBreakerValues(maxPriceMovePercentageStr string, cooldownStr string) (maxPriceMovePercentage sdk.Dec, cooldown sdk.Uint, err error) {
+
+	// Check that max price move percentage is parsable and not negative
+	maxPriceMovePercentage, err = parseNonNegativeDec(maxPriceMovePercentageStr, "max price move percentage")
+	if err != nil {
+		return sdk.Dec{}, sdk.Uint{}, err
+	}
+
+	cooldown, err = sdk.ParseUint(cooldownStr)
+	if err != nil {
+		return sdk.Dec{}, sdk.Uint{}, types.ErrArgumentMissingOrNonUInteger(types.DefaultCodespace, "circuit breaker cooldown")
+	}
+
+	return maxPriceMovePercentage, cooldown, nil
+}
+
+func ParseHoldingPeriodExitFee(minExitFeePercentageStr string, holdingPeriodStr string) (minExitFeePercentage sdk.Dec, holdingPeriod sdk.Uint, err error) {
+
+	// Holding period defaults to zero (i.e. exit fee does not decrease)
+	holdingPeriod = sdk.ZeroUint()
+	if holdingPeriodStr != "" {
+		holdingPeriod, err = sdk.ParseUint(holdingPeriodStr)
+		if err != nil {
+			return sdk.Dec{}, sdk.Uint{}, types.ErrArgumentMissingOrNonUInteger(types.DefaultCodespace, "exit fee holding period")
+		}
+	}
+
+	// Min exit fee percentage is left blank (nil) if not specified, in
+	// which case the bond's min exit fee is its exit fee percentage
+	if minExitFeePercentageStr == "" {
+		return sdk.Dec{}, holdingPeriod, nil
+	}
+	minExitFeePercentage, err = parseNonNegativeDec(minExitFeePercentageStr, "min exit fee percentage")
+	if err != nil {
+		return sdk.Dec{}, sdk.Uint{}, err
+	}
+
+	return minExitFeePercentage, holdingPeriod, nil
+}
+
+func ParseTapValues(beneficiaryStr, rateStr, floorPercentageStr string) (beneficiary sdk.AccAddress, rate sdk.Int, floorPercentage sdk.Dec, err error) {
+
+	beneficiary, err = sdk.AccAddressFromBech32(beneficiaryStr)
+	if err != nil {
+		return nil, sdk.Int{}, sdk.Dec{}, err
+	}
+
+	rate, ok := sdk.NewIntFromString(rateStr)
+	if !ok {
+		return nil, sdk.Int{}, sdk.Dec{}, types.ErrArgumentMissingOrNonInteger(types.DefaultCodespace, "tap rate")
+	}
+
+	// Check that floor percentage is parsable and not negative
+	floorPercentage, err = parseNonNegativeDec(floorPercentageStr, "tap floor percentage")
+	if err != nil {
+		return nil, sdk.Int{}, sdk.Dec{}, err
+	}
+
+	return beneficiary, rate, floorPercentage, nil
+}
+
+func ParseReserveDelegationValues(validatorStr, amountStr string) (validator sdk.ValAddress, amount sdk.Coin, err error) {
+
+	validator, err = sdk.ValAddressFromBech32(validatorStr)
+	if err != nil {
+		return nil, sdk.Coin{}, err
+	}
+
+	amount, err = sdk.ParseCoin(amountStr)
+	if err != nil {
+		return nil, sdk.Coin{}, err
+	}
+
+	return validator, amount, nil
+}
+
+func ParseStakingCapPercentage(capPercentageStr string) (capPercentage sdk.Dec, err error) {
+
+	// Check that cap percentage is parsable and not negative
+	capPercentage, err = parseNonNegativeDec(capPercentageStr, "staking cap percentage")
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	return capPercentage, nil
+}
+
+func ParseRoleThreshold(thresholdStr string) (threshold sdk.Uint, err error) {
+
+	threshold, err = sdk.ParseUint(thresholdStr)
+	if err != nil {
+		return sdk.Uint{}, types.ErrArgumentMissingOrNonUInteger(types.DefaultCodespace, "role threshold")
+	}
+	return threshold, nil
+}
+
+func ParseBondsDetailedParams(offsetStr, limitStr, functionType, reserveToken,
+	creatorStr, allowSells, paused, dissolved string) (params types.QueryBondsDetailedParams, err error) {
+
+	offset := sdk.ZeroUint()
+	if offsetStr != "" {
+		offset, err = sdk.ParseUint(offsetStr)
+		if err != nil {
+			return types.QueryBondsDetailedParams{}, types.ErrArgumentMissingOrNonUInteger(types.DefaultCodespace, "offset")
+		}
+	}
+
+	limit := sdk.ZeroUint()
+	if limitStr != "" {
+		limit, err = sdk.ParseUint(limitStr)
+		if err != nil {
+			return types.QueryBondsDetailedParams{}, types.ErrArgumentMissingOrNonUInteger(types.DefaultCodespace, "limit")
+		}
+	}
+
+	var creator sdk.AccAddress
+	if creatorStr != "" {
+		creator, err = sdk.AccAddressFromBech32(creatorStr)
+		if err != nil {
+			return types.QueryBondsDetailedParams{}, err
+		}
+	}
+
+	params = types.NewQueryBondsDetailedParams(offset, limit, functionType,
+		reserveToken, creator, allowSells, paused, dissolved)
+	if err := params.Validate(); err != nil {
+		return types.QueryBondsDetailedParams{}, err
+	}
+	return params, nil
+}
+
+func ParseCandlesParams(offsetStr, limitStr, intervalBlocksStr string) (params types.QueryCandlesParams, err error) {
+
+	offset := sdk.ZeroUint()
+	if offsetStr != "" {
+		offset, err = sdk.ParseUint(offsetStr)
+		if err != nil {
+			return types.QueryCandlesParams{}, types.ErrArgumentMissingOrNonUInteger(types.DefaultCodespace, "offset")
+		}
+	}
+
+	limit := sdk.ZeroUint()
+	if limitStr != "" {
+		limit, err = sdk.ParseUint(limitStr)
+		if err != nil {
+			return types.QueryCandlesParams{}, types.ErrArgumentMissingOrNonUInteger(types.DefaultCodespace, "limit")
+		}
+	}
+
+	intervalBlocks := sdk.ZeroUint()
+	if intervalBlocksStr != "" {
+		intervalBlocks, err = sdk.ParseUint(intervalBlocksStr)
+		if err != nil {
+			return types.QueryCandlesParams{}, types.ErrArgumentMissingOrNonUInteger(types.DefaultCodespace, "interval blocks")
+		}
+	}
+
+	return types.NewQueryCandlesParams(offset, limit, intervalBlocks), nil
+}
+
 func CheckCoinDenom(denom string) (err error) {
 	coin, err := sdk.ParseCoin("0" + denom)
 	if err != nil {
@@ -215,3 +375,73 @@ func ParseCoin(amount, denom string) (coin sdk.Coin, err error) {
 	}
 	return coin, nil
 }
+
+func ParseFeeRecipients(feeRecipientsStr string) (feeRecipients types.FeeRecipients, err error) {
+
+	// Split "addr1:50,addr2:50" (if not empty) into ["addr1:50","addr2:50"]
+	for _, recipientShare := range splitParameters(feeRecipientsStr) {
+		// Split each "addr1:50" into ["addr1","50"]
+		rsArray := strings.SplitN(recipientShare, ":", 2)
+		if len(rsArray) != 2 {
+			return nil, types.ErrArgumentMissingOrNonFloat(types.DefaultCodespace, "fee recipient share")
+		}
+
+		address, err := sdk.AccAddressFromBech32(rsArray[0])
+		if err != nil {
+			return nil, err
+		}
+
+		share, err := sdk.NewDecFromStr(rsArray[1])
+		if err != nil {
+			return nil, types.ErrArgumentMissingOrNonFloat(types.DefaultCodespace, "fee recipient share")
+		}
+
+		feeRecipients = append(feeRecipients, types.NewFeeRecipient(address, share))
+	}
+	return feeRecipients, nil
+}
+
+func parseFeeTiers(feeTiersStr string, tierName string) (feeTiers types.FeeTiers, err error) {
+
+	// Split "1000:0.5,5000:0.25" (if not empty) into ["1000:0.5","5000:0.25"]
+	for _, thresholdMultiplier := range splitParameters(feeTiersStr) {
+		// Split each "1000:0.5" into ["1000","0.5"]
+		tmArray := strings.SplitN(thresholdMultiplier, ":", 2)
+		if len(tmArray) != 2 {
+			return nil, types.ErrArgumentMissingOrNonFloat(types.DefaultCodespace, tierName+" multiplier")
+		}
+
+		threshold, err := parseNonNegativeDec(tmArray[0], tierName+" threshold")
+		if err != nil {
+			return nil, err
+		}
+
+		multiplier, err := parseNonNegativeDec(tmArray[1], tierName+" multiplier")
+		if err != nil {
+			return nil, err
+		}
+
+		feeTiers = append(feeTiers, types.NewFeeTier(threshold, multiplier))
+	}
+	return feeTiers, nil
+}
+
+func ParseFeeSchedule(sizeTiersStr, volatilityTiersStr, volatilityBatchesStr string) (feeSchedule types.FeeSchedule, err error) {
+
+	sizeTiers, err := parseFeeTiers(sizeTiersStr, "size tier")
+	if err != nil {
+		return types.FeeSchedule{}, err
+	}
+
+	volatilityTiers, err := parseFeeTiers(volatilityTiersStr, "volatility tier")
+	if err != nil {
+		return types.FeeSchedule{}, err
+	}
+
+	volatilityBatches, err := sdk.ParseUint(volatilityBatchesStr)
+	if err != nil {
+		return types.FeeSchedule{}, types.ErrArgumentMissingOrNonUInteger(types.DefaultCodespace, "volatility batches")
+	}
+
+	return types.NewFeeSchedule(sizeTiers, volatilityTiers, volatilityBatches), nil
+}
@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/gorilla/mux"
+	"github.com/ixoworld/bonds/x/bonds/client"
 	"net/http"
 )
 
@@ -14,6 +15,39 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, queryRoute st
 		"/bonds", queryBondsHandler(cliCtx, queryRoute),
 	).Methods("GET")
 
+	r.HandleFunc(
+		"/bonds_detailed", queryBondsDetailedHandler(cliCtx, queryRoute),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/bonds_by_creator/{%s}", RestAddress),
+		queryBondsByIndexHandler(cliCtx, queryRoute, "bonds_by_creator", RestAddress),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/bonds_by_signer/{%s}", RestAddress),
+		queryBondsByIndexHandler(cliCtx, queryRoute, "bonds_by_signer", RestAddress),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/bonds_by_reserve_denom/{%s}", RestReserveDenom),
+		queryBondsByIndexHandler(cliCtx, queryRoute, "bonds_by_reserve_denom", RestReserveDenom),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/orders_by_address/{%s}", RestAddress),
+		queryOrdersByAddressHandler(cliCtx, queryRoute),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/account_positions/{%s}", RestAddress),
+		queryAccountPositionsHandler(cliCtx, queryRoute),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/bonds/params", queryParamsHandler(cliCtx, queryRoute),
+	).Methods("GET")
+
 	r.HandleFunc(
 		fmt.Sprintf("/bonds/{%s}", RestBondToken),
 		queryBondHandler(cliCtx, queryRoute),
@@ -29,6 +63,11 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, queryRoute st
 		queryLastBatchHandler(cliCtx, queryRoute),
 	).Methods("GET")
 
+	r.HandleFunc(
+		fmt.Sprintf("/bonds/{%s}/order_book", RestBondToken),
+		queryOrderBookHandler(cliCtx, queryRoute),
+	).Methods("GET")
+
 	r.HandleFunc(
 		fmt.Sprintf("/bonds/{%s}/current_price", RestBondToken),
 		queryCurrentPriceHandler(cliCtx, queryRoute),
@@ -39,11 +78,68 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, queryRoute st
 		queryCurrentReserveHandler(cliCtx, queryRoute),
 	).Methods("GET")
 
+	r.HandleFunc(
+		fmt.Sprintf("/bonds/{%s}/reserve_surplus", RestBondToken),
+		queryReserveSurplusHandler(cliCtx, queryRoute),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/bonds/{%s}/claimable_rewards/{%s}", RestBondToken, RestAddress),
+		queryClaimableRewardsHandler(cliCtx, queryRoute),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/bonds/{%s}/tap", RestBondToken),
+		queryTapHandler(cliCtx, queryRoute),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/bonds/{%s}/reserve_staking", RestBondToken),
+		queryReserveStakingHandler(cliCtx, queryRoute),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/bonds/{%s}/rounding_surplus", RestBondToken),
+		queryRoundingSurplusHandler(cliCtx, queryRoute),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/bonds/{%s}/referrals", RestBondToken),
+		queryReferralsHandler(cliCtx, queryRoute),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/bonds/{%s}/stats", RestBondToken),
+		queryBondStatsHandler(cliCtx, queryRoute),
+	).Methods("GET")
+
 	r.HandleFunc(
 		fmt.Sprintf("/bonds/{%s}/price/{%s}", RestBondToken, RestBondAmount),
 		queryCustomPriceHandler(cliCtx, queryRoute),
 	).Methods("GET")
 
+	r.HandleFunc(
+		fmt.Sprintf("/bonds/{%s}/price_curve/{%s}/{%s}/{%s}",
+			RestBondToken, RestFromSupply, RestToSupply, RestSamples),
+		queryPriceCurveHandler(cliCtx, queryRoute),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/bonds/{%s}/candles", RestBondToken),
+		queryCandlesHandler(cliCtx, queryRoute),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/bonds/{%s}/twap/{%s}/{%s}",
+			RestBondToken, RestFromHeight, RestToHeight),
+		queryTwapHandler(cliCtx, queryRoute),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/bonds/{%s}/price_observations", RestBondToken),
+		queryPriceObservationsHandler(cliCtx, queryRoute),
+	).Methods("GET")
+
 	r.HandleFunc(
 		fmt.Sprintf("/bonds/{%s}/buy_price/{%s}", RestBondToken, RestBondAmount),
 		queryBuyPriceHandler(cliCtx, queryRoute),
@@ -54,10 +150,35 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, queryRoute st
 		querySellReturnHandler(cliCtx, queryRoute),
 	).Methods("GET")
 
+	r.HandleFunc(
+		fmt.Sprintf("/bonds/{%s}/sell_return/{%s}/{%s}", RestBondToken, RestBondAmount, RestAddress),
+		querySellReturnHandler(cliCtx, queryRoute),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/bonds/{%s}/quote_buy/{%s}", RestBondToken, RestBondAmount),
+		queryQuoteBuyHandler(cliCtx, queryRoute),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/bonds/{%s}/quote_sell/{%s}", RestBondToken, RestBondAmount),
+		queryQuoteSellHandler(cliCtx, queryRoute),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/bonds/{%s}/quote_sell/{%s}/{%s}", RestBondToken, RestBondAmount, RestAddress),
+		queryQuoteSellHandler(cliCtx, queryRoute),
+	).Methods("GET")
+
 	r.HandleFunc(
 		fmt.Sprintf("/bonds/{%s}/swap_return/{%s}/{%s}", RestBondToken, RestFromTokenWithAmount, RestToToken),
 		querySwapReturnHandler(cliCtx, queryRoute),
 	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/bonds/{%s}/swap_input/{%s}/{%s}", RestBondToken, RestToTokenWithAmount, RestFromToken),
+		querySwapInputHandler(cliCtx, queryRoute),
+	).Methods("GET")
 }
 
 func queryBondsHandler(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
@@ -72,6 +193,47 @@ func queryBondsHandler(cliCtx context.CLIContext, queryRoute string) http.Handle
 	}
 }
 
+func queryBondsDetailedHandler(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+
+		params, err := client.ParseBondsDetailedParams(
+			query.Get("offset"), query.Get("limit"),
+			query.Get("function_type"), query.Get("reserve_token"),
+			query.Get("creator"), query.Get("allow_sells"),
+			query.Get("paused"), query.Get("dissolved"))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(
+			fmt.Sprintf("custom/%s/bonds_detailed", queryRoute),
+			cliCtx.Codec.MustMarshalJSON(params))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func queryBondsByIndexHandler(cliCtx context.CLIContext, queryRoute, query, restVar string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		indexValue := vars[restVar]
+
+		res, _, err := cliCtx.QueryWithData(
+			fmt.Sprintf("custom/%s/%s/%s",
+				queryRoute, query, indexValue), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 func queryBondHandler(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
@@ -123,6 +285,57 @@ func queryLastBatchHandler(cliCtx context.CLIContext, queryRoute string) http.Ha
 	}
 }
 
+func queryOrderBookHandler(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		bondToken := vars[RestBondToken]
+
+		res, _, err := cliCtx.QueryWithData(
+			fmt.Sprintf("custom/%s/order_book/%s",
+				queryRoute, bondToken), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func queryOrdersByAddressHandler(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		address := vars[RestAddress]
+
+		res, _, err := cliCtx.QueryWithData(
+			fmt.Sprintf("custom/%s/orders_by_address/%s",
+				queryRoute, address), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func queryAccountPositionsHandler(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		address := vars[RestAddress]
+
+		res, _, err := cliCtx.QueryWithData(
+			fmt.Sprintf("custom/%s/account_positions/%s",
+				queryRoute, address), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 func queryCurrentPriceHandler(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
@@ -157,6 +370,126 @@ func queryCurrentReserveHandler(cliCtx context.CLIContext, queryRoute string) ht
 	}
 }
 
+func queryReserveSurplusHandler(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		bondToken := vars[RestBondToken]
+
+		res, _, err := cliCtx.QueryWithData(
+			fmt.Sprintf("custom/%s/reserve_surplus/%s",
+				queryRoute, bondToken), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func queryTapHandler(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		bondToken := vars[RestBondToken]
+
+		res, _, err := cliCtx.QueryWithData(
+			fmt.Sprintf("custom/%s/tap/%s",
+				queryRoute, bondToken), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func queryReserveStakingHandler(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		bondToken := vars[RestBondToken]
+
+		res, _, err := cliCtx.QueryWithData(
+			fmt.Sprintf("custom/%s/reserve_staking/%s",
+				queryRoute, bondToken), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func queryRoundingSurplusHandler(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		bondToken := vars[RestBondToken]
+
+		res, _, err := cliCtx.QueryWithData(
+			fmt.Sprintf("custom/%s/rounding_surplus/%s",
+				queryRoute, bondToken), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func queryReferralsHandler(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		bondToken := vars[RestBondToken]
+
+		res, _, err := cliCtx.QueryWithData(
+			fmt.Sprintf("custom/%s/referrals/%s",
+				queryRoute, bondToken), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func queryBondStatsHandler(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		bondToken := vars[RestBondToken]
+
+		res, _, err := cliCtx.QueryWithData(
+			fmt.Sprintf("custom/%s/bond_stats/%s",
+				queryRoute, bondToken), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func queryClaimableRewardsHandler(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		bondToken := vars[RestBondToken]
+		address := vars[RestAddress]
+
+		res, _, err := cliCtx.QueryWithData(
+			fmt.Sprintf("custom/%s/claimable_rewards/%s/%s",
+				queryRoute, bondToken, address), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 func queryCustomPriceHandler(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
@@ -175,6 +508,87 @@ func queryCustomPriceHandler(cliCtx context.CLIContext, queryRoute string) http.
 	}
 }
 
+func queryPriceCurveHandler(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		bondToken := vars[RestBondToken]
+		fromSupply := vars[RestFromSupply]
+		toSupply := vars[RestToSupply]
+		samples := vars[RestSamples]
+
+		res, _, err := cliCtx.QueryWithData(
+			fmt.Sprintf("custom/%s/price_curve/%s/%s/%s/%s",
+				queryRoute, bondToken, fromSupply, toSupply, samples), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func queryCandlesHandler(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		bondToken := vars[RestBondToken]
+		query := r.URL.Query()
+
+		params, err := client.ParseCandlesParams(query.Get("offset"),
+			query.Get("limit"), query.Get("interval_blocks"))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(
+			fmt.Sprintf("custom/%s/candles/%s", queryRoute, bondToken),
+			cliCtx.Codec.MustMarshalJSON(params))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func queryTwapHandler(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		bondToken := vars[RestBondToken]
+		fromHeight := vars[RestFromHeight]
+		toHeight := vars[RestToHeight]
+
+		res, _, err := cliCtx.QueryWithData(
+			fmt.Sprintf("custom/%s/twap/%s/%s/%s",
+				queryRoute, bondToken, fromHeight, toHeight), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func queryPriceObservationsHandler(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		bondToken := vars[RestBondToken]
+
+		res, _, err := cliCtx.QueryWithData(
+			fmt.Sprintf("custom/%s/price_observations/%s",
+				queryRoute, bondToken), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 func queryBuyPriceHandler(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
@@ -194,13 +608,36 @@ func queryBuyPriceHandler(cliCtx context.CLIContext, queryRoute string) http.Han
 }
 
 func querySellReturnHandler(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		bondToken := vars[RestBondToken]
+		bondAmount := vars[RestBondAmount]
+
+		// Seller address (if any) determines the holding period exit fee
+		queryPath := fmt.Sprintf("custom/%s/sell_return/%s/%s",
+			queryRoute, bondToken, bondAmount)
+		if address, ok := vars[RestAddress]; ok {
+			queryPath += "/" + address
+		}
+
+		res, _, err := cliCtx.QueryWithData(queryPath, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func queryQuoteBuyHandler(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
 		bondToken := vars[RestBondToken]
 		bondAmount := vars[RestBondAmount]
 
 		res, _, err := cliCtx.QueryWithData(
-			fmt.Sprintf("custom/%s/sell_return/%s/%s",
+			fmt.Sprintf("custom/%s/quote_buy/%s/%s",
 				queryRoute, bondToken, bondAmount), nil)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
@@ -211,6 +648,29 @@ func querySellReturnHandler(cliCtx context.CLIContext, queryRoute string) http.H
 	}
 }
 
+func queryQuoteSellHandler(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		bondToken := vars[RestBondToken]
+		bondAmount := vars[RestBondAmount]
+
+		// Seller address (if any) determines the holding period exit fee
+		queryPath := fmt.Sprintf("custom/%s/quote_sell/%s/%s",
+			queryRoute, bondToken, bondAmount)
+		if address, ok := vars[RestAddress]; ok {
+			queryPath += "/" + address
+		}
+
+		res, _, err := cliCtx.QueryWithData(queryPath, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 func querySwapReturnHandler(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
@@ -236,3 +696,42 @@ func querySwapReturnHandler(cliCtx context.CLIContext, queryRoute string) http.H
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
+
+func querySwapInputHandler(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		bondToken := vars[RestBondToken]
+		toTokenWithAmount := vars[RestToTokenWithAmount]
+		fromToken := vars[RestFromToken]
+
+		reserveCoinWithAmount, err := sdk.ParseCoin(toTokenWithAmount)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(
+			fmt.Sprintf("custom/%s/swap_input/%s/%s/%s/%s",
+				queryRoute, bondToken, reserveCoinWithAmount.Denom,
+				reserveCoinWithAmount.Amount.String(), fromToken), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func queryParamsHandler(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		res, _, err := cliCtx.QueryWithData(
+			fmt.Sprintf("custom/%s/params", queryRoute), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
@@ -14,6 +14,15 @@ const (
 	RestBondAmount          = "bond_amount"
 	RestFromTokenWithAmount = "from_token_with_amount"
 	RestToToken             = "to_token"
+	RestToTokenWithAmount   = "to_token_with_amount"
+	RestFromToken           = "from_token"
+	RestAddress             = "address"
+	RestReserveDenom        = "reserve_denom"
+	RestFromSupply          = "from_supply"
+	RestToSupply            = "to_supply"
+	RestSamples             = "samples"
+	RestFromHeight          = "from_height"
+	RestToHeight            = "to_height"
 )
 
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec, queryRoute string) {
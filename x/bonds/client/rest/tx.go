@@ -22,6 +22,81 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		editBondHandler(cliCtx),
 	).Methods("POST")
 
+	r.HandleFunc(
+		"/bonds/close_bond",
+		closeBondHandler(cliCtx),
+	).Methods("POST")
+
+	r.HandleFunc(
+		"/bonds/set_bond_paused",
+		setBondPausedHandler(cliCtx),
+	).Methods("POST")
+
+	r.HandleFunc(
+		"/bonds/set_circuit_breaker",
+		setCircuitBreakerHandler(cliCtx),
+	).Methods("POST")
+
+	r.HandleFunc(
+		"/bonds/update_bond_role",
+		updateBondRoleHandler(cliCtx),
+	).Methods("POST")
+
+	r.HandleFunc(
+		"/bonds/set_fee_recipients",
+		setFeeRecipientsHandler(cliCtx),
+	).Methods("POST")
+
+	r.HandleFunc(
+		"/bonds/set_fee_schedule",
+		setFeeScheduleHandler(cliCtx),
+	).Methods("POST")
+
+	r.HandleFunc(
+		"/bonds/claim_bond_rewards",
+		claimBondRewardsHandler(cliCtx),
+	).Methods("POST")
+
+	r.HandleFunc(
+		"/bonds/set_tap",
+		setTapHandler(cliCtx),
+	).Methods("POST")
+
+	r.HandleFunc(
+		"/bonds/withdraw_tap",
+		withdrawTapHandler(cliCtx),
+	).Methods("POST")
+
+	r.HandleFunc(
+		"/bonds/vote_tap",
+		voteTapHandler(cliCtx),
+	).Methods("POST")
+
+	r.HandleFunc(
+		"/bonds/refund",
+		refundHandler(cliCtx),
+	).Methods("POST")
+
+	r.HandleFunc(
+		"/bonds/set_reserve_staking",
+		setReserveStakingHandler(cliCtx),
+	).Methods("POST")
+
+	r.HandleFunc(
+		"/bonds/delegate_reserve",
+		delegateReserveHandler(cliCtx),
+	).Methods("POST")
+
+	r.HandleFunc(
+		"/bonds/undelegate_reserve",
+		undelegateReserveHandler(cliCtx),
+	).Methods("POST")
+
+	r.HandleFunc(
+		"/bonds/sweep_rounding_surplus",
+		sweepRoundingSurplusHandler(cliCtx),
+	).Methods("POST")
+
 	r.HandleFunc(
 		"/bonds/buy",
 		buyHandler(cliCtx),
@@ -39,23 +114,29 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 }
 
 type createBondReq struct {
-	BaseReq                rest.BaseReq `json:"base_req" yaml:"base_req"`
-	Token                  string       `json:"token" yaml:"token"`
-	Name                   string       `json:"name" yaml:"name"`
-	Description            string       `json:"description" yaml:"description"`
-	FunctionType           string       `json:"function_type" yaml:"function_type"`
-	FunctionParameters     string       `json:"function_parameters" yaml:"function_parameters"`
-	ReserveTokens          string       `json:"reserve_tokens" yaml:"reserve_tokens"`
-	TxFeePercentage        string       `json:"tx_fee_percentage" yaml:"tx_fee_percentage"`
-	ExitFeePercentage      string       `json:"exit_fee_percentage" yaml:"exit_fee_percentage"`
-	FeeAddress             string       `json:"fee_address" yaml:"fee_address"`
-	MaxSupply              string       `json:"max_supply" yaml:"max_supply"`
-	OrderQuantityLimits    string       `json:"order_quantity_limits" yaml:"order_quantity_limits"`
-	SanityRate             string       `json:"sanity_rate" yaml:"sanity_rate"`
-	SanityMarginPercentage string       `json:"sanity_margin_percentage" yaml:"sanity_margin_percentage"`
-	AllowSells             string       `json:"allow_sells" yaml:"allow_sells"`
-	Signers                string       `json:"signers" yaml:"signers"`
-	BatchBlocks            string       `json:"batch_blocks" yaml:"batch_blocks"`
+	BaseReq                 rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Token                   string       `json:"token" yaml:"token"`
+	Name                    string       `json:"name" yaml:"name"`
+	Description             string       `json:"description" yaml:"description"`
+	FunctionType            string       `json:"function_type" yaml:"function_type"`
+	FunctionParameters      string       `json:"function_parameters" yaml:"function_parameters"`
+	ReserveTokens           string       `json:"reserve_tokens" yaml:"reserve_tokens"`
+	TxFeePercentage         string       `json:"tx_fee_percentage" yaml:"tx_fee_percentage"`
+	ExitFeePercentage       string       `json:"exit_fee_percentage" yaml:"exit_fee_percentage"`
+	FeeAddress              string       `json:"fee_address" yaml:"fee_address"`
+	FeeRecipients           string       `json:"fee_recipients" yaml:"fee_recipients"`
+	HolderRewardsPercentage string       `json:"holder_rewards_percentage" yaml:"holder_rewards_percentage"`
+	LiquidityFeePercentage  string       `json:"liquidity_fee_percentage" yaml:"liquidity_fee_percentage"`
+	ReferralFeePercentage   string       `json:"referral_fee_percentage" yaml:"referral_fee_percentage"`
+	MinExitFeePercentage    string       `json:"min_exit_fee_percentage" yaml:"min_exit_fee_percentage"`
+	ExitFeeHoldingPeriod    string       `json:"exit_fee_holding_period" yaml:"exit_fee_holding_period"`
+	MaxSupply               string       `json:"max_supply" yaml:"max_supply"`
+	OrderQuantityLimits     string       `json:"order_quantity_limits" yaml:"order_quantity_limits"`
+	SanityRate              string       `json:"sanity_rate" yaml:"sanity_rate"`
+	SanityMarginPercentage  string       `json:"sanity_margin_percentage" yaml:"sanity_margin_percentage"`
+	AllowSells              string       `json:"allow_sells" yaml:"allow_sells"`
+	Signers                 string       `json:"signers" yaml:"signers"`
+	BatchBlocks             string       `json:"batch_blocks" yaml:"batch_blocks"`
 }
 
 func createBondHandler(cliCtx context.CLIContext) http.HandlerFunc {
@@ -125,6 +206,54 @@ func createBondHandler(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
+		// Parse fee recipients (optional)
+		feeRecipients, err := client.ParseFeeRecipients(req.FeeRecipients)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// Parse holder rewards percentage (optional; holder rewards disabled if blank)
+		holderRewardsPercentageDec := sdk.ZeroDec()
+		if req.HolderRewardsPercentage != "" {
+			holderRewardsPercentageDec, err = sdk.NewDecFromStr(req.HolderRewardsPercentage)
+			if err != nil {
+				err = types.ErrArgumentMissingOrNonFloat(types.DefaultCodespace, "holder rewards percentage")
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		// Parse liquidity fee percentage (optional; no swap fees kept in reserve if blank)
+		liquidityFeePercentageDec := sdk.ZeroDec()
+		if req.LiquidityFeePercentage != "" {
+			liquidityFeePercentageDec, err = sdk.NewDecFromStr(req.LiquidityFeePercentage)
+			if err != nil {
+				err = types.ErrArgumentMissingOrNonFloat(types.DefaultCodespace, "liquidity fee percentage")
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		// Parse referral fee percentage (optional; referrers not paid if blank)
+		referralFeePercentageDec := sdk.ZeroDec()
+		if req.ReferralFeePercentage != "" {
+			referralFeePercentageDec, err = sdk.NewDecFromStr(req.ReferralFeePercentage)
+			if err != nil {
+				err = types.ErrArgumentMissingOrNonFloat(types.DefaultCodespace, "referral fee percentage")
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		// Parse min exit fee percentage and holding period (optional; exit fee does not decrease if blank)
+		minExitFeePercentage, exitFeeHoldingPeriod, err := client.ParseHoldingPeriodExitFee(
+			req.MinExitFeePercentage, req.ExitFeeHoldingPeriod)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
 		maxSupply, err := client.ParseMaxSupply(req.MaxSupply, req.Token)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -160,9 +289,9 @@ func createBondHandler(cliCtx context.CLIContext) http.HandlerFunc {
 
 		msg := types.NewMsgCreateBond(req.Token, req.Name, req.Description,
 			creator, req.FunctionType, functionParams, reserveTokens,
-			txFeePercentageDec, exitFeePercentageDec, feeAddress, maxSupply,
-			orderQuantityLimits, sanityRate, sanityMarginPercentage,
-			req.AllowSells, signers, batchBlocks)
+			txFeePercentageDec, exitFeePercentageDec, feeAddress,
+			feeRecipients, holderRewardsPercentageDec, liquidityFeePercentageDec,
+			referralFeePercentageDec, minExitFeePercentage, exitFeeHoldingPeriod, maxSupply, orderQuantityLimits, sanityRate, sanityMarginPercentage, req.AllowSells, signers, batchBlocks)
 		err = msg.ValidateBasic()
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -224,16 +353,15 @@ func editBondHandler(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
-type buyReq struct {
-	BaseReq    rest.BaseReq `json:"base_req" yaml:"base_req"`
-	BondToken  string       `json:"bond_token" yaml:"bond_token"`
-	BondAmount string       `json:"bond_amount" yaml:"bond_amount"`
-	MaxPrices  string       `json:"max_prices" yaml:"max_prices"`
+type closeBondReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Token   string       `json:"token" yaml:"token"`
+	Signers string       `json:"signers" yaml:"signers"`
 }
 
-func buyHandler(cliCtx context.CLIContext) http.HandlerFunc {
+func closeBondHandler(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		var req buyReq
+		var req closeBondReq
 
 		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
@@ -245,25 +373,784 @@ func buyHandler(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		buyer, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		closer, err := sdk.AccAddressFromBech32(req.BaseReq.From)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 
-		bondCoin, err := client.ParseCoin(req.BondAmount, req.BondToken)
+		// Parse signers
+		signers, err := client.ParseSigners(req.Signers)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 
-		maxPrices, err := sdk.ParseCoins(req.MaxPrices)
+		msg := types.NewMsgCloseBond(req.Token, closer, signers)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type setBondPausedReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Token   string       `json:"token" yaml:"token"`
+	Paused  string       `json:"paused" yaml:"paused"`
+	Signers string       `json:"signers" yaml:"signers"`
+}
+
+func setBondPausedHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req setBondPausedReq
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		editor, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// Parse signers
+		signers, err := client.ParseSigners(req.Signers)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgSetBondPaused(req.Token, req.Paused, editor, signers)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type setCircuitBreakerReq struct {
+	BaseReq                rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Token                  string       `json:"token" yaml:"token"`
+	MaxPriceMovePercentage string       `json:"max_price_move_percentage" yaml:"max_price_move_percentage"`
+	CircuitBreakerMode     string       `json:"circuit_breaker_mode" yaml:"circuit_breaker_mode"`
+	CircuitBreakerCooldown string       `json:"circuit_breaker_cooldown" yaml:"circuit_breaker_cooldown"`
+	Signers                string       `json:"signers" yaml:"signers"`
+}
+
+func setCircuitBreakerHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req setCircuitBreakerReq
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		editor, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// Parse circuit breaker values
+		maxPriceMovePercentage, circuitBreakerCooldown, err := client.ParseCircuitBreakerValues(
+			req.MaxPriceMovePercentage, req.CircuitBreakerCooldown)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// Parse signers
+		signers, err := client.ParseSigners(req.Signers)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgSetCircuitBreaker(req.Token, maxPriceMovePercentage,
+			req.CircuitBreakerMode, circuitBreakerCooldown, editor, signers)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type updateBondRoleReq struct {
+	BaseReq   rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Token     string       `json:"token" yaml:"token"`
+	Role      string       `json:"role" yaml:"role"`
+	Addresses string       `json:"addresses" yaml:"addresses"`
+	Threshold string       `json:"threshold" yaml:"threshold"`
+	Signers   string       `json:"signers" yaml:"signers"`
+}
+
+func updateBondRoleHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req updateBondRoleReq
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		editor, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// Parse role addresses
+		addresses, err := client.ParseSigners(req.Addresses)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// Parse role threshold
+		threshold, err := client.ParseRoleThreshold(req.Threshold)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// Parse signers
+		signers, err := client.ParseSigners(req.Signers)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgUpdateBondRole(req.Token, req.Role, addresses,
+			threshold, editor, signers)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type setFeeRecipientsReq struct {
+	BaseReq       rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Token         string       `json:"token" yaml:"token"`
+	FeeRecipients string       `json:"fee_recipients" yaml:"fee_recipients"`
+	FeeAddress    string       `json:"fee_address" yaml:"fee_address"`
+	Signers       string       `json:"signers" yaml:"signers"`
+}
+
+func setFeeRecipientsHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req setFeeRecipientsReq
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		editor, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// Parse fee recipients
+		feeRecipients, err := client.ParseFeeRecipients(req.FeeRecipients)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 
-		msg := types.NewMsgBuy(buyer, bondCoin, maxPrices)
+		feeAddress, err := sdk.AccAddressFromBech32(req.FeeAddress)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// Parse signers
+		signers, err := client.ParseSigners(req.Signers)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgSetFeeRecipients(req.Token, feeRecipients,
+			feeAddress, editor, signers)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type setFeeScheduleReq struct {
+	BaseReq           rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Token             string       `json:"token" yaml:"token"`
+	SizeTiers         string       `json:"size_tiers" yaml:"size_tiers"`
+	VolatilityTiers   string       `json:"volatility_tiers" yaml:"volatility_tiers"`
+	VolatilityBatches string       `json:"volatility_batches" yaml:"volatility_batches"`
+	Signers           string       `json:"signers" yaml:"signers"`
+}
+
+func setFeeScheduleHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req setFeeScheduleReq
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		editor, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// Volatility batches are optional, since they are only
+		// required if volatility tiers are specified
+		volatilityBatches := req.VolatilityBatches
+		if volatilityBatches == "" {
+			volatilityBatches = "0"
+		}
+
+		// Parse fee schedule
+		feeSchedule, err := client.ParseFeeSchedule(
+			req.SizeTiers, req.VolatilityTiers, volatilityBatches)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// Parse signers
+		signers, err := client.ParseSigners(req.Signers)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgSetFeeSchedule(req.Token, feeSchedule, editor, signers)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type claimBondRewardsReq struct {
+	BaseReq   rest.BaseReq `json:"base_req" yaml:"base_req"`
+	BondToken string       `json:"bond_token" yaml:"bond_token"`
+}
+
+func claimBondRewardsHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req claimBondRewardsReq
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		claimer, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgClaimBondRewards(req.BondToken, claimer)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type setTapReq struct {
+	BaseReq            rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Token              string       `json:"token" yaml:"token"`
+	Beneficiary        string       `json:"beneficiary" yaml:"beneficiary"`
+	TapRate            string       `json:"tap_rate" yaml:"tap_rate"`
+	TapFloorPercentage string       `json:"tap_floor_percentage" yaml:"tap_floor_percentage"`
+	Signers            string       `json:"signers" yaml:"signers"`
+}
+
+func setTapHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req setTapReq
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		editor, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// Tap floor percentage is optional, defaulting to no floor
+		tapFloorPercentage := req.TapFloorPercentage
+		if tapFloorPercentage == "" {
+			tapFloorPercentage = "0"
+		}
+
+		// Parse tap values
+		beneficiary, tapRate, floorPercentage, err := client.ParseTapValues(
+			req.Beneficiary, req.TapRate, tapFloorPercentage)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// Parse signers
+		signers, err := client.ParseSigners(req.Signers)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgSetTap(req.Token, beneficiary, tapRate,
+			floorPercentage, editor, signers)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type withdrawTapReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Token   string       `json:"token" yaml:"token"`
+	Signers string       `json:"signers" yaml:"signers"`
+}
+
+func withdrawTapHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req withdrawTapReq
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		editor, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// Parse signers
+		signers, err := client.ParseSigners(req.Signers)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgWithdrawTap(req.Token, editor, signers)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type voteTapReq struct {
+	BaseReq   rest.BaseReq `json:"base_req" yaml:"base_req"`
+	BondToken string       `json:"bond_token" yaml:"bond_token"`
+	Option    string       `json:"option" yaml:"option"`
+}
+
+func voteTapHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req voteTapReq
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		voter, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgVoteTap(req.BondToken, req.Option, voter)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type refundReq struct {
+	BaseReq    rest.BaseReq `json:"base_req" yaml:"base_req"`
+	BondToken  string       `json:"bond_token" yaml:"bond_token"`
+	BondAmount string       `json:"bond_amount" yaml:"bond_amount"`
+}
+
+func refundHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req refundReq
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		holder, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		bondCoin, err := client.ParseCoin(req.BondAmount, req.BondToken)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgRefund(holder, bondCoin)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type setReserveStakingReq struct {
+	BaseReq              rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Token                string       `json:"token" yaml:"token"`
+	StakingCapPercentage string       `json:"staking_cap_percentage" yaml:"staking_cap_percentage"`
+	RewardsToReserve     string       `json:"rewards_to_reserve" yaml:"rewards_to_reserve"`
+	Signers              string       `json:"signers" yaml:"signers"`
+}
+
+func setReserveStakingHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req setReserveStakingReq
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		editor, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// Parse staking cap percentage
+		capPercentage, err := client.ParseStakingCapPercentage(req.StakingCapPercentage)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// Parse signers
+		signers, err := client.ParseSigners(req.Signers)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgSetReserveStaking(req.Token, capPercentage,
+			req.RewardsToReserve, editor, signers)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type reserveDelegationReq struct {
+	BaseReq   rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Token     string       `json:"token" yaml:"token"`
+	Validator string       `json:"validator" yaml:"validator"`
+	Amount    string       `json:"amount" yaml:"amount"`
+	Signers   string       `json:"signers" yaml:"signers"`
+}
+
+func delegateReserveHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req reserveDelegationReq
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		editor, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// Parse validator and amount
+		validator, amount, err := client.ParseReserveDelegationValues(req.Validator, req.Amount)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// Parse signers
+		signers, err := client.ParseSigners(req.Signers)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgDelegateReserve(req.Token, validator, amount, editor, signers)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+func undelegateReserveHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req reserveDelegationReq
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		editor, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// Parse validator and amount
+		validator, amount, err := client.ParseReserveDelegationValues(req.Validator, req.Amount)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// Parse signers
+		signers, err := client.ParseSigners(req.Signers)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgUndelegateReserve(req.Token, validator, amount, editor, signers)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type sweepRoundingSurplusReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Token   string       `json:"token" yaml:"token"`
+	Signers string       `json:"signers" yaml:"signers"`
+}
+
+func sweepRoundingSurplusHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req sweepRoundingSurplusReq
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		editor, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// Parse signers
+		signers, err := client.ParseSigners(req.Signers)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgSweepRoundingSurplus(req.Token, editor, signers)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type buyReq struct {
+	BaseReq    rest.BaseReq `json:"base_req" yaml:"base_req"`
+	BondToken  string       `json:"bond_token" yaml:"bond_token"`
+	BondAmount string       `json:"bond_amount" yaml:"bond_amount"`
+	MaxPrices  string       `json:"max_prices" yaml:"max_prices"`
+	Referrer   string       `json:"referrer" yaml:"referrer"`
+}
+
+func buyHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req buyReq
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		buyer, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		bondCoin, err := client.ParseCoin(req.BondAmount, req.BondToken)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		maxPrices, err := sdk.ParseCoins(req.MaxPrices)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// Parse referrer (optional)
+		var referrer sdk.AccAddress
+		if req.Referrer != "" {
+			referrer, err = sdk.AccAddressFromBech32(req.Referrer)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		msg := types.NewMsgBuy(buyer, bondCoin, maxPrices, referrer)
 		err = msg.ValidateBasic()
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
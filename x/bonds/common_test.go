@@ -2,6 +2,7 @@ package bonds_test
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
 	simapp "github.com/ixoworld/bonds/x/bonds/app"
 	"github.com/ixoworld/bonds/x/bonds/internal/types"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -64,6 +65,7 @@ func newSimpleBond() types.Bond {
 		Token:   token,
 		Creator: initCreator,
 		Signers: initSigners,
+		Roles:   types.NewDefaultBondRoles(initSigners),
 	}
 }
 
@@ -82,14 +84,20 @@ func newValidMsgCreateBond() types.MsgCreateBond {
 	return types.NewMsgCreateBond(token, initName, initDescription,
 		initCreator, functionType, functionParams, reserveTokens,
 		initTxFeePercentage, initExitFeePercentage, initFeeAddress,
-		initMaxSupply, initOrderQuantityLimits, initSanityRate,
+		nil, sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec(), sdk.Dec{}, sdk.ZeroUint(), initMaxSupply, initOrderQuantityLimits, initSanityRate,
 		initSanityMarginPercentage, initAllowSell, initSigners, initBatchBlocks)
 }
 
+func newValidMsgCreateStakingBond(stakingDenom string) types.MsgCreateBond {
+	validMsg := newValidMsgCreateBond()
+	validMsg.ReserveTokens = []string{stakingDenom}
+	return validMsg
+}
+
 func newValidMsgBuy(amount int64, maxPrice int64) types.MsgBuy {
 	amountCoin := sdk.NewInt64Coin(token, amount)
 	maxPrices := sdk.NewCoins(sdk.NewInt64Coin(reserveToken, maxPrice))
-	return types.NewMsgBuy(userAddress, amountCoin, maxPrices)
+	return types.NewMsgBuy(userAddress, amountCoin, maxPrices, nil)
 }
 
 func newValidMsgSell(amount int64) types.MsgSell {
@@ -106,3 +114,17 @@ func addCoinsToUser(app *simapp.SimApp, ctx sdk.Context, coins sdk.Coins) sdk.Er
 	_, err := app.BondsKeeper.CoinKeeper.AddCoins(ctx, userAddress, coins)
 	return err
 }
+
+func createValidator(app *simapp.SimApp, ctx sdk.Context) (sdk.ValAddress, sdk.Result) {
+	pubKey := ed25519.GenPrivKey().PubKey()
+	valAddress := sdk.ValAddress(pubKey.Address())
+	selfDelegation := sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), sdk.TokensFromConsensusPower(1))
+	_, err := app.BondsKeeper.CoinKeeper.AddCoins(ctx, sdk.AccAddress(valAddress), sdk.NewCoins(selfDelegation))
+	if err != nil {
+		return nil, err.Result()
+	}
+
+	msg := staking.NewMsgCreateValidator(valAddress, pubKey, selfDelegation,
+		staking.Description{}, staking.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.OneInt())
+	return valAddress, staking.NewHandler(app.StakingKeeper)(ctx, msg)
+}
@@ -5,15 +5,59 @@ import (
 )
 
 func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
+	// Initialise params
+	keeper.SetParams(ctx, data.Params)
+
 	// Initialise bonds
 	for _, b := range data.Bonds {
 		keeper.SetBond(ctx, b.Token, b)
 	}
+	keeper.RebuildBondIndexes(ctx)
 
 	// Initialise batches
 	for _, b := range data.Batches {
 		keeper.SetBatch(ctx, b.Token, b)
 	}
+	keeper.RebuildOrderAddressIndexes(ctx)
+	keeper.RebuildStakedReserveIndex(ctx)
+
+	// Initialise holder rewards
+	for _, hr := range data.HolderRewards {
+		keeper.SetHolderRewards(ctx, hr)
+	}
+
+	// Initialise holder lots
+	for _, hl := range data.HolderLots {
+		keeper.SetHolderLots(ctx, hl)
+	}
+
+	// Initialise tap votes
+	for _, tv := range data.TapVotes {
+		keeper.SetTapVote(ctx, tv)
+	}
+
+	// Initialise referrer totals
+	for _, rt := range data.ReferrerTotals {
+		keeper.SetReferrerTotal(ctx, rt)
+	}
+
+	// Initialise candles (sorted from oldest to newest for each bond)
+	for _, c := range data.Candles {
+		keeper.AddCandle(ctx, c)
+	}
+
+	// Initialise price observations
+	for _, po := range data.PriceObservations {
+		keeper.SetPriceObservation(ctx, po)
+	}
+
+	// Initialise bond stats and buyers
+	for _, bs := range data.BondStats {
+		keeper.SetBondStats(ctx, bs)
+	}
+	for _, bb := range data.BondBuyers {
+		keeper.SetBondBuyer(ctx, bb)
+	}
 }
 
 func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
@@ -28,8 +72,79 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 		batches = append(batches, batch)
 	}
 
+	// Export holder rewards
+	var holderRewards []HolderRewards
+	hrIterator := k.GetAllHolderRewardsIterator(ctx)
+	for ; hrIterator.Valid(); hrIterator.Next() {
+		holderRewards = append(holderRewards,
+			k.MustGetHolderRewardsByKey(ctx, hrIterator.Key()))
+	}
+
+	// Export holder lots
+	var holderLots []HolderLots
+	hlIterator := k.GetAllHolderLotsIterator(ctx)
+	for ; hlIterator.Valid(); hlIterator.Next() {
+		holderLots = append(holderLots,
+			k.MustGetHolderLotsByKey(ctx, hlIterator.Key()))
+	}
+
+	// Export tap votes
+	var tapVotes []TapVote
+	tvIterator := k.GetAllTapVotesIterator(ctx)
+	for ; tvIterator.Valid(); tvIterator.Next() {
+		tapVotes = append(tapVotes,
+			k.MustGetTapVoteByKey(ctx, tvIterator.Key()))
+	}
+
+	// Export referrer totals
+	var referrerTotals []ReferrerTotal
+	rtIterator := k.GetAllReferrerTotalsIterator(ctx)
+	for ; rtIterator.Valid(); rtIterator.Next() {
+		referrerTotals = append(referrerTotals,
+			k.MustGetReferrerTotalByKey(ctx, rtIterator.Key()))
+	}
+
+	// Export candles
+	var candles []Candle
+	cIterator := k.GetAllCandlesIterator(ctx)
+	for ; cIterator.Valid(); cIterator.Next() {
+		candles = append(candles,
+			k.MustGetCandleByKey(ctx, cIterator.Key()))
+	}
+
+	// Export price observations
+	var priceObservations []PriceObservation
+	poIterator := k.GetAllPriceObservationsIterator(ctx)
+	for ; poIterator.Valid(); poIterator.Next() {
+		priceObservations = append(priceObservations,
+			k.MustGetPriceObservationByKey(ctx, poIterator.Key()))
+	}
+
+	// Export bond stats and buyers
+	var bondStats []BondStats
+	bsIterator := k.GetAllBondStatsIterator(ctx)
+	for ; bsIterator.Valid(); bsIterator.Next() {
+		bondStats = append(bondStats,
+			k.MustGetBondStatsByKey(ctx, bsIterator.Key()))
+	}
+	var bondBuyers []BondBuyer
+	bbIterator := k.GetAllBondBuyersIterator(ctx)
+	for ; bbIterator.Valid(); bbIterator.Next() {
+		bondBuyers = append(bondBuyers,
+			k.MustGetBondBuyerByKey(ctx, bbIterator.Key()))
+	}
+
 	return GenesisState{
-		Bonds:   bonds,
-		Batches: batches,
+		Bonds:             bonds,
+		Batches:           batches,
+		HolderRewards:     holderRewards,
+		HolderLots:        holderLots,
+		TapVotes:          tapVotes,
+		ReferrerTotals:    referrerTotals,
+		Candles:           candles,
+		PriceObservations: priceObservations,
+		BondStats:         bondStats,
+		BondBuyers:        bondBuyers,
+		Params:            k.GetParams(ctx),
 	}
 }
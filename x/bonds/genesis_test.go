@@ -14,6 +14,7 @@ func TestInitAndExportGenesis(t *testing.T) {
 	genesisState := bonds.DefaultGenesisState()
 	require.Equal(t, 0, len(genesisState.Bonds))
 	require.Equal(t, 0, len(genesisState.Batches))
+	require.Equal(t, 0, len(genesisState.HolderRewards))
 
 	token := "testtoken"
 	name := "test token"
@@ -48,18 +49,79 @@ func TestInitAndExportGenesis(t *testing.T) {
 		sanityMarginPercentage, allowSell, signers, batchBlocks)
 	batch := types.NewBatch(bond.Token, bond.BatchBlocks)
 
+	holder := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	holderRewards := types.NewHolderRewards(token, holder)
+	holderRewards.Unclaimed = sdk.NewDecCoins(sdk.NewCoins(sdk.NewInt64Coin("reservetoken", 5)))
+
+	holderLots := types.NewHolderLots(token, holder).Add(1, sdk.NewInt(5))
+
+	tapVote := types.NewTapVote(token, holder, types.TapVoteDissolve)
+
+	referrerTotal := types.NewReferrerTotal(token, holder).AddPayout(
+		sdk.NewCoins(sdk.NewInt64Coin("reservetoken", 2)))
+
+	prices := sdk.NewDecCoins(sdk.NewCoins(sdk.NewInt64Coin("reservetoken", 100)))
+	candle := types.NewBatchCandle(100, 105, prices, prices, batch)
+	priceObservation := types.NewPriceObservation(token, 100, prices, nil)
+
+	bondStats := types.NewBondStats(token).AddBuy(sdk.NewInt(5),
+		sdk.NewCoins(sdk.NewInt64Coin("reservetoken", 1)), true)
+	bondBuyer := types.NewBondBuyer(token, holder)
+
+	params := types.DefaultParams()
+	params.MaxOrdersPerBatch = 10
+
 	genesisState = bonds.NewGenesisState(
-		[]types.Bond{bond}, []types.Batch{batch})
+		[]types.Bond{bond}, []types.Batch{batch},
+		[]types.HolderRewards{holderRewards},
+		[]types.HolderLots{holderLots}, []types.TapVote{tapVote},
+		[]types.ReferrerTotal{referrerTotal}, []types.Candle{candle},
+		[]types.PriceObservation{priceObservation},
+		[]types.BondStats{bondStats}, []types.BondBuyer{bondBuyer}, params)
 
 	bonds.InitGenesis(ctx, app.BondsKeeper, genesisState)
 
 	returnedBond := app.BondsKeeper.MustGetBond(ctx, token)
 	require.Equal(t, bond, returnedBond)
+	require.Equal(t, []string{token}, app.BondsKeeper.GetBondTokensByCreator(ctx, creator))
+	require.Equal(t, []string{token}, app.BondsKeeper.GetBondTokensByReserveDenom(ctx, reserveTokens[0]))
 
 	returnedBatch := app.BondsKeeper.MustGetBatch(ctx, token)
 	require.Equal(t, batch, returnedBatch)
 
+	returnedHolderRewards := app.BondsKeeper.GetHolderRewards(ctx, token, holder)
+	require.Equal(t, holderRewards, returnedHolderRewards)
+
+	returnedHolderLots := app.BondsKeeper.GetHolderLots(ctx, token, holder)
+	require.Equal(t, holderLots, returnedHolderLots)
+
+	returnedTapVote, found := app.BondsKeeper.GetTapVote(ctx, token, holder)
+	require.True(t, found)
+	require.Equal(t, tapVote, returnedTapVote)
+
+	returnedReferrerTotal := app.BondsKeeper.GetReferrerTotal(ctx, token, holder)
+	require.Equal(t, referrerTotal, returnedReferrerTotal)
+
+	require.Equal(t, []types.Candle{candle}, app.BondsKeeper.GetCandles(ctx, token))
+	require.Equal(t, []types.PriceObservation{priceObservation},
+		app.BondsKeeper.GetPriceObservations(ctx, token))
+
+	require.Equal(t, bondStats, app.BondsKeeper.GetBondStats(ctx, token))
+	require.True(t, app.BondsKeeper.IsBondBuyer(ctx, token, holder))
+
+	returnedParams := app.BondsKeeper.GetParams(ctx)
+	require.Equal(t, params.String(), returnedParams.String())
+
 	exportedGenesisState := bonds.ExportGenesis(ctx, app.BondsKeeper)
 	require.Equal(t, genesisState.Bonds, exportedGenesisState.Bonds)
 	require.Equal(t, genesisState.Batches, exportedGenesisState.Batches)
+	require.Equal(t, genesisState.HolderRewards, exportedGenesisState.HolderRewards)
+	require.Equal(t, genesisState.HolderLots, exportedGenesisState.HolderLots)
+	require.Equal(t, genesisState.TapVotes, exportedGenesisState.TapVotes)
+	require.Equal(t, genesisState.ReferrerTotals, exportedGenesisState.ReferrerTotals)
+	require.Equal(t, genesisState.Candles, exportedGenesisState.Candles)
+	require.Equal(t, genesisState.PriceObservations, exportedGenesisState.PriceObservations)
+	require.Equal(t, genesisState.BondStats, exportedGenesisState.BondStats)
+	require.Equal(t, genesisState.BondBuyers, exportedGenesisState.BondBuyers)
+	require.Equal(t, genesisState.Params.String(), exportedGenesisState.Params.String())
 }
@@ -3,6 +3,7 @@ package bonds
 import (
 	"fmt"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/ixoworld/bonds/x/bonds/internal/keeper"
 	"github.com/ixoworld/bonds/x/bonds/internal/types"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -16,6 +17,36 @@ func NewHandler(keeper keeper.Keeper) sdk.Handler {
 			return handleMsgCreateBond(ctx, keeper, msg)
 		case types.MsgEditBond:
 			return handleMsgEditBond(ctx, keeper, msg)
+		case types.MsgCloseBond:
+			return handleMsgCloseBond(ctx, keeper, msg)
+		case types.MsgSetBondPaused:
+			return handleMsgSetBondPaused(ctx, keeper, msg)
+		case types.MsgSetCircuitBreaker:
+			return handleMsgSetCircuitBreaker(ctx, keeper, msg)
+		case types.MsgUpdateBondRole:
+			return handleMsgUpdateBondRole(ctx, keeper, msg)
+		case types.MsgSetFeeRecipients:
+			return handleMsgSetFeeRecipients(ctx, keeper, msg)
+		case types.MsgSetFeeSchedule:
+			return handleMsgSetFeeSchedule(ctx, keeper, msg)
+		case types.MsgClaimBondRewards:
+			return handleMsgClaimBondRewards(ctx, keeper, msg)
+		case types.MsgSetTap:
+			return handleMsgSetTap(ctx, keeper, msg)
+		case types.MsgWithdrawTap:
+			return handleMsgWithdrawTap(ctx, keeper, msg)
+		case types.MsgVoteTap:
+			return handleMsgVoteTap(ctx, keeper, msg)
+		case types.MsgRefund:
+			return handleMsgRefund(ctx, keeper, msg)
+		case types.MsgSetReserveStaking:
+			return handleMsgSetReserveStaking(ctx, keeper, msg)
+		case types.MsgDelegateReserve:
+			return handleMsgDelegateReserve(ctx, keeper, msg)
+		case types.MsgUndelegateReserve:
+			return handleMsgUndelegateReserve(ctx, keeper, msg)
+		case types.MsgSweepRoundingSurplus:
+			return handleMsgSweepRoundingSurplus(ctx, keeper, msg)
 		case types.MsgBuy:
 			return handleMsgBuy(ctx, keeper, msg)
 		case types.MsgSell:
@@ -29,6 +60,17 @@ func NewHandler(keeper keeper.Keeper) sdk.Handler {
 	}
 }
 
+func BeginBlocker(ctx sdk.Context, keeper keeper.Keeper) {
+
+	// Record any losses of staked reserves (e.g. due to slashing, which is
+	// applied in the slashing module's begin-blocker before this one). Only
+	// bonds with delegated or unbonding reserve can have such losses.
+	for _, token := range keeper.GetBondTokensWithStakedReserve(ctx) {
+		keeper.SyncStakedReserve(ctx, token)
+		keeper.UpdateStakedReserveIndex(ctx, token)
+	}
+}
+
 func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) []abci.ValidatorUpdate {
 
 	iterator := keeper.GetBondIterator(ctx)
@@ -36,6 +78,42 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) []abci.ValidatorUpdate {
 		bond := keeper.MustGetBondByKey(ctx, iterator.Key())
 		batch := keeper.MustGetBatch(ctx, bond.Token)
 
+		// If bond was halted by its circuit breaker, count down the cooldown
+		halted := bond.IsHalted()
+		if halted {
+			bond.HaltBlocksRemaining = bond.HaltBlocksRemaining.Sub(sdk.OneUint())
+			keeper.SetBond(ctx, bond.Token, bond)
+		}
+
+		// Pay out any queued sells that can be paid out using the liquid
+		// reserve, and undelegate enough of the reserve for the rest
+		if bond.HasReserveStaking() {
+			keeper.PayQueuedSells(ctx, bond.Token)
+			keeper.UndelegateForQueuedSells(ctx, bond.Token)
+			keeper.UndelegateDissolvedReserve(ctx, bond.Token)
+		}
+
+		// If bond is dissolved, paused or halted, refund any pending orders instead
+		// of performing them, and do not count down the blocks remaining in the batch
+		if bond.IsDissolved() || bond.IsPaused() || halted {
+			if batch.NumberOfOrders() != 0 {
+				cancelReason := types.ErrBondIsPaused(types.DefaultCodespace, bond.Token).Error()
+				if bond.IsDissolved() {
+					cancelReason = types.ErrBondIsDissolved(types.DefaultCodespace, bond.Token).Error()
+				} else if !bond.IsPaused() {
+					cancelReason = types.ErrBondIsHalted(types.DefaultCodespace,
+						bond.Token, bond.HaltBlocksRemaining).Error()
+				}
+				keeper.CancelAllOrders(ctx, bond.Token, cancelReason)
+
+				// Save current as last and reset current
+				batch = keeper.MustGetBatch(ctx, bond.Token)
+				keeper.SetLastBatch(ctx, bond.Token, batch)
+				keeper.SetBatch(ctx, bond.Token, types.NewBatch(bond.Token, bond.BatchBlocks))
+			}
+			continue
+		}
+
 		// Subtract one block
 		batch.BlocksRemaining = batch.BlocksRemaining.SubUint64(1)
 		keeper.SetBatch(ctx, bond.Token, batch)
@@ -45,9 +123,29 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) []abci.ValidatorUpdate {
 			continue
 		}
 
+		// Get pre-batch prices, used as the open prices of the batch's candle
+		openPrices, _ := bond.GetCurrentPricesPT(keeper.GetReserveBalances(ctx, bond.Token))
+
+		// Cancel orders or halt bond if the batch moves the price too much
+		keeper.ApplyCircuitBreaker(ctx, bond.Token)
+
 		// Perform orders
 		keeper.PerformOrders(ctx, bond.Token)
 
+		// Record post-batch prices, used to measure the bond's volatility
+		keeper.RecordBatchPrices(ctx, bond.Token)
+
+		// Add the performed batch to the bond's price and volume history
+		keeper.RecordBatchCandle(ctx, bond.Token, openPrices)
+
+		// Accumulate post-batch prices, used to get time-weighted averages
+		keeper.RecordPriceObservation(ctx, bond.Token)
+
+		// Collect staking rewards earned by any staked reserve
+		if bond.HasReserveStaking() {
+			keeper.CollectStakingRewards(ctx, bond.Token)
+		}
+
 		// Get batch again just in case orders were cancelled
 		batch = keeper.MustGetBatch(ctx, bond.Token)
 
@@ -62,17 +160,72 @@ func handleMsgCreateBond(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgCre
 
 	if keeper.BondExists(ctx, msg.Token) {
 		return types.ErrBondAlreadyExists(DefaultCodeSpace, msg.Token).Result()
-	} else if msg.Token == keeper.StakingKeeper.GetParams(ctx).BondDenom {
-		return types.ErrBondTokenCannotBeStakingToken(DefaultCodeSpace).Result()
 	}
 
-	reserveAddress := keeper.GetNextUnusedReserveAddress(ctx)
+	// Check bond against module parameters
+	params := keeper.GetParams(ctx)
+	if params.BondDenomIsDenied(msg.Token) {
+		return types.ErrBondTokenIsDenylisted(DefaultCodeSpace, msg.Token).Result()
+	}
+	for _, r := range msg.ReserveTokens {
+		if params.ReserveDenomIsDenied(r) {
+			return types.ErrReserveTokenIsDenylisted(DefaultCodeSpace, r).Result()
+		}
+	}
+	if msg.TxFeePercentage.GT(params.MaxTxFeePercentage) {
+		return types.ErrFeeExceedsMaxFee(DefaultCodeSpace,
+			"Tx fee percentage", params.MaxTxFeePercentage).Result()
+	} else if msg.ExitFeePercentage.GT(params.MaxExitFeePercentage) {
+		return types.ErrFeeExceedsMaxFee(DefaultCodeSpace,
+			"Exit fee percentage", params.MaxExitFeePercentage).Result()
+	}
+	if msg.BatchBlocks.LT(params.MinBatchBlocks) || msg.BatchBlocks.GT(params.MaxBatchBlocks) {
+		return types.ErrBatchBlocksOutOfRange(DefaultCodeSpace,
+			params.MinBatchBlocks, params.MaxBatchBlocks).Result()
+	}
+
+	// Charge bond creation fee (if any) and send it to the community pool
+	if !params.BondCreationFee.IsZero() {
+		err := keeper.DistributionKeeper.FundCommunityPool(ctx,
+			params.BondCreationFee, msg.Creator)
+		if err != nil {
+			return sdk.ErrInsufficientCoins(err.Error()).Result()
+		}
+	}
+
+	// Take bond creation deposit (if any) and hold it until the bond is closed
+	if !params.BondCreationDeposit.IsZero() {
+		err := keeper.SupplyKeeper.SendCoinsFromAccountToModule(ctx,
+			msg.Creator, types.BondsDepositAccount, params.BondCreationDeposit)
+		if err != nil {
+			return err.Result()
+		}
+	}
+
+	reserveAddress := types.GetReserveAddress(msg.Token)
 
 	bond := NewBond(msg.Token, msg.Name, msg.Description, msg.Creator,
 		msg.FunctionType, msg.FunctionParameters, msg.ReserveTokens,
 		reserveAddress, msg.TxFeePercentage, msg.ExitFeePercentage,
 		msg.FeeAddress, msg.MaxSupply, msg.OrderQuantityLimits, msg.SanityRate,
 		msg.SanityMarginPercentage, msg.AllowSells, msg.Signers, msg.BatchBlocks)
+	bond.Deposit = params.BondCreationDeposit
+	if len(msg.FeeRecipients) != 0 {
+		bond.FeeRecipients = msg.FeeRecipients
+	}
+	if !msg.HolderRewardsPercentage.IsNil() {
+		bond.HolderRewardsPercentage = msg.HolderRewardsPercentage
+	}
+	if !msg.LiquidityFeePercentage.IsNil() {
+		bond.LiquidityFeePercentage = msg.LiquidityFeePercentage
+	}
+	if !msg.ReferralFeePercentage.IsNil() {
+		bond.ReferralFeePercentage = msg.ReferralFeePercentage
+	}
+	if !msg.MinExitFeePercentage.IsNil() {
+		bond.MinExitFeePercentage = msg.MinExitFeePercentage
+		bond.ExitFeeHoldingPeriod = msg.ExitFeeHoldingPeriod
+	}
 
 	keeper.SetBond(ctx, msg.Token, bond)
 	keeper.SetBatch(ctx, msg.Token, types.NewBatch(bond.Token, msg.BatchBlocks))
@@ -94,6 +247,12 @@ func handleMsgCreateBond(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgCre
 			sdk.NewAttribute(types.AttributeKeyTxFeePercentage, msg.TxFeePercentage.String()),
 			sdk.NewAttribute(types.AttributeKeyExitFeePercentage, msg.ExitFeePercentage.String()),
 			sdk.NewAttribute(types.AttributeKeyFeeAddress, msg.FeeAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyFeeRecipients, bond.FeeRecipients.String()),
+			sdk.NewAttribute(types.AttributeKeyHolderRewardsPercentage, bond.HolderRewardsPercentage.String()),
+			sdk.NewAttribute(types.AttributeKeyLiquidityFeePercentage, bond.LiquidityFeePercentage.String()),
+			sdk.NewAttribute(types.AttributeKeyReferralFeePercentage, bond.ReferralFeePercentage.String()),
+			sdk.NewAttribute(types.AttributeKeyMinExitFeePercentage, bond.MinExitFeePercentage.String()),
+			sdk.NewAttribute(types.AttributeKeyExitFeeHoldingPeriod, bond.ExitFeeHoldingPeriod.String()),
 			sdk.NewAttribute(types.AttributeKeyMaxSupply, msg.MaxSupply.String()),
 			sdk.NewAttribute(types.AttributeKeyOrderQuantityLimits, msg.OrderQuantityLimits.String()),
 			sdk.NewAttribute(types.AttributeKeySanityRate, msg.SanityRate.String()),
@@ -101,6 +260,8 @@ func handleMsgCreateBond(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgCre
 			sdk.NewAttribute(types.AttributeKeyAllowSells, msg.AllowSells),
 			sdk.NewAttribute(types.AttributeKeySigners, types.AccAddressesToString(msg.Signers)),
 			sdk.NewAttribute(types.AttributeKeyBatchBlocks, msg.BatchBlocks.String()),
+			sdk.NewAttribute(types.AttributeKeyCreationFee, params.BondCreationFee.String()),
+			sdk.NewAttribute(types.AttributeKeyDeposit, params.BondCreationDeposit.String()),
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -119,9 +280,8 @@ func handleMsgEditBond(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgEditB
 		return types.ErrBondDoesNotExist(types.DefaultCodespace, msg.Token).Result()
 	}
 
-	if !bond.SignersEqualTo(msg.Signers) {
-		errMsg := fmt.Sprintf("List of signers does not match the one in the bond")
-		return sdk.ErrInternal(errMsg).Result()
+	if !bond.RoleAuthorizes(types.RoleMetadataEditor, msg.Signers) {
+		return types.ErrSignersNotAuthorizedForRole(types.DefaultCodespace, types.RoleMetadataEditor).Result()
 	}
 
 	if msg.Name != types.DoNotModifyField {
@@ -190,6 +350,740 @@ func handleMsgEditBond(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgEditB
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
 
+func handleMsgCloseBond(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgCloseBond) sdk.Result {
+
+	bond, found := keeper.GetBond(ctx, msg.Token)
+	if !found {
+		return types.ErrBondDoesNotExist(types.DefaultCodespace, msg.Token).Result()
+	}
+
+	if !bond.RoleAuthorizes(types.RoleAdmin, msg.Signers) {
+		return types.ErrSignersNotAuthorizedForRole(types.DefaultCodespace, types.RoleAdmin).Result()
+	}
+
+	// Bond can only be closed if there are no bond tokens in circulation
+	// and if there are no orders waiting to be performed
+	if !bond.CurrentSupply.IsZero() {
+		return types.ErrBondHasNonZeroSupply(types.DefaultCodespace, bond.CurrentSupply).Result()
+	} else if keeper.MustGetBatch(ctx, msg.Token).NumberOfOrders() != 0 {
+		return types.ErrBondHasPendingOrders(types.DefaultCodespace).Result()
+	}
+
+	// Bond can also only be closed once none of its reserve is staked and
+	// all queued sells have been paid out
+	if bond.HasReserveStaking() {
+		staked := keeper.GetStakedReserve(ctx, msg.Token)
+		if !staked.IsZero() {
+			return types.ErrBondHasStakedReserve(types.DefaultCodespace, sdk.Coins{
+				sdk.NewCoin(bond.ReserveTokens[0], staked)}).Result()
+		} else if bond.Staking.HasQueuedSells() {
+			return types.ErrBondHasQueuedSells(types.DefaultCodespace).Result()
+		}
+	}
+
+	// Return deposit to bond creator
+	if !bond.Deposit.IsZero() {
+		err := keeper.SupplyKeeper.SendCoinsFromModuleToAccount(ctx,
+			types.BondsDepositAccount, bond.Creator, bond.Deposit)
+		if err != nil {
+			return err.Result()
+		}
+	}
+
+	// Pay out any unclaimed holder rewards and sweep any remaining rewards
+	// pool (i.e. rounding dust) to the fee address
+	err := keeper.PayOutAllHolderRewards(ctx, msg.Token)
+	if err != nil {
+		return err.Result()
+	}
+	sweptRewards := keeper.MustGetBond(ctx, msg.Token).RewardsPool
+	if !sweptRewards.IsZero() {
+		err := keeper.SupplyKeeper.SendCoinsFromModuleToAccount(ctx,
+			types.BondsRewardsAccount, bond.FeeAddress, sweptRewards)
+		if err != nil {
+			return err.Result()
+		}
+	}
+
+	// Sweep any remaining reserve (e.g. rounding dust) and any reserve
+	// surplus (e.g. coins sent directly to the reserve) to the fee address
+	sweptReserve := keeper.GetActualReserveBalances(ctx, msg.Token)
+	if !sweptReserve.IsZero() {
+		err := keeper.CoinKeeper.SendCoins(ctx, bond.ReserveAddress,
+			bond.FeeAddress, sweptReserve)
+		if err != nil {
+			return err.Result()
+		}
+	}
+
+	// Delete bond and its batches
+	keeper.DeleteBond(ctx, msg.Token)
+	keeper.DeleteBatch(ctx, msg.Token)
+	keeper.DeleteLastBatch(ctx, msg.Token)
+	keeper.DeleteTapVotes(ctx, msg.Token, "")
+	keeper.DeleteReferrerTotals(ctx, msg.Token)
+	keeper.DeleteCandles(ctx, msg.Token)
+	keeper.DeletePriceObservations(ctx, msg.Token)
+	keeper.DeleteBondStats(ctx, msg.Token)
+
+	logger := keeper.Logger(ctx)
+	logger.Info(fmt.Sprintf("bond %s closed by %s",
+		msg.Token, msg.Closer.String()))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCloseBond,
+			sdk.NewAttribute(types.AttributeKeyBond, msg.Token),
+			sdk.NewAttribute(types.AttributeKeyDeposit, bond.Deposit.String()),
+			sdk.NewAttribute(types.AttributeKeySweptReserve, sweptReserve.String()),
+			sdk.NewAttribute(types.AttributeKeySweptRewards, sweptRewards.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Closer.String()),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgSetBondPaused(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgSetBondPaused) sdk.Result {
+
+	bond, found := keeper.GetBond(ctx, msg.Token)
+	if !found {
+		return types.ErrBondDoesNotExist(types.DefaultCodespace, msg.Token).Result()
+	}
+
+	if !bond.RoleAuthorizes(types.RolePauser, msg.Signers) {
+		return types.ErrSignersNotAuthorizedForRole(types.DefaultCodespace, types.RolePauser).Result()
+	}
+
+	bond.Paused = msg.Paused
+	keeper.SetBond(ctx, msg.Token, bond)
+
+	logger := keeper.Logger(ctx)
+	logger.Info(fmt.Sprintf("bond %s paused set to %s by %s",
+		msg.Token, msg.Paused, msg.Editor.String()))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetPaused,
+			sdk.NewAttribute(types.AttributeKeyBond, msg.Token),
+			sdk.NewAttribute(types.AttributeKeyPaused, msg.Paused),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Editor.String()),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgSetCircuitBreaker(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgSetCircuitBreaker) sdk.Result {
+
+	bond, found := keeper.GetBond(ctx, msg.Token)
+	if !found {
+		return types.ErrBondDoesNotExist(types.DefaultCodespace, msg.Token).Result()
+	}
+
+	if !bond.RoleAuthorizes(types.RolePauser, msg.Signers) {
+		return types.ErrSignersNotAuthorizedForRole(types.DefaultCodespace, types.RolePauser).Result()
+	}
+
+	bond.MaxPriceMovePercentage = msg.MaxPriceMovePercentage
+	bond.CircuitBreakerMode = msg.CircuitBreakerMode
+	bond.CircuitBreakerCooldown = msg.CircuitBreakerCooldown
+	keeper.SetBond(ctx, msg.Token, bond)
+
+	logger := keeper.Logger(ctx)
+	logger.Info(fmt.Sprintf("bond %s circuit breaker set by %s",
+		msg.Token, msg.Editor.String()))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetCircuitBreaker,
+			sdk.NewAttribute(types.AttributeKeyBond, msg.Token),
+			sdk.NewAttribute(types.AttributeKeyMaxPriceMovePercentage, msg.MaxPriceMovePercentage.String()),
+			sdk.NewAttribute(types.AttributeKeyCircuitBreakerMode, msg.CircuitBreakerMode),
+			sdk.NewAttribute(types.AttributeKeyCircuitBreakerCooldown, msg.CircuitBreakerCooldown.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Editor.String()),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgUpdateBondRole(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgUpdateBondRole) sdk.Result {
+
+	bond, found := keeper.GetBond(ctx, msg.Token)
+	if !found {
+		return types.ErrBondDoesNotExist(types.DefaultCodespace, msg.Token).Result()
+	}
+
+	if !bond.RoleAuthorizes(types.RoleAdmin, msg.Signers) {
+		return types.ErrSignersNotAuthorizedForRole(types.DefaultCodespace, types.RoleAdmin).Result()
+	}
+
+	// Replace the role's addresses and threshold, which grants the role to
+	// any new addresses and revokes it from any addresses left out
+	role := types.NewBondRole(msg.Role, msg.Addresses, msg.Threshold)
+	bond.Roles = bond.Roles.Set(role)
+	keeper.SetBond(ctx, msg.Token, bond)
+
+	logger := keeper.Logger(ctx)
+	logger.Info(fmt.Sprintf("bond %s role %s updated by %s",
+		msg.Token, msg.Role, msg.Editor.String()))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUpdateRole,
+			sdk.NewAttribute(types.AttributeKeyBond, msg.Token),
+			sdk.NewAttribute(types.AttributeKeyRole, msg.Role),
+			sdk.NewAttribute(types.AttributeKeyAddresses, types.AccAddressesToString(msg.Addresses)),
+			sdk.NewAttribute(types.AttributeKeyThreshold, msg.Threshold.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Editor.String()),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgSetFeeRecipients(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgSetFeeRecipients) sdk.Result {
+
+	bond, found := keeper.GetBond(ctx, msg.Token)
+	if !found {
+		return types.ErrBondDoesNotExist(types.DefaultCodespace, msg.Token).Result()
+	}
+
+	if !bond.RoleAuthorizes(types.RoleFeeManager, msg.Signers) {
+		return types.ErrSignersNotAuthorizedForRole(types.DefaultCodespace, types.RoleFeeManager).Result()
+	}
+
+	// Replace the fee recipients and the fee address, which receives any
+	// rounding remainders when fees are split among the recipients
+	bond.FeeRecipients = msg.FeeRecipients
+	bond.FeeAddress = msg.FeeAddress
+	keeper.SetBond(ctx, msg.Token, bond)
+
+	logger := keeper.Logger(ctx)
+	logger.Info(fmt.Sprintf("bond %s fee recipients set by %s",
+		msg.Token, msg.Editor.String()))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetFeeRecipients,
+			sdk.NewAttribute(types.AttributeKeyBond, msg.Token),
+			sdk.NewAttribute(types.AttributeKeyFeeRecipients, msg.FeeRecipients.String()),
+			sdk.NewAttribute(types.AttributeKeyFeeAddress, msg.FeeAddress.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Editor.String()),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgSetFeeSchedule(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgSetFeeSchedule) sdk.Result {
+
+	bond, found := keeper.GetBond(ctx, msg.Token)
+	if !found {
+		return types.ErrBondDoesNotExist(types.DefaultCodespace, msg.Token).Result()
+	}
+
+	if !bond.RoleAuthorizes(types.RoleFeeManager, msg.Signers) {
+		return types.ErrSignersNotAuthorizedForRole(types.DefaultCodespace, types.RoleFeeManager).Result()
+	}
+
+	// Check that the largest fees that the schedule can charge are still
+	// within the module parameters and add up to less than 100 percent
+	params := keeper.GetParams(ctx)
+	maxMultiplier := msg.FeeSchedule.GetMaxMultiplier()
+	maxTxFeePercentage := bond.TxFeePercentage.Mul(maxMultiplier)
+	maxExitFeePercentage := bond.ExitFeePercentage.Mul(maxMultiplier)
+	if maxTxFeePercentage.GT(params.MaxTxFeePercentage) {
+		return types.ErrFeeExceedsMaxFee(DefaultCodeSpace,
+			"Tx fee percentage", params.MaxTxFeePercentage).Result()
+	} else if maxExitFeePercentage.GT(params.MaxExitFeePercentage) {
+		return types.ErrFeeExceedsMaxFee(DefaultCodeSpace,
+			"Exit fee percentage", params.MaxExitFeePercentage).Result()
+	} else if maxTxFeePercentage.Add(maxExitFeePercentage).GTE(sdk.NewDec(100)) {
+		return types.ErrFeesCannotBeOrExceed100Percent(DefaultCodeSpace).Result()
+	}
+
+	// Replace the fee schedule and drop any recorded prices that fall
+	// outside of the new schedule's volatility window
+	bond.FeeSchedule = msg.FeeSchedule
+	bond = bond.TrimRecentPrices()
+	keeper.SetBond(ctx, msg.Token, bond)
+
+	logger := keeper.Logger(ctx)
+	logger.Info(fmt.Sprintf("bond %s fee schedule set by %s",
+		msg.Token, msg.Editor.String()))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetFeeSchedule,
+			sdk.NewAttribute(types.AttributeKeyBond, msg.Token),
+			sdk.NewAttribute(types.AttributeKeyFeeSchedule, msg.FeeSchedule.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Editor.String()),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgClaimBondRewards(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgClaimBondRewards) sdk.Result {
+
+	if !keeper.BondExists(ctx, msg.Token) {
+		return types.ErrBondDoesNotExist(types.DefaultCodespace, msg.Token).Result()
+	}
+
+	claimed, err := keeper.ClaimHolderRewards(ctx, msg.Token, msg.Claimer)
+	if err != nil {
+		return err.Result()
+	}
+
+	logger := keeper.Logger(ctx)
+	logger.Info(fmt.Sprintf("%s claimed %s in bond %s holder rewards",
+		msg.Claimer.String(), claimed.String(), msg.Token))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeClaimRewards,
+			sdk.NewAttribute(types.AttributeKeyBond, msg.Token),
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Claimer.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, claimed.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Claimer.String()),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgSetTap(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgSetTap) sdk.Result {
+
+	bond, found := keeper.GetBond(ctx, msg.Token)
+	if !found {
+		return types.ErrBondDoesNotExist(types.DefaultCodespace, msg.Token).Result()
+	}
+
+	if !bond.RoleAuthorizes(types.RoleAdmin, msg.Signers) {
+		return types.ErrSignersNotAuthorizedForRole(types.DefaultCodespace, types.RoleAdmin).Result()
+	}
+
+	// Swapper reserves are not priced by a curve, so they have no floor
+	if bond.FunctionType == types.SwapperFunction {
+		return types.ErrFunctionNotAvailableForFunctionType(types.DefaultCodespace).Result()
+	} else if bond.IsDissolved() {
+		return types.ErrBondIsDissolved(types.DefaultCodespace, msg.Token).Result()
+	}
+
+	height := ctx.BlockHeight()
+	if !bond.HasTap() {
+		tap := types.NewTap(msg.Beneficiary, msg.Rate, msg.FloorPercentage, height)
+		bond.Tap = &tap
+	} else {
+		// The floor protects sellers, so it can be raised but never lowered
+		if msg.FloorPercentage.LT(bond.Tap.FloorPercentage) {
+			return types.ErrTapFloorCannotBeLowered(types.DefaultCodespace,
+				msg.FloorPercentage, bond.Tap.FloorPercentage).Result()
+		}
+
+		// Lock in the amount accrued at the current rate. The rate can be
+		// lowered directly, but a higher rate is only proposed, and is then
+		// applied once the token holders vote to raise the tap
+		tap := bond.Tap.Accrue(height)
+		tap.Beneficiary = msg.Beneficiary
+		tap.FloorPercentage = msg.FloorPercentage
+		if msg.Rate.GT(tap.Rate) {
+			tap.ProposedRate = msg.Rate
+		} else {
+			tap.Rate = msg.Rate
+			tap.ProposedRate = sdk.ZeroInt()
+		}
+		bond.Tap = &tap
+
+		// Votes to raise the tap were for the previously proposed rate
+		keeper.DeleteTapVotes(ctx, msg.Token, types.TapVoteRaise)
+	}
+	keeper.SetBond(ctx, msg.Token, bond)
+
+	logger := keeper.Logger(ctx)
+	logger.Info(fmt.Sprintf("bond %s tap set by %s",
+		msg.Token, msg.Editor.String()))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetTap,
+			sdk.NewAttribute(types.AttributeKeyBond, msg.Token),
+			sdk.NewAttribute(types.AttributeKeyBeneficiary, msg.Beneficiary.String()),
+			sdk.NewAttribute(types.AttributeKeyTapRate, bond.Tap.Rate.String()),
+			sdk.NewAttribute(types.AttributeKeyProposedTapRate, bond.Tap.ProposedRate.String()),
+			sdk.NewAttribute(types.AttributeKeyTapFloorPercentage, msg.FloorPercentage.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Editor.String()),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgWithdrawTap(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgWithdrawTap) sdk.Result {
+
+	bond, found := keeper.GetBond(ctx, msg.Token)
+	if !found {
+		return types.ErrBondDoesNotExist(types.DefaultCodespace, msg.Token).Result()
+	}
+
+	if !bond.RoleAuthorizes(types.RoleWithdrawer, msg.Signers) {
+		return types.ErrSignersNotAuthorizedForRole(types.DefaultCodespace, types.RoleWithdrawer).Result()
+	}
+
+	withdrawn, err := keeper.WithdrawTap(ctx, msg.Token)
+	if err != nil {
+		return err.Result()
+	}
+
+	logger := keeper.Logger(ctx)
+	logger.Info(fmt.Sprintf("%s withdrawn from bond %s tap to %s by %s",
+		withdrawn.String(), msg.Token, bond.Tap.Beneficiary.String(), msg.Editor.String()))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeWithdrawTap,
+			sdk.NewAttribute(types.AttributeKeyBond, msg.Token),
+			sdk.NewAttribute(types.AttributeKeyBeneficiary, bond.Tap.Beneficiary.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, withdrawn.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Editor.String()),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgVoteTap(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgVoteTap) sdk.Result {
+
+	bond, found := keeper.GetBond(ctx, msg.Token)
+	if !found {
+		return types.ErrBondDoesNotExist(types.DefaultCodespace, msg.Token).Result()
+	}
+
+	if !bond.HasTap() {
+		return types.ErrBondDoesNotHaveTap(types.DefaultCodespace, msg.Token).Result()
+	} else if bond.IsDissolved() {
+		return types.ErrBondIsDissolved(types.DefaultCodespace, msg.Token).Result()
+	} else if msg.Option == types.TapVoteRaise && !bond.Tap.HasProposedRate() {
+		return types.ErrNoTapRateProposed(types.DefaultCodespace, msg.Token).Result()
+	}
+
+	// Voters without any bond tokens would add nothing to the tally
+	if keeper.CoinKeeper.GetCoins(ctx, msg.Voter).AmountOf(msg.Token).IsZero() {
+		return types.ErrNoBondTokensToVoteWith(types.DefaultCodespace, msg.Token).Result()
+	}
+
+	// Record vote, replacing any previous vote by the voter
+	keeper.SetTapVote(ctx, types.NewTapVote(msg.Token, msg.Voter, msg.Option))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeVoteTap,
+			sdk.NewAttribute(types.AttributeKeyBond, msg.Token),
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Voter.String()),
+			sdk.NewAttribute(types.AttributeKeyOption, msg.Option),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Voter.String()),
+		),
+	})
+
+	// Apply the outcome once the vote passes
+	if keeper.TapVotePasses(ctx, msg.Token, msg.Option) {
+		switch msg.Option {
+		case types.TapVoteRaise:
+			keeper.RaiseTap(ctx, msg.Token)
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeRaiseTap,
+				sdk.NewAttribute(types.AttributeKeyBond, msg.Token),
+				sdk.NewAttribute(types.AttributeKeyTapRate, bond.Tap.ProposedRate.String()),
+			))
+		case types.TapVoteDissolve:
+			keeper.DissolveBond(ctx, msg.Token)
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeDissolveBond,
+				sdk.NewAttribute(types.AttributeKeyBond, msg.Token),
+			))
+		}
+	}
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgRefund(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgRefund) sdk.Result {
+
+	token := msg.Amount.Denom
+	if !keeper.BondExists(ctx, token) {
+		return types.ErrBondDoesNotExist(types.DefaultCodespace, token).Result()
+	}
+
+	refunds, err := keeper.Refund(ctx, msg.Holder, msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+
+	logger := keeper.Logger(ctx)
+	logger.Info(fmt.Sprintf("%s refunded %s for %s",
+		msg.Holder.String(), refunds.String(), msg.Amount.String()))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRefund,
+			sdk.NewAttribute(types.AttributeKeyBond, token),
+			sdk.NewAttribute(types.AttributeKeyTokensBurned, msg.Amount.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyRefunds, refunds.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Holder.String()),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgSetReserveStaking(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgSetReserveStaking) sdk.Result {
+
+	bond, found := keeper.GetBond(ctx, msg.Token)
+	if !found {
+		return types.ErrBondDoesNotExist(types.DefaultCodespace, msg.Token).Result()
+	}
+
+	if !bond.RoleAuthorizes(types.RoleAdmin, msg.Signers) {
+		return types.ErrSignersNotAuthorizedForRole(types.DefaultCodespace, types.RoleAdmin).Result()
+	}
+
+	// Only a reserve made up solely of the staking denom can be staked
+	stakingDenom := keeper.StakingKeeper.BondDenom(ctx)
+	if len(bond.ReserveTokens) != 1 || bond.ReserveTokens[0] != stakingDenom {
+		return types.ErrReserveIsNotStakingDenom(types.DefaultCodespace, msg.Token, stakingDenom).Result()
+	} else if bond.IsDissolved() {
+		return types.ErrBondIsDissolved(types.DefaultCodespace, msg.Token).Result()
+	}
+
+	// Any losses and queued sells are kept if staking is already set up
+	if !bond.HasReserveStaking() {
+		staking := types.NewReserveStaking(msg.CapPercentage, msg.RewardsToReserve)
+		bond.Staking = &staking
+	} else {
+		staking := *bond.Staking
+		staking.CapPercentage = msg.CapPercentage
+		staking.RewardsToReserve = msg.RewardsToReserve
+		bond.Staking = &staking
+	}
+	keeper.SetBond(ctx, msg.Token, bond)
+
+	logger := keeper.Logger(ctx)
+	logger.Info(fmt.Sprintf("bond %s reserve staking set by %s",
+		msg.Token, msg.Editor.String()))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetReserveStaking,
+			sdk.NewAttribute(types.AttributeKeyBond, msg.Token),
+			sdk.NewAttribute(types.AttributeKeyStakingCapPercentage, msg.CapPercentage.String()),
+			sdk.NewAttribute(types.AttributeKeyRewardsToReserve, msg.RewardsToReserve),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Editor.String()),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgDelegateReserve(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgDelegateReserve) sdk.Result {
+
+	bond, found := keeper.GetBond(ctx, msg.Token)
+	if !found {
+		return types.ErrBondDoesNotExist(types.DefaultCodespace, msg.Token).Result()
+	}
+
+	if !bond.RoleAuthorizes(types.RoleStaker, msg.Signers) {
+		return types.ErrSignersNotAuthorizedForRole(types.DefaultCodespace, types.RoleStaker).Result()
+	}
+
+	if !bond.HasReserveStaking() {
+		return types.ErrBondDoesNotHaveReserveStaking(types.DefaultCodespace, msg.Token).Result()
+	} else if bond.IsDissolved() {
+		return types.ErrBondIsDissolved(types.DefaultCodespace, msg.Token).Result()
+	} else if msg.Amount.Denom != bond.ReserveTokens[0] {
+		return types.ErrReserveDenomsMismatch(types.DefaultCodespace,
+			msg.Amount.Denom, bond.ReserveTokens).Result()
+	}
+
+	validator, found := keeper.StakingKeeper.GetValidator(ctx, msg.Validator)
+	if !found {
+		return staking.ErrNoValidatorFound(staking.DefaultCodespace).Result()
+	}
+
+	// The staked reserve cannot exceed the bond's staking cap, and no more of
+	// the reserve can be staked while sells are waiting to be paid out
+	staked := keeper.GetStakedReserve(ctx, msg.Token).Add(msg.Amount.Amount)
+	stakingCap := bond.GetStakingCap()
+	if staked.GT(stakingCap) {
+		return types.ErrStakingCapExceeded(types.DefaultCodespace, staked, stakingCap).Result()
+	} else if bond.Staking.HasQueuedSells() {
+		return types.ErrBondHasQueuedSells(types.DefaultCodespace).Result()
+	}
+
+	liquid := keeper.GetLiquidReserve(ctx, msg.Token)
+	if liquid.LT(msg.Amount.Amount) {
+		return types.ErrInsufficientLiquidReserve(types.DefaultCodespace,
+			liquid, msg.Amount.Amount).Result()
+	}
+
+	err := keeper.DelegateReserve(ctx, msg.Token, validator, msg.Amount.Amount)
+	if err != nil {
+		return err.Result()
+	}
+
+	logger := keeper.Logger(ctx)
+	logger.Info(fmt.Sprintf("bond %s delegated %s of its reserve to %s",
+		msg.Token, msg.Amount.String(), msg.Validator.String()))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDelegateReserve,
+			sdk.NewAttribute(types.AttributeKeyBond, msg.Token),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.Validator.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.Amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Editor.String()),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgUndelegateReserve(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgUndelegateReserve) sdk.Result {
+
+	bond, found := keeper.GetBond(ctx, msg.Token)
+	if !found {
+		return types.ErrBondDoesNotExist(types.DefaultCodespace, msg.Token).Result()
+	}
+
+	if !bond.RoleAuthorizes(types.RoleStaker, msg.Signers) {
+		return types.ErrSignersNotAuthorizedForRole(types.DefaultCodespace, types.RoleStaker).Result()
+	}
+
+	if !bond.HasReserveStaking() {
+		return types.ErrBondDoesNotHaveReserveStaking(types.DefaultCodespace, msg.Token).Result()
+	} else if msg.Amount.Denom != bond.ReserveTokens[0] {
+		return types.ErrReserveDenomsMismatch(types.DefaultCodespace,
+			msg.Amount.Denom, bond.ReserveTokens).Result()
+	}
+
+	completionTime, err := keeper.UndelegateReserve(
+		ctx, msg.Token, msg.Validator, msg.Amount.Amount)
+	if err != nil {
+		return err.Result()
+	}
+
+	logger := keeper.Logger(ctx)
+	logger.Info(fmt.Sprintf("bond %s undelegated %s of its reserve from %s",
+		msg.Token, msg.Amount.String(), msg.Validator.String()))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUndelegateReserve,
+			sdk.NewAttribute(types.AttributeKeyBond, msg.Token),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.Validator.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Editor.String()),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgSweepRoundingSurplus(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgSweepRoundingSurplus) sdk.Result {
+
+	bond, found := keeper.GetBond(ctx, msg.Token)
+	if !found {
+		return types.ErrBondDoesNotExist(types.DefaultCodespace, msg.Token).Result()
+	}
+
+	if !bond.RoleAuthorizes(types.RoleFeeManager, msg.Signers) {
+		return types.ErrSignersNotAuthorizedForRole(types.DefaultCodespace, types.RoleFeeManager).Result()
+	}
+
+	swept, err := keeper.SweepRoundingSurplus(ctx, msg.Token)
+	if err != nil {
+		return err.Result()
+	}
+
+	logger := keeper.Logger(ctx)
+	logger.Info(fmt.Sprintf("rounding surplus %s of bond %s swept to %s by %s",
+		swept.String(), msg.Token, bond.FeeAddress.String(), msg.Editor.String()))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSweepRoundingSurplus,
+			sdk.NewAttribute(types.AttributeKeyBond, msg.Token),
+			sdk.NewAttribute(types.AttributeKeyFeeAddress, bond.FeeAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, swept.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Editor.String()),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
 func handleMsgBuy(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgBuy) sdk.Result {
 
 	token := msg.Amount.Denom
@@ -198,6 +1092,14 @@ func handleMsgBuy(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgBuy) sdk.R
 		return types.ErrBondDoesNotExist(types.DefaultCodespace, token).Result()
 	}
 
+	if bond.IsDissolved() {
+		return types.ErrBondIsDissolved(types.DefaultCodespace, token).Result()
+	} else if bond.IsPaused() {
+		return types.ErrBondIsPaused(types.DefaultCodespace, token).Result()
+	} else if bond.IsHalted() {
+		return types.ErrBondIsHalted(types.DefaultCodespace, token, bond.HaltBlocksRemaining).Result()
+	}
+
 	// Check max prices
 	if !bond.ReserveDenomsEqualTo(msg.MaxPrices) {
 		return types.ErrReserveDenomsMismatch(types.DefaultCodespace, msg.MaxPrices.String(), bond.ReserveTokens).Result()
@@ -208,6 +1110,12 @@ func handleMsgBuy(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgBuy) sdk.R
 		return types.ErrOrderQuantityLimitExceeded(types.DefaultCodespace).Result()
 	}
 
+	// Check if batch has reached the max number of orders
+	if keeper.BatchIsFull(ctx, token) {
+		return types.ErrMaxOrdersPerBatchReached(types.DefaultCodespace,
+			keeper.GetParams(ctx).MaxOrdersPerBatch).Result()
+	}
+
 	// For the swapper, the first buy is the initialisation of the reserves
 	// The max prices are used as the actual prices and one token is minted
 	// The amount of token serves to define the price of adding more liquidity
@@ -223,7 +1131,7 @@ func handleMsgBuy(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgBuy) sdk.R
 	}
 
 	// Create order
-	order := types.NewBuyOrder(msg.Buyer, msg.Amount, msg.MaxPrices)
+	order := types.NewBuyOrder(msg.Buyer, msg.Amount, msg.MaxPrices, msg.Referrer)
 
 	// Get buy price and check if can add buy order to batch
 	buyPrices, sellPrices, err := keeper.GetUpdatedBatchPricesAfterBuy(ctx, token, order)
@@ -243,6 +1151,7 @@ func handleMsgBuy(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgBuy) sdk.R
 			sdk.NewAttribute(types.AttributeKeyBond, msg.Amount.Denom),
 			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.Amount.String()),
 			sdk.NewAttribute(types.AttributeKeyMaxPrices, msg.MaxPrices.String()),
+			sdk.NewAttribute(types.AttributeKeyReferrer, msg.Referrer.String()),
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -270,7 +1179,7 @@ func performFirstSwapperFunctionBuy(ctx sdk.Context, keeper keeper.Keeper, msg t
 	}
 
 	// Use max prices as the amount to send to the liquidity pool (i.e. price)
-	err := keeper.CoinKeeper.SendCoins(ctx, msg.Buyer, bond.ReserveAddress, msg.MaxPrices)
+	err := keeper.DepositReserve(ctx, token, msg.Buyer, msg.MaxPrices)
 	if err != nil {
 		return err.Result()
 	}
@@ -317,6 +1226,14 @@ func handleMsgSell(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgSell) sdk
 		return types.ErrBondDoesNotExist(types.DefaultCodespace, token).Result()
 	}
 
+	if bond.IsDissolved() {
+		return types.ErrBondIsDissolved(types.DefaultCodespace, token).Result()
+	} else if bond.IsPaused() {
+		return types.ErrBondIsPaused(types.DefaultCodespace, token).Result()
+	} else if bond.IsHalted() {
+		return types.ErrBondIsHalted(types.DefaultCodespace, token, bond.HaltBlocksRemaining).Result()
+	}
+
 	if strings.ToLower(bond.AllowSells) == types.FALSE {
 		return types.ErrBondDoesNotAllowSelling(types.DefaultCodespace).Result()
 	}
@@ -326,6 +1243,16 @@ func handleMsgSell(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgSell) sdk
 		return types.ErrOrderQuantityLimitExceeded(types.DefaultCodespace).Result()
 	}
 
+	// Check if batch has reached the max number of orders
+	if keeper.BatchIsFull(ctx, token) {
+		return types.ErrMaxOrdersPerBatchReached(types.DefaultCodespace,
+			keeper.GetParams(ctx).MaxOrdersPerBatch).Result()
+	}
+
+	// Get exit fee percentage before the seller's lots are removed by the burn
+	exitFeePercentage := keeper.GetSellExitFeePercentage(
+		ctx, token, msg.Seller, msg.Amount.Amount)
+
 	// Send coins to be burned from seller (enforces sellAmount <= balance)
 	err := keeper.SupplyKeeper.SendCoinsFromAccountToModule(ctx, msg.Seller,
 		types.BondsMintBurnAccount, sdk.Coins{msg.Amount})
@@ -341,7 +1268,7 @@ func handleMsgSell(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgSell) sdk
 	}
 
 	// Create order
-	order := types.NewSellOrder(msg.Seller, msg.Amount)
+	order := types.NewSellOrder(msg.Seller, msg.Amount, exitFeePercentage)
 
 	// Get sell price and check if can add sell order to batch
 	buyPrices, sellPrices, err := keeper.GetUpdatedBatchPricesAfterSell(ctx, token, order)
@@ -378,6 +1305,14 @@ func handleMsgSwap(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgSwap) sdk
 		return types.ErrBondDoesNotExist(types.DefaultCodespace, msg.BondToken).Result()
 	}
 
+	if bond.IsDissolved() {
+		return types.ErrBondIsDissolved(types.DefaultCodespace, msg.BondToken).Result()
+	} else if bond.IsPaused() {
+		return types.ErrBondIsPaused(types.DefaultCodespace, msg.BondToken).Result()
+	} else if bond.IsHalted() {
+		return types.ErrBondIsHalted(types.DefaultCodespace, msg.BondToken, bond.HaltBlocksRemaining).Result()
+	}
+
 	// Check that from and to use reserve token names
 	fromAndTo := sdk.NewCoins(msg.From, sdk.NewCoin(msg.ToToken, sdk.OneInt()))
 	fromAndToDenoms := msg.From.Denom + "," + msg.ToToken
@@ -390,6 +1325,12 @@ func handleMsgSwap(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgSwap) sdk
 		return types.ErrOrderQuantityLimitExceeded(types.DefaultCodespace).Result()
 	}
 
+	// Check if batch has reached the max number of orders
+	if keeper.BatchIsFull(ctx, msg.BondToken) {
+		return types.ErrMaxOrdersPerBatchReached(types.DefaultCodespace,
+			keeper.GetParams(ctx).MaxOrdersPerBatch).Result()
+	}
+
 	// Take coins to be swapped from swapper (enforces swapAmount <= balance)
 	err := keeper.SupplyKeeper.SendCoinsFromAccountToModule(ctx, msg.Swapper,
 		types.BatchesIntermediaryAccount, sdk.Coins{msg.From})
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
 
 	"github.com/stretchr/testify/require"
 )
@@ -63,6 +64,990 @@ func TestCreatingABondUsingStakingTokenFails(t *testing.T) {
 	require.False(t, app.BondsKeeper.BondExists(ctx, token))
 }
 
+func TestCreatingABondUsingDenylistedReserveTokenFails(t *testing.T) {
+	app, ctx := createTestApp(false)
+	h := bonds.NewHandler(app.BondsKeeper)
+
+	// Denylist the reserve token
+	params := app.BondsKeeper.GetParams(ctx)
+	params.ReserveDenomDenylist = []string{reserveToken}
+	app.BondsKeeper.SetParams(ctx, params)
+
+	res := h(ctx, newValidMsgCreateBond())
+
+	require.False(t, res.IsOK())
+	require.Equal(t, res.Code, bonds.CodeReserveTokenInvalid)
+	require.False(t, app.BondsKeeper.BondExists(ctx, token))
+}
+
+func TestCreatingABondWithFeeAboveMaxFails(t *testing.T) {
+	app, ctx := createTestApp(false)
+	h := bonds.NewHandler(app.BondsKeeper)
+
+	// Tx fee above max tx fee
+	msg := newValidMsgCreateBond()
+	msg.TxFeePercentage = app.BondsKeeper.GetParams(ctx).MaxTxFeePercentage.Add(sdk.OneDec())
+	res := h(ctx, msg)
+
+	require.False(t, res.IsOK())
+	require.Equal(t, res.Code, bonds.CodeFeeTooLarge)
+
+	// Exit fee above max exit fee
+	msg = newValidMsgCreateBond()
+	msg.ExitFeePercentage = app.BondsKeeper.GetParams(ctx).MaxExitFeePercentage.Add(sdk.OneDec())
+	res = h(ctx, msg)
+
+	require.False(t, res.IsOK())
+	require.Equal(t, res.Code, bonds.CodeFeeTooLarge)
+	require.False(t, app.BondsKeeper.BondExists(ctx, token))
+}
+
+func TestCreatingABondWithBatchBlocksOutOfRangeFails(t *testing.T) {
+	app, ctx := createTestApp(false)
+	h := bonds.NewHandler(app.BondsKeeper)
+
+	params := app.BondsKeeper.GetParams(ctx)
+	params.MinBatchBlocks = sdk.NewUint(2)
+	params.MaxBatchBlocks = sdk.NewUint(5)
+	app.BondsKeeper.SetParams(ctx, params)
+
+	// Batch blocks below min
+	msg := newValidMsgCreateBond()
+	msg.BatchBlocks = sdk.OneUint()
+	res := h(ctx, msg)
+
+	require.False(t, res.IsOK())
+	require.Equal(t, res.Code, bonds.CodeBatchBlocksOutOfRange)
+
+	// Batch blocks above max
+	msg.BatchBlocks = sdk.NewUint(6)
+	res = h(ctx, msg)
+
+	require.False(t, res.IsOK())
+	require.Equal(t, res.Code, bonds.CodeBatchBlocksOutOfRange)
+	require.False(t, app.BondsKeeper.BondExists(ctx, token))
+}
+
+func TestCreatingABondChargesBondCreationFee(t *testing.T) {
+	app, ctx := createTestApp(false)
+	h := bonds.NewHandler(app.BondsKeeper)
+
+	creationFee := sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 100))
+	params := app.BondsKeeper.GetParams(ctx)
+	params.BondCreationFee = creationFee
+	app.BondsKeeper.SetParams(ctx, params)
+
+	// Creator cannot afford fee
+	res := h(ctx, newValidMsgCreateBond())
+	require.False(t, res.IsOK())
+	require.False(t, app.BondsKeeper.BondExists(ctx, token))
+
+	// Creator can afford fee
+	_, err := app.BankKeeper.AddCoins(ctx, initCreator, creationFee)
+	require.Nil(t, err)
+	res = h(ctx, newValidMsgCreateBond())
+	require.True(t, res.IsOK())
+	require.True(t, app.BondsKeeper.BondExists(ctx, token))
+	require.True(t, app.BankKeeper.GetCoins(ctx, initCreator).IsZero())
+
+	// Fee was sent to the community pool
+	communityPool := app.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+	require.Equal(t, sdk.NewDecCoins(creationFee), communityPool)
+}
+
+func TestCreatingABondTakesBondCreationDeposit(t *testing.T) {
+	app, ctx := createTestApp(false)
+	h := bonds.NewHandler(app.BondsKeeper)
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 100))
+	params := app.BondsKeeper.GetParams(ctx)
+	params.BondCreationDeposit = deposit
+	app.BondsKeeper.SetParams(ctx, params)
+
+	// Creator cannot afford deposit
+	res := h(ctx, newValidMsgCreateBond())
+	require.False(t, res.IsOK())
+	require.False(t, app.BondsKeeper.BondExists(ctx, token))
+
+	// Creator can afford deposit
+	_, err := app.BankKeeper.AddCoins(ctx, initCreator, deposit)
+	require.Nil(t, err)
+	res = h(ctx, newValidMsgCreateBond())
+	require.True(t, res.IsOK())
+	require.Equal(t, deposit, app.BondsKeeper.MustGetBond(ctx, token).Deposit)
+	require.True(t, app.BankKeeper.GetCoins(ctx, initCreator).IsZero())
+
+	// Deposit is held by the deposits module account
+	depositAcc := app.SupplyKeeper.GetModuleAccount(ctx, bonds.BondsDepositAccount)
+	require.Equal(t, deposit, depositAcc.GetCoins())
+}
+
+func TestClosingANonExistingBondFails(t *testing.T) {
+	app, ctx := createTestApp(false)
+	h := bonds.NewHandler(app.BondsKeeper)
+
+	res := h(ctx, types.NewMsgCloseBond(token, initCreator, initSigners))
+
+	require.False(t, res.IsOK())
+	require.Equal(t, res.Code, bonds.CodeBondDoesNotExist)
+}
+
+func TestClosingABondWithDifferentSignersFails(t *testing.T) {
+	app, ctx := createTestApp(false)
+	h := bonds.NewHandler(app.BondsKeeper)
+
+	h(ctx, newValidMsgCreateBond())
+	res := h(ctx, types.NewMsgCloseBond(token, anotherAddress,
+		[]sdk.AccAddress{anotherAddress}))
+
+	require.False(t, res.IsOK())
+	require.True(t, app.BondsKeeper.BondExists(ctx, token))
+}
+
+func TestClosingABondWithNonZeroSupplyFails(t *testing.T) {
+	app, ctx := createTestApp(false)
+	h := bonds.NewHandler(app.BondsKeeper)
+
+	h(ctx, newValidMsgCreateBond())
+	err := addCoinsToUser(app, ctx, sdk.Coins{sdk.NewInt64Coin(reserveToken, 1000000)})
+	require.Nil(t, err)
+
+	// Pending buy order prevents closing
+	h(ctx, newValidMsgBuy(2, 10000))
+	res := h(ctx, types.NewMsgCloseBond(token, initCreator, initSigners))
+	require.False(t, res.IsOK())
+	require.Equal(t, res.Code, bonds.CodeBondCannotBeClosed)
+
+	// Performed buy order (non-zero supply) prevents closing
+	bonds.EndBlocker(ctx, app.BondsKeeper)
+	res = h(ctx, types.NewMsgCloseBond(token, initCreator, initSigners))
+	require.False(t, res.IsOK())
+	require.Equal(t, res.Code, bonds.CodeBondCannotBeClosed)
+	require.True(t, app.BondsKeeper.BondExists(ctx, token))
+}
+
+func TestClosingABondCorrectlyPasses(t *testing.T) {
+	app, ctx := createTestApp(false)
+	h := bonds.NewHandler(app.BondsKeeper)
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 100))
+	params := app.BondsKeeper.GetParams(ctx)
+	params.BondCreationDeposit = deposit
+	app.BondsKeeper.SetParams(ctx, params)
+
+	// Create bond
+	_, err := app.BankKeeper.AddCoins(ctx, initCreator, deposit)
+	require.Nil(t, err)
+	h(ctx, newValidMsgCreateBond())
+	bonds.EndBlocker(ctx, app.BondsKeeper) // sets last batch
+	bond := app.BondsKeeper.MustGetBond(ctx, token)
+
+	// Add reserve dust
+	dust := sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 3))
+	_, err = app.BankKeeper.AddCoins(ctx, bond.ReserveAddress, dust)
+	require.Nil(t, err)
+
+	// Close bond
+	res := h(ctx, types.NewMsgCloseBond(token, initCreator, initSigners))
+	require.True(t, res.IsOK())
+
+	// Bond and batches deleted, deposit refunded, and dust swept
+	require.False(t, app.BondsKeeper.BondExists(ctx, token))
+	require.False(t, app.BondsKeeper.BatchExists(ctx, token))
+	require.False(t, app.BondsKeeper.LastBatchExists(ctx, token))
+	require.Equal(t, deposit, app.BankKeeper.GetCoins(ctx, initCreator))
+	require.Equal(t, dust, app.BankKeeper.GetCoins(ctx, initFeeAddress))
+	require.True(t, app.BankKeeper.GetCoins(ctx, bond.ReserveAddress).IsZero())
+}
+
+func TestPausingABondWithDifferentSignersFails(t *testing.T) {
+	app, ctx := createTestApp(false)
+	h := bonds.NewHandler(app.BondsKeeper)
+
+	// Set bond to simulate creation
+	app.BondsKeeper.SetBond(ctx, token, newSimpleBond())
+
+	// Pause bond
+	msg := types.NewMsgSetBondPaused(token, types.TRUE, initCreator, []sdk.AccAddress{anotherAddress})
+	res := h(ctx, msg)
+
+	require.False(t, res.IsOK())
+	require.Equal(t, res.Code, bonds.CodeSignersNotAuthorized)
+	require.False(t, app.BondsKeeper.MustGetBond(ctx, token).IsPaused())
+}
+
+func TestTradingAPausedBondFails(t *testing.T) {
+	app, ctx := createTestApp(false)
+	h := bonds.NewHandler(app.BondsKeeper)
+
+	// Create and pause bond
+	h(ctx, newValidMsgCreateBond())
+	res := h(ctx, types.NewMsgSetBondPaused(token, types.TRUE, initCreator, initSigners))
+	require.True(t, res.IsOK())
+	require.True(t, app.BondsKeeper.MustGetBond(ctx, token).IsPaused())
+
+	// Add reserve tokens to user
+	err := addCoinsToUser(app, ctx, sdk.Coins{sdk.NewInt64Coin(reserveToken, 4000)})
+	require.Nil(t, err)
+
+	// Buy, sell and swap all fail
+	res = h(ctx, newValidMsgBuy(2, 4000))
+	require.False(t, res.IsOK())
+	require.Equal(t, res.Code, bonds.CodeBondPaused)
+	res = h(ctx, newValidMsgSell(2))
+	require.False(t, res.IsOK())
+	require.Equal(t, res.Code, bonds.CodeBondPaused)
+	res = h(ctx, newValidMsgSwap(reserveToken, reserveToken2, 10))
+	require.False(t, res.IsOK())
+	require.Equal(t, res.Code, bonds.CodeBondPaused)
+
+	// Unpause bond and buy
+	res = h(ctx, types.NewMsgSetBondPaused(token, types.FALSE, initCreator, initSigners))
+	require.True(t, res.IsOK())
+	res = h(ctx, newValidMsgBuy(2, 4000))
+	require.True(t, res.IsOK())
+}
+
+func TestPausingABondRefundsPendingOrdersAtEndBlock(t *testing.T) {
+	app, ctx := createTestApp(false)
+	h := bonds.NewHandler(app.BondsKeeper)
+
+	// Create bond
+	h(ctx, newValidMsgCreateBond())
+
+	// Add reserve tokens to user
+	err := addCoinsToUser(app, ctx, sdk.Coins{sdk.NewInt64Coin(reserveToken, 4000)})
+	require.Nil(t, err)
+
+	// Buy 2 tokens
+	h(ctx, newValidMsgBuy(2, 4000))
+	bonds.EndBlocker(ctx, app.BondsKeeper)
+	balanceBefore := app.BondsKeeper.CoinKeeper.GetCoins(ctx, userAddress)
+
+	// Place buy and sell orders, and pause bond before the batch ends
+	res := h(ctx, newValidMsgBuy(2, 3000))
+	require.True(t, res.IsOK())
+	res = h(ctx, newValidMsgSell(2))
+	require.True(t, res.IsOK())
+	res = h(ctx, types.NewMsgSetBondPaused(token, types.TRUE, initCreator, initSigners))
+	require.True(t, res.IsOK())
+	bonds.EndBlocker(ctx, app.BondsKeeper)
+
+	// Orders were cancelled and refunded instead of being performed
+	lastBatch := app.BondsKeeper.MustGetLastBatch(ctx, token)
+	require.True(t, lastBatch.Buys[0].IsCancelled())
+	require.True(t, lastBatch.Sells[0].IsCancelled())
+	require.Equal(t, 0, app.BondsKeeper.MustGetBatch(ctx, token).NumberOfOrders())
+	require.Equal(t, balanceBefore, app.BondsKeeper.CoinKeeper.GetCoins(ctx, userAddress))
+	require.Equal(t, sdk.NewInt(2), app.BondsKeeper.MustGetBond(ctx, token).CurrentSupply.Amount)
+}
+
+func TestSettingATapOnASwapperBondFails(t *testing.T) {
+	app, ctx := createTestApp(false)
+	h := bonds.NewHandler(app.BondsKeeper)
+
+	// Create swapper bond
+	h(ctx, newValidMsgCreateSwapperBond())
+
+	// Set tap
+	res := h(ctx, types.NewMsgSetTap(token, anotherAddress, sdk.NewInt(100),
+		sdk.NewDec(50), initCreator, initSigners))
+
+	require.False(t, res.IsOK())
+	require.Equal(t, res.Code, bonds.CodeFunctionNotAvailableForFunctionType)
+	require.False(t, app.BondsKeeper.MustGetBond(ctx, token).HasTap())
+}
+
+func TestRaisingATapRequiresAHolderVote(t *testing.T) {
+	app, ctx := createTestApp(false)
+	h := bonds.NewHandler(app.BondsKeeper)
+
+	// Create bond with a tap of 100 per block
+	h(ctx, newValidMsgCreateBond())
+	res := h(ctx, types.NewMsgSetTap(token, anotherAddress, sdk.NewInt(100),
+		sdk.NewDec(50), initCreator, initSigners))
+	require.True(t, res.IsOK())
+
+	// Floor percentage cannot be lowered
+	res = h(ctx, types.NewMsgSetTap(token, anotherAddress, sdk.NewInt(100),
+		sdk.NewDec(40), initCreator, initSigners))
+	require.False(t, res.IsOK())
+	require.Equal(t, res.Code, bonds.CodeInvalidTap)
+
+	// Raising the rate only proposes the new rate
+	res = h(ctx, types.NewMsgSetTap(token, anotherAddress, sdk.NewInt(200),
+		sdk.NewDec(50), initCreator, initSigners))
+	require.True(t, res.IsOK())
+	tap := app.BondsKeeper.MustGetBond(ctx, token).Tap
+	require.Equal(t, sdk.NewInt(100), tap.Rate)
+	require.Equal(t, sdk.NewInt(200), tap.ProposedRate)
+
+	// User cannot vote without holding any tokens
+	res = h(ctx, types.NewMsgVoteTap(token, types.TapVoteRaise, userAddress))
+	require.False(t, res.IsOK())
+	require.Equal(t, res.Code, bonds.CodeNoBondTokensToVoteWith)
+	require.Empty(t, app.BondsKeeper.GetTapVotes(ctx, token))
+
+	// Add reserve tokens to user and buy 10 tokens
+	err := addCoinsToUser(app, ctx, sdk.Coins{sdk.NewInt64Coin(reserveToken, 6000)})
+	require.Nil(t, err)
+	h(ctx, newValidMsgBuy(10, 6000))
+	bonds.EndBlocker(ctx, app.BondsKeeper)
+
+	// User holds all tokens, so their vote to raise the tap passes
+	res = h(ctx, types.NewMsgVoteTap(token, types.TapVoteRaise, userAddress))
+	require.True(t, res.IsOK())
+	tap = app.BondsKeeper.MustGetBond(ctx, token).Tap
+	require.Equal(t, sdk.NewInt(200), tap.Rate)
+	require.False(t, tap.HasProposedRate())
+	require.Empty(t, app.BondsKeeper.GetTapVotes(ctx, token))
+
+	// No rate is proposed anymore, so voting to raise fails
+	res = h(ctx, types.NewMsgVoteTap(token, types.TapVoteRaise, userAddress))
+	require.False(t, res.IsOK())
+	require.Equal(t, res.Code, bonds.CodeNoTapRateProposed)
+}
+
+func TestWithdrawingFromATapAndDissolvingTheBond(t *testing.T) {
+	app, ctx := createTestApp(false)
+	h := bonds.NewHandler(app.BondsKeeper)
+
+	// Create bond
+	h(ctx, newValidMsgCreateBond())
+
+	// Add reserve tokens to user
+	err := addCoinsToUser(app, ctx, sdk.Coins{sdk.NewInt64Coin(reserveToken, 6000)})
+	require.Nil(t, err)
+
+	// Buy 10 tokens, for which the reserve is 5000 and the tx fee is 5
+	h(ctx, newValidMsgBuy(10, 6000))
+	bonds.EndBlocker(ctx, app.BondsKeeper)
+
+	// Set tap of 100 per block with a floor of 50% of the reserve
+	res := h(ctx, types.NewMsgSetTap(token, anotherAddress, sdk.NewInt(100),
+		sdk.NewDec(50), initCreator, initSigners))
+	require.True(t, res.IsOK())
+
+	// At height 10, the 1000 accrued is withdrawn to the beneficiary
+	ctx = ctx.WithBlockHeight(10)
+	res = h(ctx, types.NewMsgWithdrawTap(token, initCreator, initSigners))
+	require.True(t, res.IsOK())
+	anotherBalance := app.BondsKeeper.CoinKeeper.GetCoins(ctx, anotherAddress)
+	require.Equal(t, sdk.NewInt(1000), anotherBalance.AmountOf(reserveToken))
+	require.Equal(t, sdk.NewInt(1000), app.BondsKeeper.MustGetBond(ctx, token).Tap.Withdrawn)
+
+	// Nothing left to withdraw in the same block
+	res = h(ctx, types.NewMsgWithdrawTap(token, initCreator, initSigners))
+	require.False(t, res.IsOK())
+	require.Equal(t, res.Code, bonds.CodeNoTapFundsToDraw)
+
+	// Refunds are not available before the bond is dissolved
+	res = h(ctx, types.NewMsgRefund(userAddress, sdk.NewInt64Coin(token, 10)))
+	require.False(t, res.IsOK())
+	require.Equal(t, res.Code, bonds.CodeBondNotDissolved)
+
+	// User holds all tokens, so their vote to dissolve the bond passes
+	res = h(ctx, types.NewMsgVoteTap(token, types.TapVoteDissolve, userAddress))
+	require.True(t, res.IsOK())
+	require.True(t, app.BondsKeeper.MustGetBond(ctx, token).IsDissolved())
+
+	// Buying and withdrawing from the tap both fail
+	res = h(ctx, newValidMsgBuy(2, 1000))
+	require.False(t, res.IsOK())
+	require.Equal(t, res.Code, bonds.CodeBondDissolved)
+	res = h(ctx.WithBlockHeight(20), types.NewMsgWithdrawTap(token, initCreator, initSigners))
+	require.False(t, res.IsOK())
+	require.Equal(t, res.Code, bonds.CodeBondDissolved)
+
+	// User is refunded the remaining 4000 reserve for their 10 tokens
+	res = h(ctx, types.NewMsgRefund(userAddress, sdk.NewInt64Coin(token, 10)))
+	require.True(t, res.IsOK())
+	userBalance := app.BondsKeeper.CoinKeeper.GetCoins(ctx, userAddress)
+	require.Equal(t, sdk.NewInt(4995), userBalance.AmountOf(reserveToken))
+	require.Equal(t, sdk.ZeroInt(), userBalance.AmountOf(token))
+	require.Equal(t, sdk.ZeroInt(), app.BondsKeeper.MustGetBond(ctx, token).CurrentSupply.Amount)
+}
+
+func TestSweepingRoundingSurplusToFeeAddress(t *testing.T) {
+	app, ctx := createTestApp(false)
+	h := bonds.NewHandler(app.BondsKeeper)
+
+	// Create sigmoid bond, for which prices and returns are rounded
+	msg := newValidMsgCreateBond()
+	msg.FunctionType = types.SigmoidFunction
+	msg.FunctionParameters = types.FunctionParams{
+		types.NewFunctionParam("a", sdk.NewInt(3)),
+		types.NewFunctionParam("b", sdk.NewInt(5)),
+		types.NewFunctionParam("c", sdk.NewInt(1))}
+	res := h(ctx, msg)
+	require.True(t, res.IsOK())
+
+	// Add reserve tokens to user
+	err := addCoinsToUser(app, ctx, sdk.Coins{sdk.NewInt64Coin(reserveToken, 1000)})
+	require.Nil(t, err)
+
+	// Buy 3 tokens, for which the price is rounded up to 1
+	h(ctx, newValidMsgBuy(3, 10))
+	bonds.EndBlocker(ctx, app.BondsKeeper)
+	bond := app.BondsKeeper.MustGetBond(ctx, token)
+	require.True(t, bond.RoundingSurplus.IsAllPositive())
+
+	// Less than one whole token of surplus, so there is nothing to sweep
+	res = h(ctx, types.NewMsgSweepRoundingSurplus(token, initCreator, initSigners))
+	require.False(t, res.IsOK())
+	require.Equal(t, res.Code, bonds.CodeNoRoundingSurplusToSweep)
+
+	// Sell all 3 tokens, for which the returns are rounded down to 0
+	h(ctx, newValidMsgSell(3))
+	bonds.EndBlocker(ctx, app.BondsKeeper)
+	bond = app.BondsKeeper.MustGetBond(ctx, token)
+	expectedSurplus := sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 1))
+	require.Equal(t, sdk.NewDecCoins(expectedSurplus), bond.RoundingSurplus)
+	require.Equal(t, expectedSurplus, bond.CurrentReserve)
+
+	// Only the bond's fee manager can sweep the surplus
+	anotherSigners := []sdk.AccAddress{anotherAddress}
+	res = h(ctx, types.NewMsgSweepRoundingSurplus(token, anotherAddress, anotherSigners))
+	require.False(t, res.IsOK())
+	require.Equal(t, res.Code, bonds.CodeSignersNotAuthorized)
+
+	// Surplus is swept from the reserve to the fee address
+	feeBalance := app.BondsKeeper.CoinKeeper.GetCoins(ctx, initFeeAddress)
+	res = h(ctx, types.NewMsgSweepRoundingSurplus(token, initCreator, initSigners))
+	require.True(t, res.IsOK())
+	require.Equal(t, feeBalance.Add(expectedSurplus),
+		app.BondsKeeper.CoinKeeper.GetCoins(ctx, initFeeAddress))
+	bond = app.BondsKeeper.MustGetBond(ctx, token)
+	require.True(t, bond.RoundingSurplus.IsZero())
+	require.True(t, bond.CurrentReserve.IsZero())
+}
+
+func TestRoundingSurplusIsTrackedExactlyForUnevenBatches(t *testing.T) {
+	app, ctx := createTestApp(false)
+	h := bonds.NewHandler(app.BondsKeeper)
+	res := h(ctx, newValidMsgCreateBond())
+	require.True(t, res.IsOK())
+
+	// Add reserve tokens to users
+	err := addCoinsToUser(app, ctx, sdk.Coins{sdk.NewInt64Coin(reserveToken, 100000)})
+	require.Nil(t, err)
+	_, err = app.BondsKeeper.CoinKeeper.AddCoins(ctx, anotherAddress,
+		sdk.Coins{sdk.NewInt64Coin(reserveToken, 100000)})
+	require.Nil(t, err)
+
+	// Buy 10 tokens, for which the curve integral is 5000
+	h(ctx, newValidMsgBuy(10, 100000))
+	bonds.EndBlocker(ctx, app.BondsKeeper)
+
+	// Buy 3 tokens and sell 1 in the same batch, so that the buy price per
+	// token is (1300 + 3112) / 3, which cannot be represented exactly
+	h(ctx, types.NewMsgBuy(anotherAddress, sdk.NewInt64Coin(token, 3),
+		sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 100000)), nil))
+	h(ctx, newValidMsgSell(1))
+	bonds.EndBlocker(ctx, app.BondsKeeper)
+
+	// The expected reserve is exactly the curve integral (8112) at the new
+	// supply, and the reserve is exactly the expected reserve and surplus
+	bond := app.BondsKeeper.MustGetBond(ctx, token)
+	require.Equal(t, sdk.NewInt(12), bond.CurrentSupply.Amount)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 8112))),
+		bond.ExpectedReserve)
+	require.True(t, bond.RoundingSurplus.IsAllPositive())
+	require.Equal(t, sdk.NewDecCoins(bond.CurrentReserve),
+		bond.ExpectedReserve.Add(bond.RoundingSurplus))
+	_, broken := bonds.RoundingSurplusInvariant(app.BondsKeeper)(ctx)
+	require.False(t, broken)
+
+	// Once a tap withdraws from the reserve, the reserve no longer follows
+	// the curve, but it is still exactly the expected reserve and surplus
+	tap := types.NewTap(initCreator, sdk.NewInt(100), sdk.ZeroDec(), ctx.BlockHeight())
+	bond.Tap = &tap
+	app.BondsKeeper.SetBond(ctx, token, bond)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	withdrawn, err := app.BondsKeeper.WithdrawTap(ctx, token)
+	require.Nil(t, err)
+	require.False(t, withdrawn.IsZero())
+	h(ctx, newValidMsgSell(5))
+	bonds.EndBlocker(ctx, app.BondsKeeper)
+
+	bond = app.BondsKeeper.MustGetBond(ctx, token)
+	require.Equal(t, sdk.NewDecCoins(bond.CurrentReserve),
+		bond.ExpectedReserve.Add(bond.RoundingSurplus))
+	_, broken = bonds.RoundingSurplusInvariant(app.BondsKeeper)(ctx)
+	require.False(t, broken)
+
+	// Any untracked change to the reserve breaks the invariant
+	bond.CurrentReserve = bond.CurrentReserve.Add(sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 1)))
+	app.BondsKeeper.SetBond(ctx, token, bond)
+	_, broken = bonds.RoundingSurplusInvariant(app.BondsKeeper)(ctx)
+	require.True(t, broken)
+}
+
+func TestSettingReserveStakingWithoutStakingDenomReserveFails(t *testing.T) {
+	app, ctx := createTestApp(false)
+	h := bonds.NewHandler(app.BondsKeeper)
+
+	// Create bond with a reserve that is not the staking denom
+	h(ctx, newValidMsgCreateBond())
+
+	res := h(ctx, types.NewMsgSetReserveStaking(token, sdk.NewDec(50),
+		types.FALSE, initCreator, initSigners))
+	require.False(t, res.IsOK())
+	require.Equal(t, res.Code, bonds.CodeInvalidReserveStaking)
+}
+
+func TestStakingReserveQueuesSellsUntilReserveIsUnbonded(t *testing.T) {
+	app, ctx := createTestApp(false)
+	h := bonds.NewHandler(app.BondsKeeper)
+	stakingDenom := app.StakingKeeper.BondDenom(ctx)
+
+	// Create validator and bond with the staking denom as its reserve
+	validator, res := createValidator(app, ctx)
+	require.True(t, res.IsOK())
+	h(ctx, newValidMsgCreateStakingBond(stakingDenom))
+
+	// Add reserve tokens to user
+	err := addCoinsToUser(app, ctx, sdk.Coins{sdk.NewInt64Coin(stakingDenom, 6000)})
+	require.Nil(t, err)
+
+	// Buy 10 tokens, for which the reserve is 5000 and the tx fee is 5
+	h(ctx, types.NewMsgBuy(userAddress, sdk.NewInt64Coin(token, 10),
+		sdk.NewCoins(sdk.NewInt64Coin(stakingDenom, 6000)), nil))
+	bonds.EndBlocker(ctx, app.BondsKeeper)
+
+	// Set reserve staking with a cap of 50% of the reserve
+	res = h(ctx, types.NewMsgSetReserveStaking(token, sdk.NewDec(50),
+		types.FALSE, initCreator, initSigners))
+	require.True(t, res.IsOK())
+
+	// Delegating more than the cap fails
+	res = h(ctx, types.NewMsgDelegateReserve(token, validator,
+		sdk.NewInt64Coin(stakingDenom, 2501), initCreator, initSigners))
+	require.False(t, res.IsOK())
+	require.Equal(t, res.Code, bonds.CodeStakingCapExceeded)
+
+	// Delegating up to the cap succeeds
+	res = h(ctx, types.NewMsgDelegateReserve(token, validator,
+		sdk.NewInt64Coin(stakingDenom, 2500), initCreator, initSigners))
+	require.True(t, res.IsOK())
+	require.Equal(t, sdk.NewInt(2500), app.BondsKeeper.GetDelegatedReserve(ctx, token))
+	require.Equal(t, sdk.NewInt(2500), app.BondsKeeper.GetLiquidReserve(ctx, token))
+	require.Equal(t, []string{token}, app.BondsKeeper.GetBondTokensWithStakedReserve(ctx))
+
+	// Selling all 10 tokens returns more than the liquid reserve, so the
+	// sell is queued, with its effect on the supply and reserve applied
+	h(ctx, newValidMsgSell(10))
+	bonds.EndBlocker(ctx, app.BondsKeeper)
+	bond := app.BondsKeeper.MustGetBond(ctx, token)
+	require.Len(t, bond.Staking.QueuedSells, 1)
+	require.Equal(t, sdk.ZeroInt(), bond.CurrentSupply.Amount)
+	require.True(t, bond.CurrentReserve.IsZero())
+	userBalance := app.BondsKeeper.CoinKeeper.GetCoins(ctx, userAddress)
+	require.Equal(t, sdk.NewInt(995), userBalance.AmountOf(stakingDenom))
+
+	// The bond cannot be closed while it has queued sells
+	res = h(ctx, types.NewMsgCloseBond(token, initCreator, initSigners))
+	require.False(t, res.IsOK())
+	require.Equal(t, res.Code, bonds.CodeBondCannotBeClosed)
+
+	// The staked reserve is undelegated to pay out the queued sell
+	bonds.EndBlocker(ctx, app.BondsKeeper)
+	require.Equal(t, sdk.ZeroInt(), app.BondsKeeper.GetDelegatedReserve(ctx, token))
+	require.Equal(t, sdk.NewInt(2500), app.BondsKeeper.GetUnbondingReserve(ctx, token))
+
+	// The bond keeps being synced while its reserve is unbonding
+	bonds.BeginBlocker(ctx, app.BondsKeeper)
+	require.Equal(t, []string{token}, app.BondsKeeper.GetBondTokensWithStakedReserve(ctx))
+
+	// Once unbonding completes, the user is paid the returns minus fees
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(app.StakingKeeper.UnbondingTime(ctx)))
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	bonds.EndBlocker(ctx, app.BondsKeeper)
+	bond = app.BondsKeeper.MustGetBond(ctx, token)
+	require.False(t, bond.Staking.HasQueuedSells())
+	userBalance = app.BondsKeeper.CoinKeeper.GetCoins(ctx, userAddress)
+	require.Equal(t, sdk.NewInt(5985), userBalance.AmountOf(stakingDenom))
+
+	// With nothing staked anymore, the bond is no longer synced
+	bonds.BeginBlocker(ctx, app.BondsKeeper)
+	require.Empty(t, app.BondsKeeper.GetBondTokensWithStakedReserve(ctx))
+}
+
+func TestUpdatingABondRoleWithNonAdminSignersFails(t *testing.T) {
+	app, ctx := createTestApp(false)
+	h := bonds.NewHandler(app.BondsKeeper)
+
+	// Set bond to simulate creation
+	app.BondsKeeper.SetBond(ctx, token, newSimpleBond())
+
+	// Try to grant pauser role to self
+	msg := types.NewMsgUpdateBondRole(token, types.RolePauser,
+		[]sdk.AccAddress{anotherAddress}, sdk.OneUint(),
+		anotherAddress, []sdk.AccAddress{anotherAddress})
+	res := h(ctx, msg)
+
+	require.False(t, res.IsOK())
+	require.Equal(t, res.Code, bonds.CodeSignersNotAuthorized)
+	pauser, _ := app.BondsKeeper.MustGetBond(ctx, token).Roles.Get(types.RolePauser)
+	require.Equal(t, initSigners, pauser.Addresses)
+}
+
+func TestUpdatingABondRoleGrantsAndRevokesRole(t *testing.T) {
+	app, ctx := createTestApp(false)
+	h := bonds.NewHandler(app.BondsKeeper)
+
+	// Set bond to simulate creation
+	app.BondsKeeper.SetBond(ctx, token, newSimpleBond())
+
+	// Admin hands pauser role over to another address
+	res := h(ctx, types.NewMsgUpdateBondRole(token, types.RolePauser,
+		[]sdk.AccAddress{anotherAddress}, sdk.OneUint(), initCreator, initSigners))
+	require.True(t, res.IsOK())
+
+	// Previous pauser can no longer pause the bond
+	res = h(ctx, types.NewMsgSetBondPaused(token, types.TRUE, initCreator, initSigners))
+	require.False(t, res.IsOK())
+	require.Equal(t, res.Code, bonds.CodeSignersNotAuthorized)
+
+	// New pauser can pause the bond
+	res = h(ctx, types.NewMsgSetBondPaused(token, types.TRUE, anotherAddress,
+		[]sdk.AccAddress{anotherAddress}))
+	require.True(t, res.IsOK())
+	require.True(t, app.BondsKeeper.MustGetBond(ctx, token).IsPaused())
+
+	// New pauser does not hold any other role
+	res = h(ctx, types.NewMsgEditBond(token, "newName", types.DoNotModifyField,
+		types.DoNotModifyField, types.DoNotModifyField, types.DoNotModifyField,
+		anotherAddress, []sdk.AccAddress{anotherAddress}))
+	require.False(t, res.IsOK())
+	require.Equal(t, res.Code, bonds.CodeSignersNotAuthorized)
+}
+
+func TestUpdatingABondRoleUpdatesSignerIndex(t *testing.T) {
+	app, ctx := createTestApp(false)
+	h := bonds.NewHandler(app.BondsKeeper)
+
+	// Set bond to simulate creation
+	app.BondsKeeper.SetBond(ctx, token, newSimpleBond())
+	require.Empty(t, app.BondsKeeper.GetBondTokensBySigner(ctx, anotherAddress))
+
+	// Granting a role to another address adds the bond to its signer index
+	res := h(ctx, types.NewMsgUpdateBondRole(token, types.RolePauser,
+		[]sdk.AccAddress{anotherAddress}, sdk.OneUint(), initCreator, initSigners))
+	require.True(t, res.IsOK())
+	require.Equal(t, []string{token}, app.BondsKeeper.GetBondTokensBySigner(ctx, anotherAddress))
+
+	// Revoking the role again removes the bond from its signer index
+	res = h(ctx, types.NewMsgUpdateBondRole(token, types.RolePauser,
+		initSigners, sdk.OneUint(), initCreator, initSigners))
+	require.True(t, res.IsOK())
+	require.Empty(t, app.BondsKeeper.GetBondTokensBySigner(ctx, anotherAddress))
+	require.Equal(t, []string{token}, app.BondsKeeper.GetBondTokensBySigner(ctx, initCreator))
+
+	_, broken := bonds.BondIndexesInvariant(app.BondsKeeper)(ctx)
+	require.False(t, broken)
+}
+
+func TestCreatingABondWithFeeRecipientsSplitsFees(t *testing.T) {
+	app, ctx := createTestApp(false)
+	h := bonds.NewHandler(app.BondsKeeper)
+
+	// Create bond with a 10% tx fee split 50/50, with remainders
+	// going to the fee address
+	msg := newValidMsgCreateBond()
+	msg.TxFeePercentage = sdk.NewDec(10)
+	msg.FeeRecipients = types.FeeRecipients{
+		types.NewFeeRecipient(anotherAddress, sdk.NewDec(50)),
+		types.NewFeeRecipient(initFeeAddress, sdk.NewDec(50)),
+	}
+	res := h(ctx, msg)
+	require.True(t, res.IsOK())
+
+	// Add reserve tokens to user
+	err := addCoinsToUser(app, ctx, sdk.Coins{sdk.NewInt64Coin(reserveToken, 4000)})
+	require.Nil(t, err)
+
+	// Buy 2 tokens, for which the tx fee is 24
+	res = h(ctx, newValidMsgBuy(2, 4000))
+	require.True(t, res.IsOK())
+	bonds.EndBlocker(ctx, app.BondsKeeper)
+
+	anotherBalance := app.BondsKeeper.CoinKeeper.GetCoins(ctx, anotherAddress)
+	feeBalance := app.BondsKeeper.CoinKeeper.GetCoins(ctx, initFeeAddress)
+	require.Equal(t, sdk.NewInt(12), anotherBalance.AmountOf(reserveToken))
+	require.Equal(t, sdk.NewInt(12), feeBalance.AmountOf(reserveToken))
+}
+
+func TestSettingFeeRecipientsWithNonFeeManagerSignersFails(t *testing.T) {
+	app, ctx := createTestApp(false)
+	h := bonds.NewHandler(app.BondsKeeper)
+
+	// Create bond
+	h(ctx, newValidMsgCreateBond())
+
+	// Try to redirect all fees to self
+	recipients := types.NewDefaultFeeRecipients(anotherAddress)
+	res := h(ctx, types.NewMsgSetFeeRecipients(token, recipients,
+		anotherAddress, anotherAddress, []sdk.AccAddress{anotherAddress}))
+
+	require.False(t, res.IsOK())
+	require.Equal(t, res.Code, bonds.CodeSignersNotAuthorized)
+	bond := app.BondsKeeper.MustGetBond(ctx, token)
+	require.Equal(t, initFeeAddress, bond.FeeAddress)
+	require.Equal(t, types.NewDefaultFeeRecipients(initFeeAddress), bond.FeeRecipients)
+}
+
+func TestSettingFeeRecipientsSplitsSubsequentFees(t *testing.T) {
+	app, ctx := createTestApp(false)
+	h := bonds.NewHandler(app.BondsKeeper)
+
+	// Create bond with a 10% tx fee
+	msg := newValidMsgCreateBond()
+	msg.TxFeePercentage = sdk.NewDec(10)
+	h(ctx, msg)
+
+	// Fee manager splits fees 75/25, with remainders going to anotherAddress
+	recipients := types.FeeRecipients{
+		types.NewFeeRecipient(initFeeAddress, sdk.NewDec(75)),
+		types.NewFeeRecipient(anotherAddress, sdk.NewDec(25)),
+	}
+	res := h(ctx, types.NewMsgSetFeeRecipients(token, recipients,
+		anotherAddress, initCreator, initSigners))
+	require.True(t, res.IsOK())
+	require.Equal(t, anotherAddress, app.BondsKeeper.MustGetBond(ctx, token).FeeAddress)
+
+	// Add reserve tokens to user
+	err := addCoinsToUser(app, ctx, sdk.Coins{sdk.NewInt64Coin(reserveToken, 4000)})
+	require.Nil(t, err)
+
+	// Buy 2 tokens, for which the tx fee is 24
+	res = h(ctx, newValidMsgBuy(2, 4000))
+	require.True(t, res.IsOK())
+	bonds.EndBlocker(ctx, app.BondsKeeper)
+
+	feeBalance := app.BondsKeeper.CoinKeeper.GetCoins(ctx, initFeeAddress)
+	anotherBalance := app.BondsKeeper.CoinKeeper.GetCoins(ctx, anotherAddress)
+	require.Equal(t, sdk.NewInt(18), feeBalance.AmountOf(reserveToken))
+	require.Equal(t, sdk.NewInt(6), anotherBalance.AmountOf(reserveToken))
+}
+
+func TestSettingFeeScheduleWithNonFeeManagerSignersFails(t *testing.T) {
+	app, ctx := createTestApp(false)
+	h := bonds.NewHandler(app.BondsKeeper)
+
+	// Create bond
+	h(ctx, newValidMsgCreateBond())
+
+	// Try to waive all fees
+	feeSchedule := types.NewFeeSchedule(types.FeeTiers{
+		types.NewFeeTier(sdk.ZeroDec(), sdk.ZeroDec())}, nil, sdk.ZeroUint())
+	res := h(ctx, types.NewMsgSetFeeSchedule(token, feeSchedule,
+		anotherAddress, []sdk.AccAddress{anotherAddress}))
+
+	require.False(t, res.IsOK())
+	require.Equal(t, res.Code, bonds.CodeSignersNotAuthorized)
+	bond := app.BondsKeeper.MustGetBond(ctx, token)
+	require.Equal(t, types.NewDefaultFeeSchedule(), bond.FeeSchedule)
+}
+
+func TestSettingFeeScheduleAboveMaxFeeFails(t *testing.T) {
+	app, ctx := createTestApp(false)
+	h := bonds.NewHandler(app.BondsKeeper)
+
+	// Create bond with a 10% tx fee
+	msg := newValidMsgCreateBond()
+	msg.TxFeePercentage = sdk.NewDec(10)
+	h(ctx, msg)
+
+	// Volatility multiplier would raise the tx fee above the max tx fee
+	maxTxFee := app.BondsKeeper.GetParams(ctx).MaxTxFeePercentage
+	multiplier := maxTxFee.QuoInt64(10).Add(sdk.OneDec())
+	feeSchedule := types.NewFeeSchedule(nil, types.FeeTiers{
+		types.NewFeeTier(sdk.NewDec(10), multiplier)}, sdk.NewUint(5))
+	res := h(ctx, types.NewMsgSetFeeSchedule(token, feeSchedule,
+		initCreator, initSigners))
+
+	require.False(t, res.IsOK())
+	require.Equal(t, res.Code, bonds.CodeFeeTooLarge)
+}
+
+func TestSettingFeeScheduleDiscountsSubsequentLargeBuys(t *testing.T) {
+	app, ctx := createTestApp(false)
+	h := bonds.NewHandler(app.BondsKeeper)
+
+	// Create bond with a 10% tx fee
+	msg := newValidMsgCreateBond()
+	msg.TxFeePercentage = sdk.NewDec(10)
+	h(ctx, msg)
+
+	// Fee manager halves the tx fee for orders of at least 100res
+	feeSchedule := types.NewFeeSchedule(types.FeeTiers{
+		types.NewFeeTier(sdk.NewDec(100), sdk.MustNewDecFromStr("0.5"))},
+		nil, sdk.ZeroUint())
+	res := h(ctx, types.NewMsgSetFeeSchedule(token, feeSchedule,
+		initCreator, initSigners))
+	require.True(t, res.IsOK())
+
+	// Add reserve tokens to user
+	err := addCoinsToUser(app, ctx, sdk.Coins{sdk.NewInt64Coin(reserveToken, 4000)})
+	require.Nil(t, err)
+
+	// Buy 2 tokens, for which the tx fee would be 24 without the discount
+	res = h(ctx, newValidMsgBuy(2, 4000))
+	require.True(t, res.IsOK())
+	bonds.EndBlocker(ctx, app.BondsKeeper)
+
+	feeBalance := app.BondsKeeper.CoinKeeper.GetCoins(ctx, initFeeAddress)
+	require.Equal(t, sdk.NewInt(12), feeBalance.AmountOf(reserveToken))
+}
+
+func TestClaimingBondRewardsWithNoRewardsFails(t *testing.T) {
+	app, ctx := createTestApp(false)
+	h := bonds.NewHandler(app.BondsKeeper)
+
+	// Create bond with 50% of fees going to holders
+	msg := newValidMsgCreateBond()
+	msg.HolderRewardsPercentage = sdk.NewDec(50)
+	h(ctx, msg)
+
+	res := h(ctx, types.NewMsgClaimBondRewards(token, userAddress))
+
+	require.False(t, res.IsOK())
+	require.Equal(t, res.Code, bonds.CodeNoRewardsToClaim)
+}
+
+func TestClaimingBondRewardsPaysHoldersShareOfFees(t *testing.T) {
+	app, ctx := createTestApp(false)
+	h := bonds.NewHandler(app.BondsKeeper)
+
+	// Create bond with a 10% tx fee, 50% of which goes to holders
+	msg := newValidMsgCreateBond()
+	msg.TxFeePercentage = sdk.NewDec(10)
+	msg.HolderRewardsPercentage = sdk.NewDec(50)
+	res := h(ctx, msg)
+	require.True(t, res.IsOK())
+
+	// Add reserve tokens to user
+	err := addCoinsToUser(app, ctx, sdk.Coins{sdk.NewInt64Coin(reserveToken, 10000)})
+	require.Nil(t, err)
+
+	// Buy 2 tokens, for which the tx fee is 24, of which 12 goes to the
+	// user since tokens are minted before fees are paid
+	res = h(ctx, newValidMsgBuy(2, 4000))
+	require.True(t, res.IsOK())
+	bonds.EndBlocker(ctx, app.BondsKeeper)
+	claimable := app.BondsKeeper.GetClaimableRewards(ctx, token, userAddress)
+	require.Equal(t, sdk.NewInt(12), claimable.AmountOf(reserveToken))
+
+	// User sends one of the tokens to anotherAddress
+	_, err = app.BondsKeeper.CoinKeeper.SubtractCoins(ctx, userAddress,
+		sdk.Coins{sdk.NewInt64Coin(token, 1)})
+	require.Nil(t, err)
+	_, err = app.BondsKeeper.CoinKeeper.AddCoins(ctx, anotherAddress,
+		sdk.Coins{sdk.NewInt64Coin(token, 1)})
+	require.Nil(t, err)
+
+	// Buy 2 more tokens, for which the tx fee is 43, of which 21 is split
+	// among the 4 tokens (5.25 per token), 3 held by user and 1 by another
+	res = h(ctx, newValidMsgBuy(2, 5000))
+	require.True(t, res.IsOK())
+	bonds.EndBlocker(ctx, app.BondsKeeper)
+
+	// Both holders claim their rewards, leaving behind rounding remainders
+	res = h(ctx, types.NewMsgClaimBondRewards(token, userAddress))
+	require.True(t, res.IsOK())
+	res = h(ctx, types.NewMsgClaimBondRewards(token, anotherAddress))
+	require.True(t, res.IsOK())
+
+	userBalance := app.BondsKeeper.CoinKeeper.GetCoins(ctx, userAddress)
+	anotherBalance := app.BondsKeeper.CoinKeeper.GetCoins(ctx, anotherAddress)
+	feeBalance := app.BondsKeeper.CoinKeeper.GetCoins(ctx, initFeeAddress)
+	require.Equal(t, sdk.NewInt(10000-232-24-424-43+27), userBalance.AmountOf(reserveToken))
+	require.Equal(t, sdk.NewInt(5), anotherBalance.AmountOf(reserveToken))
+	require.Equal(t, sdk.NewInt(12+22), feeBalance.AmountOf(reserveToken))
+	require.Equal(t, sdk.NewInt(1),
+		app.BondsKeeper.MustGetBond(ctx, token).RewardsPool.AmountOf(reserveToken))
+}
+
+func TestSettingCircuitBreakerWithDifferentSignersFails(t *testing.T) {
+	app, ctx := createTestApp(false)
+	h := bonds.NewHandler(app.BondsKeeper)
+
+	// Set bond to simulate creation
+	app.BondsKeeper.SetBond(ctx, token, newSimpleBond())
+
+	// Set circuit breaker
+	msg := types.NewMsgSetCircuitBreaker(token, sdk.NewDec(10),
+		types.CircuitBreakerCancel, sdk.ZeroUint(), initCreator, []sdk.AccAddress{anotherAddress})
+	res := h(ctx, msg)
+
+	require.False(t, res.IsOK())
+	require.Equal(t, res.Code, bonds.CodeSignersNotAuthorized)
+	require.False(t, app.BondsKeeper.MustGetBond(ctx, token).HasMaxPriceMove())
+}
+
+func TestCircuitBreakerCancelsOrdersThatMoveThePriceTooMuch(t *testing.T) {
+	app, ctx := createTestApp(false)
+	h := bonds.NewHandler(app.BondsKeeper)
+
+	// Create bond with a max price move of 10%
+	h(ctx, newValidMsgCreateBond())
+	res := h(ctx, types.NewMsgSetCircuitBreaker(token, sdk.NewDec(10),
+		types.CircuitBreakerCancel, sdk.ZeroUint(), initCreator, initSigners))
+	require.True(t, res.IsOK())
+
+	// Add reserve tokens to user
+	err := addCoinsToUser(app, ctx, sdk.Coins{sdk.NewInt64Coin(reserveToken, 100000)})
+	require.Nil(t, err)
+
+	// Buying 3 tokens moves the price from 100 to 136 per token (36%)
+	h(ctx, newValidMsgBuy(2, 10000))
+	h(ctx, newValidMsgBuy(1, 10000))
+	bonds.EndBlocker(ctx, app.BondsKeeper)
+
+	// Larger buy cancelled, bringing the price move down to 4%
+	lastBatch := app.BondsKeeper.MustGetLastBatch(ctx, token)
+	require.True(t, lastBatch.Buys[0].IsCancelled())
+	require.False(t, lastBatch.Buys[1].IsCancelled())
+	require.Equal(t, sdk.OneInt(), app.BondsKeeper.MustGetBond(ctx, token).CurrentSupply.Amount)
+	require.Equal(t, sdk.OneInt(), app.BankKeeper.GetCoins(ctx, userAddress).AmountOf(token))
+}
+
+func TestCircuitBreakerHaltsBondThatMovesThePriceTooMuch(t *testing.T) {
+	app, ctx := createTestApp(false)
+	h := bonds.NewHandler(app.BondsKeeper)
+
+	// Create bond with a max price move of 10% and a halt of 2 blocks
+	h(ctx, newValidMsgCreateBond())
+	res := h(ctx, types.NewMsgSetCircuitBreaker(token, sdk.NewDec(10),
+		types.CircuitBreakerHalt, sdk.NewUint(2), initCreator, initSigners))
+	require.True(t, res.IsOK())
+
+	// Add reserve tokens to user
+	err := addCoinsToUser(app, ctx, sdk.Coins{sdk.NewInt64Coin(reserveToken, 100000)})
+	require.Nil(t, err)
+
+	// Buying 2 tokens moves the price from 100 to 116 per token (16%)
+	h(ctx, newValidMsgBuy(2, 10000))
+	bonds.EndBlocker(ctx, app.BondsKeeper)
+
+	// Buy cancelled and refunded, and bond halted
+	require.True(t, app.BondsKeeper.MustGetLastBatch(ctx, token).Buys[0].IsCancelled())
+	require.True(t, app.BondsKeeper.MustGetBond(ctx, token).CurrentSupply.IsZero())
+	require.Equal(t, sdk.NewInt(100000), app.BankKeeper.GetCoins(ctx, userAddress).AmountOf(reserveToken))
+	require.True(t, app.BondsKeeper.MustGetBond(ctx, token).IsHalted())
+
+	// Buying fails until the halt is over
+	res = h(ctx, newValidMsgBuy(1, 10000))
+	require.False(t, res.IsOK())
+	require.Equal(t, res.Code, bonds.CodeBondHalted)
+	bonds.EndBlocker(ctx, app.BondsKeeper)
+	bonds.EndBlocker(ctx, app.BondsKeeper)
+	require.False(t, app.BondsKeeper.MustGetBond(ctx, token).IsHalted())
+	res = h(ctx, newValidMsgBuy(1, 10000))
+	require.True(t, res.IsOK())
+}
+
 func TestEditingANonExistingBondFails(t *testing.T) {
 	app, ctx := createTestApp(false)
 	h := bonds.NewHandler(app.BondsKeeper)
@@ -90,7 +1075,7 @@ func TestEditingABondWithDifferentCreatorAndSignersFails(t *testing.T) {
 	res := h(ctx, msg)
 
 	require.False(t, res.IsOK())
-	require.Contains(t, res.Log, "List of signers does not match the one in the bond")
+	require.Equal(t, bonds.CodeSignersNotAuthorized, res.Code)
 }
 
 func TestEditingABondWithNegativeOrderQuantityLimitsFails(t *testing.T) {
@@ -390,6 +1375,32 @@ func TestBuyingABondWithoutSufficientFundsDueToTxFeeFails(t *testing.T) {
 	require.True(t, currentSupply.Amount.IsZero())
 }
 
+func TestBuyingIntoAFullBatchFails(t *testing.T) {
+	app, ctx := createTestApp(false)
+	h := bonds.NewHandler(app.BondsKeeper)
+
+	params := app.BondsKeeper.GetParams(ctx)
+	params.MaxOrdersPerBatch = 1
+	app.BondsKeeper.SetParams(ctx, params)
+
+	// Create bond
+	h(ctx, newValidMsgCreateBond())
+
+	// Add reserve tokens to user
+	err := addCoinsToUser(app, ctx, sdk.Coins{sdk.NewInt64Coin(reserveToken, 1000000)})
+	require.Nil(t, err)
+
+	// First buy fills the batch
+	res := h(ctx, newValidMsgBuy(2, 10000))
+	require.True(t, res.IsOK())
+
+	// Second buy exceeds the max number of orders
+	res = h(ctx, newValidMsgBuy(2, 10000))
+	require.False(t, res.IsOK())
+	require.Equal(t, res.Code, bonds.CodeMaxOrdersReached)
+	require.Equal(t, 1, len(app.BondsKeeper.MustGetBatch(ctx, token).Buys))
+}
+
 func TestBuyingABondCorrectlyPasses(t *testing.T) {
 	app, ctx := createTestApp(false)
 	h := bonds.NewHandler(app.BondsKeeper)
@@ -417,6 +1428,48 @@ func TestBuyingABondCorrectlyPasses(t *testing.T) {
 	require.Equal(t, sdk.NewInt(2), currentSupply.Amount)
 }
 
+func TestBuyingABondWithReferrerPaysReferralFee(t *testing.T) {
+	app, ctx := createTestApp(false)
+	h := bonds.NewHandler(app.BondsKeeper)
+
+	// Create bond with a 10% tx fee, 50% of which goes to referrers
+	msg := newValidMsgCreateBond()
+	msg.TxFeePercentage = sdk.NewDec(10)
+	msg.ReferralFeePercentage = sdk.NewDec(50)
+	res := h(ctx, msg)
+	require.True(t, res.IsOK())
+
+	// Add reserve tokens to user
+	err := addCoinsToUser(app, ctx, sdk.Coins{sdk.NewInt64Coin(reserveToken, 4000)})
+	require.Nil(t, err)
+
+	// Buy 2 tokens referred by anotherAddress, for which the tx fee is 24,
+	// of which 12 goes to the referrer and 12 to the fee address
+	buyMsg := newValidMsgBuy(2, 4000)
+	buyMsg.Referrer = anotherAddress
+	res = h(ctx, buyMsg)
+	require.True(t, res.IsOK())
+	bonds.EndBlocker(ctx, app.BondsKeeper)
+
+	referrerBalance := app.BondsKeeper.CoinKeeper.GetCoins(ctx, anotherAddress)
+	feeBalance := app.BondsKeeper.CoinKeeper.GetCoins(ctx, initFeeAddress)
+	require.Equal(t, sdk.NewInt(12), referrerBalance.AmountOf(reserveToken))
+	require.Equal(t, sdk.NewInt(12), feeBalance.AmountOf(reserveToken))
+
+	referrerTotal := app.BondsKeeper.GetReferrerTotal(ctx, token, anotherAddress)
+	require.Equal(t, sdk.OneUint(), referrerTotal.Buys)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 12)), referrerTotal.Paid)
+
+	// Buy 2 more tokens without a referrer, leaving the referrer unchanged
+	err = addCoinsToUser(app, ctx, sdk.Coins{sdk.NewInt64Coin(reserveToken, 10000)})
+	require.Nil(t, err)
+	res = h(ctx, newValidMsgBuy(2, 10000))
+	require.True(t, res.IsOK())
+	bonds.EndBlocker(ctx, app.BondsKeeper)
+
+	require.Equal(t, referrerTotal, app.BondsKeeper.GetReferrerTotal(ctx, token, anotherAddress))
+}
+
 func TestSellingANonExistingBondFails(t *testing.T) {
 	app, ctx := createTestApp(false)
 	h := bonds.NewHandler(app.BondsKeeper)
@@ -602,6 +1655,37 @@ func TestSellingABondCorrectlyPasses(t *testing.T) {
 	require.Equal(t, sdk.ZeroInt(), currentSupply.Amount)
 }
 
+func TestSellingABondAfterHoldingPeriodChargesMinExitFee(t *testing.T) {
+	app, ctx := createTestApp(false)
+	h := bonds.NewHandler(app.BondsKeeper)
+
+	// Create bond with exit fee that decreases to zero over 10 blocks
+	createMsg := newValidMsgCreateBond()
+	createMsg.MinExitFeePercentage = sdk.ZeroDec()
+	createMsg.ExitFeeHoldingPeriod = sdk.NewUint(10)
+	h(ctx, createMsg)
+
+	// Add reserve tokens to user
+	err := addCoinsToUser(app, ctx, sdk.Coins{sdk.NewInt64Coin(reserveToken, 4000)})
+	require.Nil(t, err)
+
+	// Buy 2 tokens at height 0
+	h(ctx, newValidMsgBuy(2, 4000))
+	bonds.EndBlocker(ctx, app.BondsKeeper)
+
+	// Sell 2 tokens at height 10; no exit fee is charged
+	ctx = ctx.WithBlockHeight(10)
+	res := h(ctx, newValidMsgSell(2))
+	bonds.EndBlocker(ctx, app.BondsKeeper)
+
+	userBalance := app.BondsKeeper.CoinKeeper.GetCoins(ctx, userAddress)
+	feeBalance := app.BondsKeeper.CoinKeeper.GetCoins(ctx, initFeeAddress)
+	require.True(t, res.IsOK())
+	require.Equal(t, sdk.NewInt(3998), userBalance.AmountOf(reserveToken))
+	require.Equal(t, sdk.NewInt(2), feeBalance.AmountOf(reserveToken))
+	require.Empty(t, app.BondsKeeper.GetHolderLots(ctx, token, userAddress).Lots)
+}
+
 func TestSwapBondDoesNotExistFails(t *testing.T) {
 	app, ctx := createTestApp(false)
 	h := bonds.NewHandler(app.BondsKeeper)
@@ -762,6 +1846,58 @@ func TestSwapValidAmount(t *testing.T) {
 	require.Equal(t, sdk.OneInt(), feeBalance.AmountOf(reserveToken))
 }
 
+func TestSwapWithLiquidityFeeGrowsReserveForSellers(t *testing.T) {
+	app, ctx := createTestApp(false)
+	h := bonds.NewHandler(app.BondsKeeper)
+
+	// Create bond that keeps all swap fees in the reserve
+	createMsg := newValidMsgCreateSwapperBond()
+	createMsg.LiquidityFeePercentage = sdk.NewDec(100)
+	h(ctx, createMsg)
+
+	// Add reserve tokens to user
+	coins := sdk.NewCoins(
+		sdk.NewInt64Coin(reserveToken, 100000),
+		sdk.NewInt64Coin(reserveToken2, 100000),
+	)
+	err := addCoinsToUser(app, ctx, coins)
+	require.Nil(t, err)
+
+	// Buy 2 tokens
+	buyMsg := newValidMsgBuy(2, 0) // 0 max prices replaced below
+	buyMsg.MaxPrices = sdk.NewCoins(
+		sdk.NewInt64Coin(reserveToken, 10000),
+		sdk.NewInt64Coin(reserveToken2, 10000),
+	)
+	h(ctx, buyMsg)
+	bonds.EndBlocker(ctx, app.BondsKeeper)
+
+	// Perform swap; the 1res fee stays in the reserve
+	res := h(ctx, newValidMsgSwap(reserveToken, reserveToken2, 10))
+	bonds.EndBlocker(ctx, app.BondsKeeper)
+
+	reserveBalance := app.BondsKeeper.GetReserveBalances(ctx, initToken)
+	feeBalance := app.BondsKeeper.CoinKeeper.GetCoins(ctx, initFeeAddress)
+	require.True(t, res.IsOK())
+	require.Equal(t, sdk.NewInt(10010), reserveBalance.AmountOf(reserveToken))
+	require.Equal(t, sdk.NewInt(9992), reserveBalance.AmountOf(reserveToken2))
+	require.True(t, feeBalance.AmountOf(reserveToken).IsZero())
+
+	// Sell 2 tokens; seller gets the grown reserve (minus sell fees)
+	res = h(ctx, newValidMsgSell(2))
+	bonds.EndBlocker(ctx, app.BondsKeeper)
+
+	userBalance := app.BondsKeeper.CoinKeeper.GetCoins(ctx, userAddress)
+	reserveBalance = app.BondsKeeper.GetReserveBalances(ctx, initToken)
+	feeBalance = app.BondsKeeper.CoinKeeper.GetCoins(ctx, initFeeAddress)
+	require.True(t, res.IsOK())
+	require.True(t, reserveBalance.IsZero())
+	require.Equal(t, sdk.NewInt(100000).Sub(feeBalance.AmountOf(reserveToken)),
+		userBalance.AmountOf(reserveToken))
+	require.Equal(t, sdk.NewInt(100000).Sub(feeBalance.AmountOf(reserveToken2)),
+		userBalance.AmountOf(reserveToken2))
+}
+
 func TestSwapValidAmountReversed(t *testing.T) {
 	app, ctx := createTestApp(false)
 	h := bonds.NewHandler(app.BondsKeeper)
@@ -866,3 +2002,33 @@ func TestEndBlockerPerformsOrdersAfterASpecifiedNumberOfBlocks(t *testing.T) {
 	// Buys have been performed
 	require.Equal(t, 0, len(app.BondsKeeper.MustGetBatch(ctx, token).Buys))
 }
+
+func TestEndBlockerRecordsCandleOfPerformedBatch(t *testing.T) {
+	app, ctx := createTestApp(false)
+	h := bonds.NewHandler(app.BondsKeeper)
+
+	// Create bond
+	h(ctx, newValidMsgCreateBond())
+
+	// Add reserve tokens to user
+	err := addCoinsToUser(app, ctx, sdk.Coins{sdk.NewInt64Coin(reserveToken, 1000000)})
+	require.Nil(t, err)
+
+	// Buy 4 tokens and perform the batch
+	h(ctx, newValidMsgBuy(2, 10000))
+	h(ctx, newValidMsgBuy(2, 10000))
+	bonds.EndBlocker(ctx, app.BondsKeeper)
+
+	// Candle records the buys and the prices before and after the batch
+	bond := app.BondsKeeper.MustGetBond(ctx, token)
+	closePrices, err := bond.GetCurrentPricesPT(
+		app.BondsKeeper.GetReserveBalances(ctx, token))
+	require.Nil(t, err)
+	candles := app.BondsKeeper.GetCandles(ctx, token)
+	require.Len(t, candles, 1)
+	require.Equal(t, sdk.NewUint(2), candles[0].Buys)
+	require.Equal(t, sdk.NewInt(4), candles[0].BuyVolume)
+	require.Equal(t, closePrices, candles[0].Close)
+	require.True(t, candles[0].Open.AmountOf(reserveToken).LT(
+		candles[0].Close.AmountOf(reserveToken)))
+}
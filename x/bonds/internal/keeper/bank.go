@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/ixoworld/bonds/x/bonds/internal/types"
+)
+
+// BankKeeperWithHooks wraps a bank keeper and calls the bank hooks before
+// and after any change to account balances. It has to be passed by reference, so
+// that the hooks can be set after any keepers that depend on it are created.
+type BankKeeperWithHooks struct {
+	bank.Keeper
+	hooks types.BankHooks
+}
+
+var _ bank.Keeper = (*BankKeeperWithHooks)(nil)
+
+func NewBankKeeperWithHooks(bankKeeper bank.Keeper) *BankKeeperWithHooks {
+	return &BankKeeperWithHooks{Keeper: bankKeeper}
+}
+
+// Set the bank hooks
+func (bk *BankKeeperWithHooks) SetHooks(bh types.BankHooks) *BankKeeperWithHooks {
+	if bk.hooks != nil {
+		panic("cannot set bank hooks twice")
+	}
+	bk.hooks = bh
+	return bk
+}
+
+func (bk *BankKeeperWithHooks) beforeCoinsChange(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) {
+	if bk.hooks != nil {
+		bk.hooks.BeforeCoinsChange(ctx, addr, amt)
+	}
+}
+
+func (bk *BankKeeperWithHooks) afterCoinsChange(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) {
+	if bk.hooks != nil {
+		bk.hooks.AfterCoinsChange(ctx, addr, amt)
+	}
+}
+
+func (bk *BankKeeperWithHooks) InputOutputCoins(ctx sdk.Context,
+	inputs []bank.Input, outputs []bank.Output) sdk.Error {
+	for _, in := range inputs {
+		bk.beforeCoinsChange(ctx, in.Address, in.Coins)
+	}
+	for _, out := range outputs {
+		bk.beforeCoinsChange(ctx, out.Address, out.Coins)
+	}
+	if err := bk.Keeper.InputOutputCoins(ctx, inputs, outputs); err != nil {
+		return err
+	}
+	for _, in := range inputs {
+		bk.afterCoinsChange(ctx, in.Address, in.Coins)
+	}
+	for _, out := range outputs {
+		bk.afterCoinsChange(ctx, out.Address, out.Coins)
+	}
+	return nil
+}
+
+func (bk *BankKeeperWithHooks) SendCoins(ctx sdk.Context,
+	fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	bk.beforeCoinsChange(ctx, fromAddr, amt)
+	bk.beforeCoinsChange(ctx, toAddr, amt)
+	if err := bk.Keeper.SendCoins(ctx, fromAddr, toAddr, amt); err != nil {
+		return err
+	}
+	bk.afterCoinsChange(ctx, fromAddr, amt)
+	bk.afterCoinsChange(ctx, toAddr, amt)
+	return nil
+}
+
+func (bk *BankKeeperWithHooks) SubtractCoins(ctx sdk.Context,
+	addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Error) {
+	bk.beforeCoinsChange(ctx, addr, amt)
+	coins, err := bk.Keeper.SubtractCoins(ctx, addr, amt)
+	if err != nil {
+		return coins, err
+	}
+	bk.afterCoinsChange(ctx, addr, amt)
+	return coins, nil
+}
+
+func (bk *BankKeeperWithHooks) AddCoins(ctx sdk.Context,
+	addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Error) {
+	bk.beforeCoinsChange(ctx, addr, amt)
+	coins, err := bk.Keeper.AddCoins(ctx, addr, amt)
+	if err != nil {
+		return coins, err
+	}
+	bk.afterCoinsChange(ctx, addr, amt)
+	return coins, nil
+}
+
+func (bk *BankKeeperWithHooks) SetCoins(ctx sdk.Context,
+	addr sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	// Any coins held before being overwritten are also about to change
+	changed := bk.GetCoins(ctx, addr).Add(amt)
+	bk.beforeCoinsChange(ctx, addr, changed)
+	if err := bk.Keeper.SetCoins(ctx, addr, amt); err != nil {
+		return err
+	}
+	bk.afterCoinsChange(ctx, addr, changed)
+	return nil
+}
+
+func (bk *BankKeeperWithHooks) DelegateCoins(ctx sdk.Context,
+	delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	bk.beforeCoinsChange(ctx, delegatorAddr, amt)
+	bk.beforeCoinsChange(ctx, moduleAccAddr, amt)
+	if err := bk.Keeper.DelegateCoins(ctx, delegatorAddr, moduleAccAddr, amt); err != nil {
+		return err
+	}
+	bk.afterCoinsChange(ctx, delegatorAddr, amt)
+	bk.afterCoinsChange(ctx, moduleAccAddr, amt)
+	return nil
+}
+
+func (bk *BankKeeperWithHooks) UndelegateCoins(ctx sdk.Context,
+	moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	bk.beforeCoinsChange(ctx, moduleAccAddr, amt)
+	bk.beforeCoinsChange(ctx, delegatorAddr, amt)
+	if err := bk.Keeper.UndelegateCoins(ctx, moduleAccAddr, delegatorAddr, amt); err != nil {
+		return err
+	}
+	bk.afterCoinsChange(ctx, moduleAccAddr, amt)
+	bk.afterCoinsChange(ctx, delegatorAddr, amt)
+	return nil
+}
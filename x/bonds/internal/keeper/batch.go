@@ -38,16 +38,60 @@ func (k Keeper) LastBatchExists(ctx sdk.Context, token string) bool {
 	return store.Has(types.GetLastBatchKey(token))
 }
 
+func (k Keeper) BatchIsFull(ctx sdk.Context, token string) bool {
+	batch := k.MustGetBatch(ctx, token)
+	maxOrders := k.GetParams(ctx).MaxOrdersPerBatch
+	return uint64(batch.NumberOfOrders()) >= maxOrders
+}
+
 func (k Keeper) SetBatch(ctx sdk.Context, token string, batch types.Batch) {
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.GetBatchKey(token), k.cdc.MustMarshalBinaryBare(batch))
 }
 
 func (k Keeper) SetLastBatch(ctx sdk.Context, token string, batch types.Batch) {
+	// Addresses with orders only in the replaced last batch are unindexed
+	if k.LastBatchExists(ctx, token) {
+		remaining := []types.Batch{batch}
+		if k.BatchExists(ctx, token) {
+			remaining = append(remaining, k.MustGetBatch(ctx, token))
+		}
+		k.deleteOrderAddressIndexes(ctx, token,
+			k.MustGetLastBatch(ctx, token), remaining...)
+	}
+
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.GetLastBatchKey(token), k.cdc.MustMarshalBinaryBare(batch))
 }
 
+func (k Keeper) DeleteBatch(ctx sdk.Context, token string) {
+	if k.BatchExists(ctx, token) {
+		var remaining []types.Batch
+		if k.LastBatchExists(ctx, token) {
+			remaining = append(remaining, k.MustGetLastBatch(ctx, token))
+		}
+		k.deleteOrderAddressIndexes(ctx, token,
+			k.MustGetBatch(ctx, token), remaining...)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetBatchKey(token))
+}
+
+func (k Keeper) DeleteLastBatch(ctx sdk.Context, token string) {
+	if k.LastBatchExists(ctx, token) {
+		var remaining []types.Batch
+		if k.BatchExists(ctx, token) {
+			remaining = append(remaining, k.MustGetBatch(ctx, token))
+		}
+		k.deleteOrderAddressIndexes(ctx, token,
+			k.MustGetLastBatch(ctx, token), remaining...)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetLastBatchKey(token))
+}
+
 func (k Keeper) AddBuyOrder(ctx sdk.Context, token string, bo types.BuyOrder, buyPrices, sellPrices sdk.DecCoins) {
 	batch := k.MustGetBatch(ctx, token)
 	batch.TotalBuyAmount = batch.TotalBuyAmount.Add(bo.Amount)
@@ -55,6 +99,7 @@ func (k Keeper) AddBuyOrder(ctx sdk.Context, token string, bo types.BuyOrder, bu
 	batch.SellPrices = sellPrices
 	batch.Buys = append(batch.Buys, bo)
 	k.SetBatch(ctx, token, batch)
+	k.setOrderAddressIndex(ctx, bo.Address, token)
 
 	logger := k.Logger(ctx)
 	logger.Info(fmt.Sprintf("added buy order for %s from %s", bo.Amount.String(), bo.Address.String()))
@@ -67,6 +112,7 @@ func (k Keeper) AddSellOrder(ctx sdk.Context, token string, so types.SellOrder,
 	batch.SellPrices = sellPrices
 	batch.Sells = append(batch.Sells, so)
 	k.SetBatch(ctx, token, batch)
+	k.setOrderAddressIndex(ctx, so.Address, token)
 
 	logger := k.Logger(ctx)
 	logger.Info(fmt.Sprintf("added sell order for %s from %s", so.Amount.String(), so.Address.String()))
@@ -76,12 +122,22 @@ func (k Keeper) AddSwapOrder(ctx sdk.Context, token string, so types.SwapOrder)
 	batch := k.MustGetBatch(ctx, token)
 	batch.Swaps = append(batch.Swaps, so)
 	k.SetBatch(ctx, token, batch)
+	k.setOrderAddressIndex(ctx, so.Address, token)
 
 	logger := k.Logger(ctx)
 	logger.Info(fmt.Sprintf("added swap order for %s to %s from %s", so.Amount.String(), so.ToToken, so.Address.String()))
 }
 
 func (k Keeper) GetBatchBuySellPrices(ctx sdk.Context, token string, batch types.Batch) (buyPricesPT, sellPricesPT sdk.DecCoins, err sdk.Error) {
+	buyPricesPT, sellPricesPT, _, err = k.getBatchBuySellPricesAndTotalValues(ctx, token, batch)
+	return buyPricesPT, sellPricesPT, err
+}
+
+// getBatchBuySellPricesAndTotalValues returns the batch's per-token buy and
+// sell prices, as well as the total values (i.e. the total buy prices if there
+// are more buys than sells, or the total sell returns if there are more sells
+// than buys) from which the prices of the outweighing side are calculated
+func (k Keeper) getBatchBuySellPricesAndTotalValues(ctx sdk.Context, token string, batch types.Batch) (buyPricesPT, sellPricesPT, totalValues sdk.DecCoins, err sdk.Error) {
 	bond := k.MustGetBond(ctx, token)
 
 	buyAmountDec := sdk.NewDecFromInt(batch.TotalBuyAmount.Amount)
@@ -90,7 +146,7 @@ func (k Keeper) GetBatchBuySellPrices(ctx sdk.Context, token string, batch types
 	reserveBalances := k.GetReserveBalances(ctx, token)
 	currentPricesPT, err := bond.GetCurrentPricesPT(reserveBalances)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	// Get (amount of) matched and (actual) curve-calculated value for the remaining amount
@@ -100,13 +156,13 @@ func (k Keeper) GetBatchBuySellPrices(ctx sdk.Context, token string, batch types
 	var curvedValues sdk.DecCoins
 	if batch.EqualBuysAndSells() {
 		// Since equal, both prices are current prices
-		return currentPricesPT, currentPricesPT, nil
+		return currentPricesPT, currentPricesPT, nil, nil
 	} else if batch.MoreBuysThanSells() {
 		matchedAmount = sellAmountDec // since sells < buys, greatest common amount is sells
 		extraBuys := batch.TotalBuyAmount.Sub(batch.TotalSellAmount)
 		curvedValues, err = bond.GetPricesToMint(extraBuys.Amount, reserveBalances) // buy prices
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 	} else {
 		matchedAmount = buyAmountDec // since buys < sells, greatest common amount is buys
@@ -119,17 +175,49 @@ func (k Keeper) GetBatchBuySellPrices(ctx sdk.Context, token string, batch types
 
 	// If buys > sells, totalValues is the total buy prices
 	// If sells > buys, totalValues is the total sell returns
-	totalValues := matchedValues.Add(curvedValues)
+	totalValues = matchedValues.Add(curvedValues)
 
-	// Calculate buy and sell prices per token
+	// Calculate buy and sell prices per token, rounding buy prices up and sell
+	// returns down so that the rounding remainder is a surplus in the reserve
 	if batch.MoreBuysThanSells() {
-		buyPricesPT = types.DivideDecCoinsByDec(totalValues, buyAmountDec)
+		buyPricesPT = types.DivideDecCoinsByDecRoundUp(totalValues, buyAmountDec)
 		sellPricesPT = currentPricesPT
 	} else {
 		buyPricesPT = currentPricesPT
-		sellPricesPT = types.DivideDecCoinsByDec(totalValues, sellAmountDec)
+		sellPricesPT = types.DivideDecCoinsByDecTruncate(totalValues, sellAmountDec)
 	}
-	return buyPricesPT, sellPricesPT, nil
+	return buyPricesPT, sellPricesPT, totalValues, nil
+}
+
+// GetBatchRoundingSurplus returns the amount by which the batch's total buy
+// prices exceed, or its total sell returns fall short of, the total values
+// from which the batch's per-token prices were calculated, given that these
+// prices are rounded at the precision of sdk.Dec
+func (k Keeper) GetBatchRoundingSurplus(ctx sdk.Context, token string) (sdk.DecCoins, sdk.Error) {
+	batch := k.MustGetBatch(ctx, token)
+	_, _, totalValues, err := k.getBatchBuySellPricesAndTotalValues(ctx, token, batch)
+	if err != nil {
+		return nil, err
+	}
+
+	var surplus sdk.DecCoins
+	var negative bool
+	if batch.MoreBuysThanSells() {
+		totalBuyPrices := types.MultiplyDecCoinsByInt(batch.BuyPrices, batch.TotalBuyAmount.Amount)
+		surplus, negative = totalBuyPrices.SafeSub(totalValues)
+	} else if batch.MoreSellsThanBuys() {
+		totalSellReturns := types.MultiplyDecCoinsByInt(batch.SellPrices, batch.TotalSellAmount.Amount)
+		surplus, negative = totalValues.SafeSub(totalSellReturns)
+	}
+	if negative {
+		// Should never happen, given that the batch's prices are rounded in
+		// the reserve's favour and are updated whenever the batch changes.
+		// Nothing is recorded, so the rounding surplus invariant breaks.
+		logger := k.Logger(ctx)
+		logger.Error(fmt.Sprintf("negative batch rounding surplus for bond %s", token))
+		return nil, nil
+	}
+	return surplus, nil
 }
 
 func (k Keeper) GetUpdatedBatchPricesAfterBuy(ctx sdk.Context, token string, bo types.BuyOrder) (buyPrices, sellPrices sdk.DecCoins, err sdk.Error) {
@@ -204,16 +292,34 @@ func (k Keeper) PerformBuyAtPrice(ctx sdk.Context, token string, bo types.BuyOrd
 
 	// Add new reserve to reserve address (reservePricesRounded should never be zero)
 	// TODO: investigate possibility of zero reservePricesRounded
-	err = k.SupplyKeeper.SendCoinsFromModuleToAccount(ctx,
-		types.BatchesIntermediaryAccount, bond.ReserveAddress, reservePricesRounded)
+	err = k.DepositReserveFromModule(ctx, token,
+		types.BatchesIntermediaryAccount, reservePricesRounded)
 	if err != nil {
 		return err
 	}
 
-	// Add charged fee to fee address
-	if !txFees.IsZero() {
-		err = k.SupplyKeeper.SendCoinsFromModuleToAccount(ctx,
-			types.BatchesIntermediaryAccount, bond.FeeAddress, txFees)
+	// Prices are rounded up, so the remainder is a surplus in the reserve
+	k.AddExpectedReserve(ctx, token, reservePrices)
+	k.AddRoundingSurplus(ctx, token, sdk.NewDecCoins(reservePricesRounded).Sub(reservePrices))
+
+	// Referrer's share of the charged fee (if any) goes to the referrer
+	var referralFees sdk.Coins
+	if bo.HasReferrer() {
+		referralFees = bond.GetReferralFees(txFees)
+	}
+	if !referralFees.IsZero() {
+		err = k.PayReferralFeesFromModule(ctx, token,
+			types.BatchesIntermediaryAccount, bo.Referrer, referralFees)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Split rest of charged fee among fee recipients
+	fees := txFees.Sub(referralFees)
+	if !fees.IsZero() {
+		err = k.PayFeesFromModule(ctx, token,
+			types.BatchesIntermediaryAccount, fees)
 		if err != nil {
 			return err
 		}
@@ -232,6 +338,9 @@ func (k Keeper) PerformBuyAtPrice(ctx sdk.Context, token string, bo types.BuyOrd
 	// Update supply (max supply exceeded check done during MsgBuy)
 	k.SetCurrentSupply(ctx, token, bond.CurrentSupply.Add(bo.Amount))
 
+	// Update bond stats (fees include any referral fees)
+	k.RecordBuyStats(ctx, token, bo.Address, bo.Amount.Amount, txFees)
+
 	logger := k.Logger(ctx)
 	logger.Info(fmt.Sprintf("performed buy order for %s from %s", bo.Amount.String(), bo.Address.String()))
 
@@ -243,6 +352,8 @@ func (k Keeper) PerformBuyAtPrice(ctx sdk.Context, token string, bo types.BuyOrd
 		sdk.NewAttribute(types.AttributeKeyTokensMinted, bo.Amount.Amount.String()),
 		sdk.NewAttribute(types.AttributeKeyChargedPrices, reservePricesRounded.String()),
 		sdk.NewAttribute(types.AttributeKeyChargedFees, txFees.String()),
+		sdk.NewAttribute(types.AttributeKeyReferrer, bo.Referrer.String()),
+		sdk.NewAttribute(types.AttributeKeyReferralFees, referralFees.String()),
 		sdk.NewAttribute(types.AttributeKeyReturnedToAddress, returnToBuyer.String()),
 	))
 
@@ -255,21 +366,44 @@ func (k Keeper) PerformSellAtPrice(ctx sdk.Context, token string, so types.SellO
 	reserveReturns := types.MultiplyDecCoinsByInt(prices, so.Amount.Amount)
 	reserveReturnsRounded := types.RoundReserveReturns(reserveReturns)
 	txFees := bond.GetTxFees(reserveReturns)
-	exitFees := bond.GetExitFees(reserveReturns)
+	exitFees := bond.GetExitFeesAtPercentage(reserveReturns, so.ExitFeePercentage)
 
 	totalFees := types.AdjustFees(txFees.Add(exitFees), reserveReturnsRounded) // calculate actual total fees
 	totalReturns := reserveReturnsRounded.Sub(totalFees)                       // calculate actual reserveReturns
 
+	// Returns are rounded down, so the remainder is a surplus in the reserve
+	k.AddRoundingSurplus(ctx, token, reserveReturns.Sub(sdk.NewDecCoins(reserveReturnsRounded)))
+	k.SubtractExpectedReserve(ctx, token, reserveReturns)
+
+	// Update bond stats, including for sells that end up being queued
+	k.RecordSellStats(ctx, token, so.Amount.Amount, totalFees)
+
+	// Queue the sell if part of the reserve is staked and the liquid reserve
+	// is not enough to pay it out (or earlier sells are already queued)
+	if bond.HasReserveStaking() {
+		liquid := k.GetLiquidReserve(ctx, token)
+		owed := reserveReturnsRounded.AmountOf(getStakingDenom(bond))
+		if bond.Staking.HasQueuedSells() || liquid.LT(owed) {
+			k.QueueSell(ctx, token, types.NewQueuedSell(
+				so.Address, so.Amount, totalReturns, totalFees))
+			k.SetCurrentSupply(ctx, token, bond.CurrentSupply.Sub(so.Amount))
+
+			logger := k.Logger(ctx)
+			logger.Info(fmt.Sprintf("queued sell order for %s from %s", so.Amount.String(), so.Address.String()))
+			return nil
+		}
+	}
+
 	// Send total returns to seller (totalReturns should never be zero)
 	// TODO: investigate possibility of zero totalReturns
-	err = k.CoinKeeper.SendCoins(ctx, bond.ReserveAddress, so.Address, totalReturns)
+	err = k.WithdrawReserve(ctx, token, so.Address, totalReturns)
 	if err != nil {
 		return err
 	}
 
-	// Send total fee to fee address
+	// Split total fee among fee recipients
 	if !totalFees.IsZero() {
-		err := k.CoinKeeper.SendCoins(ctx, bond.ReserveAddress, bond.FeeAddress, totalFees)
+		err := k.PayFeesFromReserve(ctx, token, totalFees)
 		if err != nil {
 			return err
 		}
@@ -307,34 +441,43 @@ func (k Keeper) PerformSwap(ctx sdk.Context, token string, so types.SwapOrder) (
 	}
 	adjustedInput := so.Amount.Sub(txFee) // same as during GetReturnsForSwap
 
+	// Part of the fee (if any) is kept in the reserve for liquidity providers
+	liquidityFee := bond.GetLiquidityFee(txFee)
+	reserveInput := adjustedInput.Add(liquidityFee)
+	fees := txFee.Sub(liquidityFee)
+
 	// Check if new rates violate sanity rate
-	newReserveBalances := reserveBalances.Add(sdk.Coins{adjustedInput}).Sub(reserveReturns)
+	newReserveBalances := reserveBalances.Add(sdk.Coins{reserveInput}).Sub(reserveReturns)
 	if bond.ReservesViolateSanityRate(newReserveBalances) {
 		return types.ErrValuesViolateSanityRate(types.DefaultCodespace), true
 	}
 
 	// Give resultant tokens to swapper (reserveReturns should never be zero)
-	err = k.CoinKeeper.SendCoins(ctx, bond.ReserveAddress, so.Address, reserveReturns)
+	err = k.WithdrawReserve(ctx, token, so.Address, reserveReturns)
 	if err != nil {
 		return err, false
 	}
 
-	// Add fee-reduced coins to be swapped to reserve (adjustedInput should never be zero)
-	err = k.SupplyKeeper.SendCoinsFromModuleToAccount(ctx,
-		types.BatchesIntermediaryAccount, bond.ReserveAddress, sdk.Coins{adjustedInput})
+	// Add fee-reduced coins to be swapped and the liquidity fee to reserve
+	// (adjustedInput should never be zero)
+	err = k.DepositReserveFromModule(ctx, token,
+		types.BatchesIntermediaryAccount, sdk.Coins{reserveInput})
 	if err != nil {
 		return err, false
 	}
 
-	// Add fee (taken from swapper) to fee address
-	if !txFee.IsZero() {
-		err = k.SupplyKeeper.SendCoinsFromModuleToAccount(ctx,
-			types.BatchesIntermediaryAccount, bond.FeeAddress, sdk.Coins{txFee})
+	// Split rest of fee (taken from swapper) among fee recipients
+	if !fees.IsZero() {
+		err = k.PayFeesFromModule(ctx, token,
+			types.BatchesIntermediaryAccount, sdk.Coins{fees})
 		if err != nil {
 			return err, false
 		}
 	}
 
+	// Update bond stats (fees include the liquidity fee)
+	k.RecordSwapStats(ctx, token, so.Amount, sdk.NewCoins(txFee))
+
 	logger := k.Logger(ctx)
 	logger.Info(fmt.Sprintf("performed swap order for %s to %s from %s",
 		so.Amount.String(), reserveReturns, so.Address.String()))
@@ -346,6 +489,7 @@ func (k Keeper) PerformSwap(ctx sdk.Context, token string, so types.SwapOrder) (
 		sdk.NewAttribute(types.AttributeKeyAddress, so.Address.String()),
 		sdk.NewAttribute(types.AttributeKeyTokensSwapped, adjustedInput.String()),
 		sdk.NewAttribute(types.AttributeKeyChargedFees, txFee.String()),
+		sdk.NewAttribute(types.AttributeKeyLiquidityFee, liquidityFee.String()),
 		sdk.NewAttribute(types.AttributeKeyReturnedToAddress, reserveReturns.String()),
 	))
 
@@ -427,8 +571,25 @@ func (k Keeper) PerformSwapOrders(ctx sdk.Context, token string) {
 }
 
 func (k Keeper) PerformOrders(ctx sdk.Context, token string) {
+	// The batch's rounding surplus is based on the reserve and supply before
+	// the batch's orders are performed. It is only recorded for bonds whose
+	// reserve follows the curve, since the prices of other bonds' batches can
+	// be based on an earlier reserve (e.g. before a tap withdrawal), in which
+	// case the remainder is left in their expected reserve.
+	var batchSurplus sdk.DecCoins
+	bond := k.MustGetBond(ctx, token)
+	if bond.FunctionType != types.SwapperFunction && !bond.ReserveCanDivergeFromCurve() {
+		var err sdk.Error
+		batchSurplus, err = k.GetBatchRoundingSurplus(ctx, token)
+		if err != nil {
+			panic(err)
+		}
+	}
+
 	k.PerformBuyOrders(ctx, token)
 	k.PerformSellOrders(ctx, token)
+	k.SubtractExpectedReserve(ctx, token, batchSurplus)
+	k.AddRoundingSurplus(ctx, token, batchSurplus)
 	k.PerformSwapOrders(ctx, token)
 }
 
@@ -512,3 +673,247 @@ func (k Keeper) CancelUnfulfillableOrders(ctx sdk.Context, token string) (cancel
 	k.SetBatch(ctx, token, batch)
 	return cancelledOrders
 }
+
+func (k Keeper) cancelAndRefundBuy(ctx sdk.Context, batch *types.Batch, i int, cancelReason string) {
+	bo := batch.Buys[i]
+	batch.Buys[i].Cancelled = types.TRUE
+	batch.Buys[i].CancelReason = cancelReason
+	batch.TotalBuyAmount = batch.TotalBuyAmount.Sub(bo.Amount)
+
+	logger := k.Logger(ctx)
+	logger.Info(fmt.Sprintf("cancelled buy order for %s from %s", bo.Amount.String(), bo.Address.String()))
+	logger.Debug(fmt.Sprintf("cancellation reason: %s", cancelReason))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeOrderCancel,
+		sdk.NewAttribute(types.AttributeKeyBond, batch.Token),
+		sdk.NewAttribute(types.AttributeKeyOrderType, types.AttributeValueBuyOrder),
+		sdk.NewAttribute(types.AttributeKeyAddress, bo.Address.String()),
+		sdk.NewAttribute(types.AttributeKeyCancelReason, cancelReason),
+	))
+
+	// Return reserve to buyer
+	err := k.SupplyKeeper.SendCoinsFromModuleToAccount(ctx,
+		types.BatchesIntermediaryAccount, bo.Address, bo.MaxPrices)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (k Keeper) cancelAndRefundSell(ctx sdk.Context, batch *types.Batch, i int, cancelReason string) {
+	so := batch.Sells[i]
+	batch.Sells[i].Cancelled = types.TRUE
+	batch.Sells[i].CancelReason = cancelReason
+	batch.TotalSellAmount = batch.TotalSellAmount.Sub(so.Amount)
+
+	logger := k.Logger(ctx)
+	logger.Info(fmt.Sprintf("cancelled sell order for %s from %s", so.Amount.String(), so.Address.String()))
+	logger.Debug(fmt.Sprintf("cancellation reason: %s", cancelReason))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeOrderCancel,
+		sdk.NewAttribute(types.AttributeKeyBond, batch.Token),
+		sdk.NewAttribute(types.AttributeKeyOrderType, types.AttributeValueSellOrder),
+		sdk.NewAttribute(types.AttributeKeyAddress, so.Address.String()),
+		sdk.NewAttribute(types.AttributeKeyCancelReason, cancelReason),
+	))
+
+	// Re-mint bond tokens (burned in handleMsgSell) and return to seller
+	err := k.SupplyKeeper.MintCoins(ctx, types.BondsMintBurnAccount,
+		sdk.Coins{so.Amount})
+	if err != nil {
+		panic(err)
+	}
+	err = k.SupplyKeeper.SendCoinsFromModuleToAccount(ctx,
+		types.BondsMintBurnAccount, so.Address, sdk.Coins{so.Amount})
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (k Keeper) cancelAndRefundSwap(ctx sdk.Context, batch *types.Batch, i int, cancelReason string) {
+	so := batch.Swaps[i]
+	batch.Swaps[i].Cancelled = types.TRUE
+	batch.Swaps[i].CancelReason = cancelReason
+
+	logger := k.Logger(ctx)
+	logger.Info(fmt.Sprintf("cancelled swap order for %s to %s from %s", so.Amount.String(), so.ToToken, so.Address.String()))
+	logger.Debug(fmt.Sprintf("cancellation reason: %s", cancelReason))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeOrderCancel,
+		sdk.NewAttribute(types.AttributeKeyBond, batch.Token),
+		sdk.NewAttribute(types.AttributeKeyOrderType, types.AttributeValueSwapOrder),
+		sdk.NewAttribute(types.AttributeKeyAddress, so.Address.String()),
+		sdk.NewAttribute(types.AttributeKeyCancelReason, cancelReason),
+	))
+
+	// Return from amount to swapper
+	err := k.SupplyKeeper.SendCoinsFromModuleToAccount(ctx,
+		types.BatchesIntermediaryAccount, so.Address, sdk.Coins{so.Amount})
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (k Keeper) CancelAllOrders(ctx sdk.Context, token string, cancelReason string) (cancelledOrders int) {
+	batch := k.MustGetBatch(ctx, token)
+
+	// Cancel and refund buys, sells, and swaps
+	for i, bo := range batch.Buys {
+		if !bo.IsCancelled() {
+			k.cancelAndRefundBuy(ctx, &batch, i, cancelReason)
+			cancelledOrders += 1
+		}
+	}
+	for i, so := range batch.Sells {
+		if !so.IsCancelled() {
+			k.cancelAndRefundSell(ctx, &batch, i, cancelReason)
+			cancelledOrders += 1
+		}
+	}
+	for i, so := range batch.Swaps {
+		if !so.IsCancelled() {
+			k.cancelAndRefundSwap(ctx, &batch, i, cancelReason)
+			cancelledOrders += 1
+		}
+	}
+
+	// Save batch and return number of cancelled orders
+	k.SetBatch(ctx, token, batch)
+	return cancelledOrders
+}
+
+func (k Keeper) GetBatchPriceMovePercentage(ctx sdk.Context, token string, batch types.Batch) (sdk.Dec, sdk.Error) {
+	bond := k.MustGetBond(ctx, token)
+
+	// Compare batch buy and sell prices to the pre-batch current prices
+	reserveBalances := k.GetReserveBalances(ctx, token)
+	currentPricesPT, err := bond.GetCurrentPricesPT(reserveBalances)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	buyMove := types.GetPriceMovePercentage(currentPricesPT, batch.BuyPrices)
+	sellMove := types.GetPriceMovePercentage(currentPricesPT, batch.SellPrices)
+	if buyMove.GT(sellMove) {
+		return buyMove, nil
+	}
+	return sellMove, nil
+}
+
+func (k Keeper) CancelFurthestOrder(ctx sdk.Context, token string, cancelReason string) (cancelled bool) {
+	batch := k.MustGetBatch(ctx, token)
+
+	// The orders pushing the price furthest are the largest orders on the
+	// side (buys or sells) that outweighs the other side
+	largest := -1
+	if batch.MoreBuysThanSells() {
+		for i, bo := range batch.Buys {
+			if !bo.IsCancelled() && (largest == -1 ||
+				bo.Amount.IsGTE(batch.Buys[largest].Amount)) {
+				largest = i
+			}
+		}
+		if largest != -1 {
+			k.cancelAndRefundBuy(ctx, &batch, largest, cancelReason)
+		}
+	} else if batch.MoreSellsThanBuys() {
+		for i, so := range batch.Sells {
+			if !so.IsCancelled() && (largest == -1 ||
+				so.Amount.IsGTE(batch.Sells[largest].Amount)) {
+				largest = i
+			}
+		}
+		if largest != -1 {
+			k.cancelAndRefundSell(ctx, &batch, largest, cancelReason)
+		}
+	}
+	if largest == -1 {
+		return false
+	}
+
+	// Update buy and sell prices after the cancellation
+	buyPrices, sellPrices, err := k.GetBatchBuySellPrices(ctx, token, batch)
+	if err != nil {
+		panic(err)
+	}
+	batch.BuyPrices = buyPrices
+	batch.SellPrices = sellPrices
+	k.SetBatch(ctx, token, batch)
+	return true
+}
+
+func (k Keeper) ApplyCircuitBreaker(ctx sdk.Context, token string) {
+	bond := k.MustGetBond(ctx, token)
+	if !bond.HasMaxPriceMove() {
+		return
+	}
+
+	// Skip if price move is within bounds or if the prices cannot be
+	// calculated (e.g. swapper function bond with no liquidity yet)
+	batch := k.MustGetBatch(ctx, token)
+	priceMove, err := k.GetBatchPriceMovePercentage(ctx, token, batch)
+	if err != nil || priceMove.LTE(bond.MaxPriceMovePercentage) {
+		return
+	}
+	cancelReason := types.ErrMaxPriceMoveExceeded(types.DefaultCodespace,
+		priceMove, bond.MaxPriceMovePercentage).Error()
+
+	var cancelledOrders int
+	haltBlocks := sdk.ZeroUint()
+	if bond.CircuitBreakerMode == types.CircuitBreakerHalt {
+		// Cancel all orders and halt the bond for the cooldown period
+		cancelledOrders = k.CancelAllOrders(ctx, token, cancelReason)
+		haltBlocks = bond.CircuitBreakerCooldown
+		bond.HaltBlocksRemaining = haltBlocks
+		k.SetBond(ctx, token, bond)
+	} else {
+		// Cancel the orders that push the price furthest until within bounds
+		newPriceMove := priceMove
+		for newPriceMove.GT(bond.MaxPriceMovePercentage) {
+			if !k.CancelFurthestOrder(ctx, token, cancelReason) {
+				break
+			}
+			cancelledOrders += 1 + k.CancelUnfulfillableOrders(ctx, token)
+
+			batch = k.MustGetBatch(ctx, token)
+			newPriceMove, err = k.GetBatchPriceMovePercentage(ctx, token, batch)
+			if err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	logger := k.Logger(ctx)
+	logger.Info(fmt.Sprintf("circuit breaker (%s) triggered for %s by price move of %s percent",
+		bond.CircuitBreakerMode, token, priceMove.String()))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCircuitBreaker,
+		sdk.NewAttribute(types.AttributeKeyBond, token),
+		sdk.NewAttribute(types.AttributeKeyCircuitBreakerMode, bond.CircuitBreakerMode),
+		sdk.NewAttribute(types.AttributeKeyPriceMovePercentage, priceMove.String()),
+		sdk.NewAttribute(types.AttributeKeyMaxPriceMovePercentage, bond.MaxPriceMovePercentage.String()),
+		sdk.NewAttribute(types.AttributeKeyCancelledOrders, fmt.Sprintf("%d", cancelledOrders)),
+		sdk.NewAttribute(types.AttributeKeyHaltBlocks, haltBlocks.String()),
+	))
+}
+
+func (k Keeper) RecordBatchPrices(ctx sdk.Context, token string) {
+	bond := k.MustGetBond(ctx, token)
+	if !bond.FeeSchedule.HasVolatilityTiers() {
+		return
+	}
+
+	// Skip if the prices cannot be calculated (e.g. swapper function bond
+	// with no liquidity yet), since there is no price to measure yet
+	reserveBalances := k.GetReserveBalances(ctx, token)
+	currentPricesPT, err := bond.GetCurrentPricesPT(reserveBalances)
+	if err != nil {
+		return
+	}
+
+	bond = bond.AddRecentPrices(currentPricesPT)
+	k.SetBond(ctx, token, bond)
+}
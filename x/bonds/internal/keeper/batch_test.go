@@ -134,7 +134,7 @@ func TestGetBatchBuySellPrices(t *testing.T) {
 	expectedReserve := bond.CurveIntegral(bond.CurrentSupply.Amount)
 	expectedRounded := expectedReserve.Ceil().TruncateInt()
 	reserveBalance := sdk.NewCoins(sdk.NewCoin(bond.ReserveTokens[0], expectedRounded))
-	_, err := app.BankKeeper.AddCoins(ctx, bond.ReserveAddress, reserveBalance)
+	err := addToReserve(app, ctx, bond.Token, reserveBalance)
 	require.Nil(t, err)
 
 	// Create empty batch
@@ -150,7 +150,7 @@ func TestGetBatchBuySellPrices(t *testing.T) {
 
 	// (Re)Create batch with buy order (nil max prices)
 	batch = getValidBatch()
-	bo := types.NewBuyOrder(buyerAddress, fiveTokens, reserveBalance)
+	bo := types.NewBuyOrder(buyerAddress, fiveTokens, reserveBalance, nil)
 	batch.Buys = append(batch.Buys, bo)
 	batch.TotalBuyAmount = batch.TotalBuyAmount.Add(bo.Amount)
 
@@ -170,7 +170,7 @@ func TestGetBatchBuySellPrices(t *testing.T) {
 
 	// (Re)Create batch with sell order
 	batch = getValidBatch()
-	so := types.NewSellOrder(sellerAddress, fiveTokens)
+	so := types.NewSellOrder(sellerAddress, fiveTokens, bond.ExitFeePercentage)
 	batch.Sells = append(batch.Sells, so)
 	batch.TotalSellAmount = batch.TotalSellAmount.Add(so.Amount)
 
@@ -189,9 +189,9 @@ func TestGetBatchBuySellPrices(t *testing.T) {
 
 	// (Re)Create batch with buy amount > sell amount
 	batch = getValidBatch()
-	bo1 := types.NewBuyOrder(buyerAddress, fiveTokens, nil)
-	bo2 := types.NewBuyOrder(buyerAddress, fiveTokens, nil) // 5 more
-	so = types.NewSellOrder(sellerAddress, fiveTokens)
+	bo1 := types.NewBuyOrder(buyerAddress, fiveTokens, nil, nil)
+	bo2 := types.NewBuyOrder(buyerAddress, fiveTokens, nil, nil) // 5 more
+	so = types.NewSellOrder(sellerAddress, fiveTokens, bond.ExitFeePercentage)
 	batch.Buys = append(batch.Buys, bo1, bo2)
 	batch.Sells = append(batch.Sells, so)
 	batch.TotalBuyAmount = batch.TotalBuyAmount.Add(bo1.Amount).Add(bo2.Amount)
@@ -215,9 +215,9 @@ func TestGetBatchBuySellPrices(t *testing.T) {
 
 	// (Re)Create batch with sell amount > buy amount
 	batch = getValidBatch()
-	bo = types.NewBuyOrder(buyerAddress, fiveTokens, nil)
-	so1 := types.NewSellOrder(sellerAddress, fiveTokens)
-	so2 := types.NewSellOrder(sellerAddress, fiveTokens)
+	bo = types.NewBuyOrder(buyerAddress, fiveTokens, nil, nil)
+	so1 := types.NewSellOrder(sellerAddress, fiveTokens, bond.ExitFeePercentage)
+	so2 := types.NewSellOrder(sellerAddress, fiveTokens, bond.ExitFeePercentage)
 	batch.Buys = append(batch.Buys, bo)
 	batch.Sells = append(batch.Sells, so1, so2)
 	batch.TotalBuyAmount = batch.TotalBuyAmount.Add(bo1.Amount)
@@ -253,19 +253,19 @@ func TestGetUpdatedBatchPricesAfterBuy(t *testing.T) {
 	// Buy order with buy amount greater than max supply not fulfillable
 	// MaxPrices is set to nil since it is not relevant in this scenario
 	maxSupplyPlus1 := bond.MaxSupply.Add(sdk.NewCoin(bond.Token, sdk.OneInt()))
-	bo := types.NewBuyOrder(buyerAddress, maxSupplyPlus1, nil)
+	bo := types.NewBuyOrder(buyerAddress, maxSupplyPlus1, nil, nil)
 	_, _, err := app.BondsKeeper.GetUpdatedBatchPricesAfterBuy(ctx, bond.Token, bo)
 	require.Error(t, err)
 
 	// Buy order with max prices lower than prices not fulfillable
 	maxPrices := sdk.NewCoins(sdk.NewCoin(bond.ReserveTokens[0], sdk.OneInt()))
-	bo = types.NewBuyOrder(buyerAddress, buyAmount, maxPrices)
+	bo = types.NewBuyOrder(buyerAddress, buyAmount, maxPrices, nil)
 	_, _, err = app.BondsKeeper.GetUpdatedBatchPricesAfterBuy(ctx, bond.Token, bo)
 	require.Error(t, err)
 
 	// Check buy prices for fulfillable buy order
 	maxPrices = sdk.NewCoins(sdk.NewInt64Coin(bond.ReserveTokens[0], 10000000))
-	bo = types.NewBuyOrder(buyerAddress, buyAmount, maxPrices)
+	bo = types.NewBuyOrder(buyerAddress, buyAmount, maxPrices, nil)
 	buyPrices, sellPrices, err = app.BondsKeeper.GetUpdatedBatchPricesAfterBuy(ctx, bond.Token, bo)
 	expectedBuyPrices, _ := bond.GetPricesToMint(buyAmount.Amount, nil)
 	expectedSellPrices, _ := bond.GetCurrentPricesPT(nil)
@@ -287,7 +287,7 @@ func TestGetUpdatedBatchPricesAfterSell(t *testing.T) {
 	sellAmount := sdk.NewCoin(bond.Token, sdk.OneInt())
 
 	// Sell order when current supply is zero is not fulfillable
-	so := types.NewSellOrder(sellerAddress, sellAmount)
+	so := types.NewSellOrder(sellerAddress, sellAmount, bond.ExitFeePercentage)
 	_, _, err := app.BondsKeeper.GetUpdatedBatchPricesAfterSell(ctx, bond.Token, so)
 	require.Error(t, err)
 
@@ -295,10 +295,10 @@ func TestGetUpdatedBatchPricesAfterSell(t *testing.T) {
 	bond.CurrentSupply = sellAmount
 	app.BondsKeeper.SetBond(ctx, bond.Token, bond)
 	reserveBalance := sdk.NewCoins(sdk.NewInt64Coin(bond.ReserveTokens[0], 10000000))
-	_, _ = app.BankKeeper.AddCoins(ctx, bond.ReserveAddress, reserveBalance)
+	_ = addToReserve(app, ctx, bond.Token, reserveBalance)
 
 	// Check sell prices for fulfillable sell order
-	so = types.NewSellOrder(sellerAddress, sellAmount)
+	so = types.NewSellOrder(sellerAddress, sellAmount, bond.ExitFeePercentage)
 	buyPrices, sellPrices, err = app.BondsKeeper.GetUpdatedBatchPricesAfterSell(ctx, bond.Token, so)
 	expectedBuyPrices, _ := bond.GetCurrentPricesPT(nil)
 	expectedSellPrices := bond.GetReturnsForBurn(sellAmount.Amount, reserveBalance)
@@ -340,7 +340,7 @@ func TestPerformBuyAtPrice(t *testing.T) {
 	for _, tc := range testCases {
 		// Create buy order
 		amount := sdk.NewCoin(bond.Token, tc.amount)
-		bo := types.NewBuyOrder(buyerAddress, amount, tc.maxPrices)
+		bo := types.NewBuyOrder(buyerAddress, amount, tc.maxPrices, nil)
 
 		// Set transaction fee
 		bond.TxFeePercentage = tc.txFee
@@ -367,6 +367,7 @@ func TestPerformBuyAtPrice(t *testing.T) {
 		prevModuleAccBal := app.BankKeeper.GetCoins(ctx, moduleAcc.GetAddress())
 		prevFeeAddrBal := app.BankKeeper.GetCoins(ctx, bond.FeeAddress)
 		prevBuyerBal := app.BankKeeper.GetCoins(ctx, buyerAddress)
+		prevStats := app.BondsKeeper.GetBondStats(ctx, bond.Token)
 
 		// Perform buy
 		err = app.BondsKeeper.PerformBuyAtPrice(ctx, bond.Token, bo, buyPrices)
@@ -401,6 +402,12 @@ func TestPerformBuyAtPrice(t *testing.T) {
 			require.Equal(t, prevFeeAddrBal.Add(txFees), newFeeAddrBal)
 		}
 		require.Equal(t, prevBuyerBal.Add(increaseInBuyerBal), newBuyerBal)
+
+		// Buyer is only counted as a unique buyer on its first buy
+		newBuyer := prevStats.UniqueBuyers.IsZero()
+		require.Equal(t, prevStats.AddBuy(tc.amount, txFees, newBuyer),
+			app.BondsKeeper.GetBondStats(ctx, bond.Token))
+		require.Equal(t, sdk.OneUint(), app.BondsKeeper.GetBondStats(ctx, bond.Token).UniqueBuyers)
 	}
 }
 
@@ -438,7 +445,7 @@ func TestPerformSellAtPrice(t *testing.T) {
 
 	for _, tc := range testCases {
 		// Create sell order
-		so := types.NewSellOrder(sellerAddress, sellAmount)
+		so := types.NewSellOrder(sellerAddress, sellAmount, tc.exitFee)
 
 		// Set transaction and exit fee and current supply
 		bond.TxFeePercentage = tc.txFee
@@ -458,7 +465,7 @@ func TestPerformSellAtPrice(t *testing.T) {
 		require.Equal(t, totalReturns.AmountOf(reserveToken), tc.expectedReturns)
 
 		// Add reserve tokens paid by seller when buying to reserve address
-		err := app.BankKeeper.SetCoins(ctx, bond.ReserveAddress, reserveReturnsRounded)
+		err := setReserve(app, ctx, bond.Token, reserveReturnsRounded)
 		require.NoError(t, err)
 
 		// Previous values
@@ -466,6 +473,7 @@ func TestPerformSellAtPrice(t *testing.T) {
 		prevReserveBal := app.BondsKeeper.GetReserveBalances(ctx, bond.Token)
 		prevFeeAddrBal := app.BankKeeper.GetCoins(ctx, bond.FeeAddress)
 		prevSellerBal := app.BankKeeper.GetCoins(ctx, sellerAddress)
+		prevStats := app.BondsKeeper.GetBondStats(ctx, bond.Token)
 
 		// Perform sell
 		err = app.BondsKeeper.PerformSellAtPrice(ctx, bond.Token, so, sellPrices)
@@ -486,6 +494,8 @@ func TestPerformSellAtPrice(t *testing.T) {
 			require.Equal(t, prevFeeAddrBal.Add(totalFees), newFeeAddrBal)
 		}
 		require.Equal(t, prevSellerBal.Add(totalReturns), newSellerBal)
+		require.Equal(t, prevStats.AddSell(so.Amount.Amount, totalFees),
+			app.BondsKeeper.GetBondStats(ctx, bond.Token))
 	}
 }
 
@@ -557,7 +567,7 @@ func TestPerformSwap(t *testing.T) {
 		bond.SanityMarginPercentage = tc.sanityMarginPercentage
 		app.BondsKeeper.SetBond(ctx, bond.Token, bond)
 		startingReserves := sdk.NewCoins(tc.inReserve, tc.outReserve)
-		err := app.BankKeeper.SetCoins(ctx, bond.ReserveAddress, startingReserves)
+		err := setReserve(app, ctx, bond.Token, startingReserves)
 		require.NoError(t, err)
 
 		// Add reserve tokens sent by swapper to module account address
@@ -577,6 +587,7 @@ func TestPerformSwap(t *testing.T) {
 		prevReserveBal := app.BondsKeeper.GetReserveBalances(ctx, bond.Token)
 		prevFeeAddrBal := app.BankKeeper.GetCoins(ctx, bond.FeeAddress)
 		prevSwapperBal := app.BankKeeper.GetCoins(ctx, swapperAddress)
+		prevStats := app.BondsKeeper.GetBondStats(ctx, bond.Token)
 
 		// Perform swap
 		err, ok := app.BondsKeeper.PerformSwap(ctx, bond.Token, so)
@@ -605,7 +616,78 @@ func TestPerformSwap(t *testing.T) {
 			require.Equal(t, prevFeeAddrBal.Add(txFees), newFeeAddrBal)
 		}
 		require.Equal(t, prevSwapperBal.Add(totalOuts), newSwapperBal)
+		require.Equal(t, prevStats.AddSwap(fromAmount, txFees),
+			app.BondsKeeper.GetBondStats(ctx, bond.Token))
+	}
+}
+
+func TestPerformSwapKeepsLiquidityFeeInReserve(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	// Create swapper bond with a 10% fee, half of which is kept in reserve
+	bond := getValidSwapperBond()
+	bond.TxFeePercentage = sdk.NewDec(10)
+	bond.LiquidityFeePercentage = sdk.NewDec(50)
+	app.BondsKeeper.SetBond(ctx, bond.Token, bond)
+	reserves := sdk.NewCoins(
+		sdk.NewInt64Coin(reserveToken, 200),
+		sdk.NewInt64Coin(reserveToken2, 300))
+	require.NoError(t, setReserve(app, ctx, bond.Token, reserves))
+
+	// Add reserve tokens sent by swapper to module account address
+	fromAmount := sdk.NewInt64Coin(reserveToken, 100)
+	moduleAcc := app.SupplyKeeper.GetModuleAccount(ctx, types.BatchesIntermediaryAccount)
+	err := app.BankKeeper.SetCoins(ctx, moduleAcc.GetAddress(), sdk.Coins{fromAmount})
+	require.NoError(t, err)
+
+	// Swap 100res for rez; the fee is 10res, of which 5res is kept in the
+	// reserve, and the return is 90*300/(200+90) = 93rez
+	so := types.NewSwapOrder(swapperAddress, fromAmount, reserveToken2)
+	err, ok := app.BondsKeeper.PerformSwap(ctx, bond.Token, so)
+	require.True(t, ok)
+	require.NoError(t, err)
+
+	expectedReserves := sdk.NewCoins(
+		sdk.NewInt64Coin(reserveToken, 295),
+		sdk.NewInt64Coin(reserveToken2, 207))
+	require.Equal(t, expectedReserves, app.BondsKeeper.GetReserveBalances(ctx, bond.Token))
+	require.Equal(t, sdk.NewInt(5), app.BankKeeper.GetCoins(ctx, bond.FeeAddress).AmountOf(reserveToken))
+	require.Equal(t, sdk.NewInt(93), app.BankKeeper.GetCoins(ctx, swapperAddress).AmountOf(reserveToken2))
+}
+
+func TestRecordBatchPricesKeepsVolatilityBatches(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	// Create swapper bond with volatility measured across 2 batches
+	bond := getValidSwapperBond()
+	bond.FeeSchedule = types.NewFeeSchedule(nil, types.FeeTiers{
+		types.NewFeeTier(sdk.NewDec(10), sdk.NewDec(2))}, sdk.NewUint(2))
+	app.BondsKeeper.SetBond(ctx, bond.Token, bond)
+
+	// Nothing recorded since swapper has no liquidity yet
+	app.BondsKeeper.RecordBatchPrices(ctx, bond.Token)
+	require.Nil(t, app.BondsKeeper.MustGetBond(ctx, bond.Token).RecentPrices)
+
+	// Add liquidity and record the prices of three batches
+	bond.CurrentSupply = sdk.NewInt64Coin(bond.Token, 2)
+	app.BondsKeeper.SetBond(ctx, bond.Token, bond)
+	var allPrices []sdk.DecCoins
+	for _, amount := range []int64{200, 220, 250} {
+		reserves := sdk.NewCoins(
+			sdk.NewInt64Coin(reserveToken, amount),
+			sdk.NewInt64Coin(reserveToken2, 300))
+		require.NoError(t, setReserve(app, ctx, bond.Token, reserves))
+		bond = app.BondsKeeper.MustGetBond(ctx, bond.Token)
+		prices, err := bond.GetCurrentPricesPT(reserves)
+		require.NoError(t, err)
+		allPrices = append(allPrices, prices)
+
+		app.BondsKeeper.RecordBatchPrices(ctx, bond.Token)
 	}
+
+	// Only the last two batches are kept
+	bond = app.BondsKeeper.MustGetBond(ctx, bond.Token)
+	require.Equal(t, allPrices[1:], bond.RecentPrices)
 }
 
 func TestPerformBuys(t *testing.T) {
@@ -649,7 +731,7 @@ func TestPerformBuys(t *testing.T) {
 	for _, tc := range testCases {
 		// Create and add buy order
 		amount := sdk.NewCoin(bond.Token, tc.amount)
-		bo := types.NewBuyOrder(buyerAddress, amount, tc.maxPrices)
+		bo := types.NewBuyOrder(buyerAddress, amount, tc.maxPrices, nil)
 		app.BondsKeeper.AddBuyOrder(ctx, token, bo, buyPrices, blankSellPrices)
 
 		// Calculate total prices
@@ -713,7 +795,7 @@ func TestPerformSells(t *testing.T) {
 	for _, tc := range testCases {
 		// Create and add sell order
 		amount := sdk.NewCoin(bond.Token, tc.amount)
-		so := types.NewSellOrder(sellerAddress, amount)
+		so := types.NewSellOrder(sellerAddress, amount, bond.ExitFeePercentage)
 		app.BondsKeeper.AddSellOrder(ctx, token, so, blankBuyPrices, sellPrices)
 
 		// Calculate total return
@@ -723,7 +805,7 @@ func TestPerformSells(t *testing.T) {
 		globalTotalReturns = globalTotalReturns.Add(reserveReturnsRounded)
 
 		// Add reserve tokens paid by seller when buying to reserve address
-		_, err := app.BankKeeper.AddCoins(ctx, bond.ReserveAddress, reserveReturnsRounded)
+		err := addToReserve(app, ctx, bond.Token, reserveReturnsRounded)
 		require.NoError(t, err)
 
 		// Add increase in current supply due to a (simulated) buy
@@ -762,7 +844,7 @@ func TestPerformSwaps(t *testing.T) {
 	initialInReserve := sdk.NewInt64Coin(reserveToken, 200)
 	initialOutReserve := sdk.NewInt64Coin(reserveToken2, 300)
 	initialReserves := sdk.NewCoins(initialInReserve, initialOutReserve)
-	err := app.BankKeeper.SetCoins(ctx, bond.ReserveAddress, initialReserves)
+	err := setReserve(app, ctx, bond.Token, initialReserves)
 	require.NoError(t, err)
 
 	testCases := []struct {
@@ -886,7 +968,7 @@ func TestCheckIfBuyOrderFulfillableAtPrice(t *testing.T) {
 	for i, tc := range testCases {
 		// Create buy order
 		amount := sdk.NewCoin(bond.Token, sdk.NewInt(tc.amount))
-		bo := types.NewBuyOrder(buyerAddress, amount, tc.maxPrices)
+		bo := types.NewBuyOrder(buyerAddress, amount, tc.maxPrices, nil)
 
 		// Set transaction fee
 		bond.TxFeePercentage = tc.txFee
@@ -937,7 +1019,7 @@ func TestCancelUnfulfillableBuys(t *testing.T) {
 
 		// Create and add buy order
 		amount := sdk.NewCoin(bond.Token, sdk.NewInt(tc.amount))
-		bo := types.NewBuyOrder(buyerAddress, amount, tc.maxPrices)
+		bo := types.NewBuyOrder(buyerAddress, amount, tc.maxPrices, nil)
 		app.BondsKeeper.AddBuyOrder(ctx, bond.Token, bo, buyPrices, blankSellPrices)
 
 		// Add reserve tokens to module account address for return if cancel
@@ -1027,7 +1109,7 @@ func TestCancelUnfulfillableOrders(t *testing.T) {
 
 		// Create and add buy order
 		amount := sdk.NewCoin(bond.Token, sdk.NewInt(tc.amount))
-		bo := types.NewBuyOrder(buyerAddress, amount, tc.maxPrices)
+		bo := types.NewBuyOrder(buyerAddress, amount, tc.maxPrices, nil)
 		app.BondsKeeper.AddBuyOrder(ctx, bond.Token, bo, buyPrices, blankSellPrices)
 
 		// Add reserve tokens to module account address for return if cancel
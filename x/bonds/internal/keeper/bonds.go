@@ -1,7 +1,6 @@
 package keeper
 
 import (
-	"bytes"
 	"fmt"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ixoworld/bonds/x/bonds/internal/types"
@@ -15,39 +14,13 @@ func (k Keeper) GetBondIterator(ctx sdk.Context) sdk.Iterator {
 func (k Keeper) GetNumberOfBonds(ctx sdk.Context) sdk.Int {
 	count := sdk.ZeroInt()
 	iterator := k.GetBondIterator(ctx)
+	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
-		var bond types.Bond
-		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &bond)
 		count = count.AddRaw(1)
 	}
 	return count
 }
 
-func (k Keeper) GetReserveAddressByBondCount(count sdk.Int) sdk.AccAddress {
-	var buffer bytes.Buffer
-
-	// Start with number of bonds prefixed with a letter (in this case, A)
-	// Letter is added to separate the number from possible digits
-	numString := "A" + count.String()
-
-	// Append numString to a base HEX address
-	buffer.WriteString("A97B2E13A94AF4A1D3EC729DC422C6341BAEEDC9")
-	buffer.WriteString(numString)
-
-	// Truncate from the front to the required length (38) and parse to address
-	truncated := buffer.String()[len(buffer.String())-40:]
-	res, err := sdk.AccAddressFromHex(truncated)
-	if err != nil {
-		panic(err)
-	}
-
-	return res
-}
-
-func (k Keeper) GetNextUnusedReserveAddress(ctx sdk.Context) sdk.AccAddress {
-	return k.GetReserveAddressByBondCount(k.GetNumberOfBonds(ctx))
-}
-
 func (k Keeper) GetBond(ctx sdk.Context, token string) (bond types.Bond, found bool) {
 	store := ctx.KVStore(k.storeKey)
 	if !k.BondExists(ctx, token) {
@@ -83,16 +56,187 @@ func (k Keeper) BondExists(ctx sdk.Context, token string) bool {
 }
 
 func (k Keeper) SetBond(ctx sdk.Context, token string, bond types.Bond) {
+	if oldBond, found := k.GetBond(ctx, token); found {
+		k.updateBondIndexes(ctx, oldBond, bond)
+	} else {
+		k.setBondIndexes(ctx, bond)
+	}
+
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.GetBondKey(token), k.cdc.MustMarshalBinaryBare(bond))
 }
 
+func (k Keeper) DeleteBond(ctx sdk.Context, token string) {
+	if bond, found := k.GetBond(ctx, token); found {
+		k.deleteBondIndexes(ctx, bond)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetStakedReserveIndexKey(token))
+	store.Delete(types.GetBondKey(token))
+}
+
 func (k Keeper) GetReserveBalances(ctx sdk.Context, token string) sdk.Coins {
-	// TODO: investigate ways to prevent reserve address from being reused since this affects calculations
+	// Reserve balances are tracked by the bond rather than read from the
+	// reserve address, so that coins sent directly to the reserve address
+	// (i.e. the reserve surplus) do not affect any calculations
+	bond := k.MustGetBond(ctx, token)
+	return bond.CurrentReserve
+}
+
+func (k Keeper) GetActualReserveBalances(ctx sdk.Context, token string) sdk.Coins {
 	bond := k.MustGetBond(ctx, token)
 	return k.CoinKeeper.GetCoins(ctx, bond.ReserveAddress)
 }
 
+//noinspection GoNilness
+func (k Keeper) GetReserveSurplus(ctx sdk.Context, token string) (surplus sdk.Coins) {
+	// Surplus is any amount held by the reserve address but not tracked by
+	// the bond, such as coins sent to the reserve address by any account
+	tracked := k.GetReserveBalances(ctx, token)
+	for _, c := range k.GetActualReserveBalances(ctx, token) {
+		diff := c.Amount.Sub(tracked.AmountOf(c.Denom))
+		if diff.IsPositive() {
+			surplus = surplus.Add(sdk.Coins{sdk.NewCoin(c.Denom, diff)})
+		}
+	}
+	return surplus
+}
+
+func (k Keeper) DepositReserve(ctx sdk.Context, token string,
+	from sdk.AccAddress, amount sdk.Coins) sdk.Error {
+	bond := k.MustGetBond(ctx, token)
+
+	err := k.CoinKeeper.SendCoins(ctx, from, bond.ReserveAddress, amount)
+	if err != nil {
+		return err
+	}
+
+	bond.CurrentReserve = bond.CurrentReserve.Add(amount)
+	k.SetBond(ctx, token, bond)
+	return nil
+}
+
+func (k Keeper) DepositReserveFromModule(ctx sdk.Context, token string,
+	fromModule string, amount sdk.Coins) sdk.Error {
+	bond := k.MustGetBond(ctx, token)
+
+	err := k.SupplyKeeper.SendCoinsFromModuleToAccount(
+		ctx, fromModule, bond.ReserveAddress, amount)
+	if err != nil {
+		return err
+	}
+
+	bond.CurrentReserve = bond.CurrentReserve.Add(amount)
+	k.SetBond(ctx, token, bond)
+	return nil
+}
+
+func (k Keeper) WithdrawReserve(ctx sdk.Context, token string,
+	to sdk.AccAddress, amount sdk.Coins) sdk.Error {
+	bond := k.MustGetBond(ctx, token)
+
+	newReserve, negative := bond.CurrentReserve.SafeSub(amount)
+	if negative {
+		return sdk.ErrInsufficientCoins(fmt.Sprintf(
+			"insufficient reserve for bond %s: %s < %s",
+			token, bond.CurrentReserve.String(), amount.String()))
+	}
+
+	err := k.CoinKeeper.SendCoins(ctx, bond.ReserveAddress, to, amount)
+	if err != nil {
+		return err
+	}
+
+	bond.CurrentReserve = newReserve
+	k.SetBond(ctx, token, bond)
+	return nil
+}
+
+func (k Keeper) PayFeesFromModule(ctx sdk.Context, token string,
+	fromModule string, fees sdk.Coins) sdk.Error {
+	bond := k.MustGetBond(ctx, token)
+
+	// Holders' share of the fees (if any) goes to the rewards pool
+	holderRewards := k.getPayableHolderRewards(ctx, bond, fees)
+	if !holderRewards.IsZero() {
+		err := k.SupplyKeeper.SendCoinsFromModuleToModule(
+			ctx, fromModule, types.BondsRewardsAccount, holderRewards)
+		if err != nil {
+			return err
+		}
+		k.addHolderRewardsFromFees(ctx, token, holderRewards)
+		fees = fees.Sub(holderRewards)
+	}
+
+	for _, payout := range bond.GetFeePayouts(fees) {
+		if payout.Amount.IsZero() {
+			continue
+		}
+		err := k.SupplyKeeper.SendCoinsFromModuleToAccount(
+			ctx, fromModule, payout.Address, payout.Amount)
+		if err != nil {
+			return err
+		}
+		k.emitFeePayoutEvent(ctx, token, payout)
+	}
+	return nil
+}
+
+func (k Keeper) PayFeesFromReserve(ctx sdk.Context, token string, fees sdk.Coins) sdk.Error {
+	bond := k.MustGetBond(ctx, token)
+
+	// Holders' share of the fees (if any) goes to the rewards pool
+	holderRewards := k.getPayableHolderRewards(ctx, bond, fees)
+	if !holderRewards.IsZero() {
+		// Get the module account (rather than just its address) so that it is
+		// created if it does not exist yet, since sending coins to a missing
+		// module account would create a base account at its address instead
+		rewardsAddress := k.SupplyKeeper.GetModuleAccount(ctx, types.BondsRewardsAccount).GetAddress()
+		err := k.WithdrawReserve(ctx, token, rewardsAddress, holderRewards)
+		if err != nil {
+			return err
+		}
+		k.addHolderRewardsFromFees(ctx, token, holderRewards)
+		fees = fees.Sub(holderRewards)
+	}
+
+	for _, payout := range bond.GetFeePayouts(fees) {
+		if payout.Amount.IsZero() {
+			continue
+		}
+		err := k.WithdrawReserve(ctx, token, payout.Address, payout.Amount)
+		if err != nil {
+			return err
+		}
+		k.emitFeePayoutEvent(ctx, token, payout)
+	}
+	return nil
+}
+
+func (k Keeper) getPayableHolderRewards(ctx sdk.Context, bond types.Bond, fees sdk.Coins) sdk.Coins {
+	// Rewards cannot be distributed if there are no token holders
+	if !bond.HasHolderRewards() || k.GetTotalBondTokens(ctx, bond.Token).IsZero() {
+		return nil
+	}
+	return bond.GetHolderRewards(fees)
+}
+
+func (k Keeper) addHolderRewardsFromFees(ctx sdk.Context, token string, holderRewards sdk.Coins) {
+	k.AddHolderRewards(ctx, token, holderRewards)
+	rewardsAddress := k.SupplyKeeper.GetModuleAddress(types.BondsRewardsAccount)
+	k.emitFeePayoutEvent(ctx, token, types.NewFeePayout(rewardsAddress, holderRewards))
+}
+
+func (k Keeper) emitFeePayoutEvent(ctx sdk.Context, token string, payout types.FeePayout) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeFeePayout,
+		sdk.NewAttribute(types.AttributeKeyBond, token),
+		sdk.NewAttribute(types.AttributeKeyAddress, payout.Address.String()),
+		sdk.NewAttribute(types.AttributeKeyAmount, payout.Amount.String()),
+	))
+}
+
 func (k Keeper) GetSupplyAdjustedForBuy(ctx sdk.Context, token string) sdk.Coin {
 	bond := k.MustGetBond(ctx, token)
 	batch := k.MustGetBatch(ctx, token)
@@ -2,8 +2,10 @@ package keeper_test
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
 	"github.com/ixoworld/bonds/x/bonds/internal/types"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
 	"testing"
 )
 
@@ -57,72 +59,81 @@ func TestGetNumberOfBonds(t *testing.T) {
 	require.Equal(t, sdk.NewInt(3), app.BondsKeeper.GetNumberOfBonds(ctx))
 }
 
-func TestGetReserveAddressByBondCount(t *testing.T) {
-	app, _ := createTestApp(false)
-	const maxInt64 = int64(^uint64(0) >> 1) // 9223372036854775807
-
-	testCases := []struct {
-		input        sdk.Int
-		truncatedHex string
-	}{
-		{sdk.NewInt(0), "7B2E13A94AF4A1D3EC729DC422C6341BAEEDC9A0"},
-		{sdk.NewInt(5), "7B2E13A94AF4A1D3EC729DC422C6341BAEEDC9A5"},
-		{sdk.NewInt(10), "B2E13A94AF4A1D3EC729DC422C6341BAEEDC9A10"},
-		{sdk.NewInt(50), "B2E13A94AF4A1D3EC729DC422C6341BAEEDC9A50"},
-		{sdk.NewInt(1000000000), "AF4A1D3EC729DC422C6341BAEEDC9A1000000000"},
-		{sdk.NewInt(5000000000), "AF4A1D3EC729DC422C6341BAEEDC9A5000000000"},
-		{sdk.NewInt(maxInt64), "729DC422C6341BAEEDC9A9223372036854775807"},
-	}
-
-	for _, tc := range testCases {
-		expectedAddr, err := sdk.AccAddressFromHex(tc.truncatedHex)
-		require.Nil(t, err)
-
-		addr := app.BondsKeeper.GetReserveAddressByBondCount(tc.input)
-		require.Equal(t, expectedAddr, addr)
-	}
+func TestGetReserveAddress(t *testing.T) {
+	// Reserve addresses are derived from the token, so that the same token
+	// always gives the same address and different tokens never share one
+	require.Equal(t, types.GetReserveAddress(token1), types.GetReserveAddress(token1))
+	require.NotEqual(t, types.GetReserveAddress(token1), types.GetReserveAddress(token2))
+	require.NotEqual(t, types.GetReserveAddress(token1),
+		supply.NewModuleAddress(types.BondsReserveAccount))
 }
 
-func TestGetNextUnusedReserveAddress(t *testing.T) {
+func TestGetReserveBalances(t *testing.T) {
 	app, ctx := createTestApp(false)
 
-	testCases := []struct {
-		truncatedHex  string
-		nextBondToken string
-	}{
-		{"7B2E13A94AF4A1D3EC729DC422C6341BAEEDC9A0", token1},
-		{"7B2E13A94AF4A1D3EC729DC422C6341BAEEDC9A1", token2},
-		{"7B2E13A94AF4A1D3EC729DC422C6341BAEEDC9A2", token3},
-	}
+	// Add bond
+	bond := getValidBond()
+	app.BondsKeeper.SetBond(ctx, token, bond)
 
-	for _, tc := range testCases {
-		expectedAddr, err := sdk.AccAddressFromHex(tc.truncatedHex)
-		require.Nil(t, err)
+	// Reserve is initially empty
+	require.True(t, app.BondsKeeper.GetReserveBalances(ctx, token).IsZero())
 
-		addr := app.BondsKeeper.GetNextUnusedReserveAddress(ctx)
-		require.Equal(t, expectedAddr, addr)
+	// Deposit coins to reserve
+	reserveCoins, _ := sdk.ParseCoins("12res1,56res2")
+	_, _ = app.BankKeeper.AddCoins(ctx, initCreator, reserveCoins)
+	err := app.BondsKeeper.DepositReserve(ctx, token, initCreator, reserveCoins)
+	require.Nil(t, err)
 
-		app.BondsKeeper.SetBond(ctx, tc.nextBondToken, getValidBondWithToken(tc.nextBondToken))
-	}
+	// Reserve now equal to amount deposited
+	require.Equal(t, reserveCoins, app.BondsKeeper.GetReserveBalances(ctx, token))
+	require.Equal(t, reserveCoins, app.BondsKeeper.GetActualReserveBalances(ctx, token))
+	require.True(t, app.BondsKeeper.GetReserveSurplus(ctx, token).IsZero())
 }
 
-func TestGetReserveBalances(t *testing.T) {
+func TestCoinsSentToReserveAddressAreSurplus(t *testing.T) {
 	app, ctx := createTestApp(false)
 
 	// Add bond
 	bond := getValidBond()
 	app.BondsKeeper.SetBond(ctx, token, bond)
 
-	// Reserve is initially empty
+	// Send coins directly to reserve address
+	surplusCoins, _ := sdk.ParseCoins("12res1,56res2")
+	_, _ = app.BankKeeper.AddCoins(ctx, bond.ReserveAddress, surplusCoins)
+
+	// Reserve is still empty, and the coins sent are reported as surplus
 	require.True(t, app.BondsKeeper.GetReserveBalances(ctx, token).IsZero())
+	require.Equal(t, surplusCoins, app.BondsKeeper.GetActualReserveBalances(ctx, token))
+	require.Equal(t, surplusCoins, app.BondsKeeper.GetReserveSurplus(ctx, token))
+}
 
-	// Send coins to reserve address
-	reserveCoins, _ := sdk.ParseCoins("12.34res1,56.78res2")
-	_, _ = app.BankKeeper.AddCoins(ctx, bond.ReserveAddress, reserveCoins)
+func TestWithdrawReserve(t *testing.T) {
+	app, ctx := createTestApp(false)
 
-	// Reserve now equal to amount sent
-	reserveBalances := app.BondsKeeper.GetReserveBalances(ctx, token)
-	require.Equal(t, reserveCoins, reserveBalances)
+	// Add bond with reserve
+	bond := getValidBond()
+	app.BondsKeeper.SetBond(ctx, token, bond)
+	reserveCoins, _ := sdk.ParseCoins("12res1,56res2")
+	require.Nil(t, setReserve(app, ctx, token, reserveCoins))
+
+	// Withdraw part of reserve
+	withdrawal, _ := sdk.ParseCoins("2res1,6res2")
+	err := app.BondsKeeper.WithdrawReserve(ctx, token, initFeeAddress, withdrawal)
+	require.Nil(t, err)
+
+	// Reserve reduced and coins received
+	expectedReserve, _ := sdk.ParseCoins("10res1,50res2")
+	require.Equal(t, expectedReserve, app.BondsKeeper.GetReserveBalances(ctx, token))
+	require.Equal(t, withdrawal, app.BankKeeper.GetCoins(ctx, initFeeAddress))
+
+	// Withdrawing more than the reserve fails, even if the reserve address
+	// holds enough coins due to a surplus
+	surplusCoins, _ := sdk.ParseCoins("100res1")
+	_, _ = app.BankKeeper.AddCoins(ctx, bond.ReserveAddress, surplusCoins)
+	tooMuch, _ := sdk.ParseCoins("11res1")
+	err = app.BondsKeeper.WithdrawReserve(ctx, token, initFeeAddress, tooMuch)
+	require.NotNil(t, err)
+	require.Equal(t, expectedReserve, app.BondsKeeper.GetReserveBalances(ctx, token))
 }
 
 func TestGetSupplyAdjustedForBuy(t *testing.T) {
@@ -249,3 +260,28 @@ func TestSetCurrentSupply(t *testing.T) {
 	supplyFetched = app.BondsKeeper.MustGetBond(ctx, token).CurrentSupply
 	require.Equal(t, newSupply, supplyFetched)
 }
+
+func TestPayFeesFromReserveSplitsFeesAmongRecipients(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	// Add bond with reserve, with fees split 60/40
+	otherAddress := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	bond := getValidBond()
+	bond.FeeRecipients = types.FeeRecipients{
+		types.NewFeeRecipient(otherAddress, sdk.NewDec(60)),
+		types.NewFeeRecipient(initFeeAddress, sdk.NewDec(40)),
+	}
+	app.BondsKeeper.SetBond(ctx, token, bond)
+	reserveCoins, _ := sdk.ParseCoins("100res1")
+	require.Nil(t, setReserve(app, ctx, token, reserveCoins))
+
+	// Pay fees; the fee address receives the rounding remainder
+	fees, _ := sdk.ParseCoins("9res1")
+	err := app.BondsKeeper.PayFeesFromReserve(ctx, token, fees)
+	require.Nil(t, err)
+
+	expectedReserve, _ := sdk.ParseCoins("91res1")
+	require.Equal(t, expectedReserve, app.BondsKeeper.GetReserveBalances(ctx, token))
+	require.Equal(t, sdk.NewInt(5), app.BankKeeper.GetCoins(ctx, otherAddress).AmountOf("res1"))
+	require.Equal(t, sdk.NewInt(4), app.BankKeeper.GetCoins(ctx, initFeeAddress).AmountOf("res1"))
+}
@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"encoding/binary"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixoworld/bonds/x/bonds/internal/types"
+)
+
+func (k Keeper) GetAllCandlesIterator(ctx sdk.Context) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, types.CandlesKeyPrefix)
+}
+
+func (k Keeper) GetCandlesIterator(ctx sdk.Context, token string) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, types.GetCandlesPrefix(token))
+}
+
+func (k Keeper) MustGetCandleByKey(ctx sdk.Context, key []byte) types.Candle {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(key) {
+		panic("candle not found")
+	}
+	bz := store.Get(key)
+	var candle types.Candle
+	k.cdc.MustUnmarshalBinaryBare(bz, &candle)
+	return candle
+}
+
+// GetCandles returns the bond's candles, sorted from oldest to newest
+func (k Keeper) GetCandles(ctx sdk.Context, token string) (candles []types.Candle) {
+	iterator := k.GetCandlesIterator(ctx, token)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		candles = append(candles, k.MustGetCandleByKey(ctx, iterator.Key()))
+	}
+	return candles
+}
+
+func (k Keeper) getLastCandle(ctx sdk.Context, token string) (candle types.Candle, sequence uint64, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetCandlesPrefix(token)
+	iterator := sdk.KVStoreReversePrefixIterator(store, prefix)
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return types.Candle{}, 0, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &candle)
+	sequence = binary.BigEndian.Uint64(iterator.Key()[len(prefix):])
+	return candle, sequence, true
+}
+
+func (k Keeper) setCandle(ctx sdk.Context, sequence uint64, candle types.Candle) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetCandleKey(candle.Token, sequence),
+		k.cdc.MustMarshalBinaryBare(candle))
+}
+
+// AddCandle merges the candle into the bond's newest candle if it does not
+// start after it, and otherwise stores it as the bond's newest candle. Only
+// the newest MaxCandles candles are kept, so older candles are deleted.
+func (k Keeper) AddCandle(ctx sdk.Context, candle types.Candle) {
+	last, sequence, found := k.getLastCandle(ctx, candle.Token)
+	if found && candle.StartHeight <= last.StartHeight {
+		k.setCandle(ctx, sequence, last.Merge(candle))
+		return
+	} else if found {
+		sequence += 1
+	}
+	k.setCandle(ctx, sequence, candle)
+
+	maxCandles := k.GetParams(ctx).MaxCandles
+	if sequence < maxCandles {
+		return
+	}
+
+	// Collect keys first, since the store cannot be modified while iterating
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.GetCandlesPrefix(candle.Token),
+		types.GetCandleKey(candle.Token, sequence-maxCandles+1))
+	var oldKeys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		oldKeys = append(oldKeys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range oldKeys {
+		store.Delete(key)
+	}
+}
+
+// RecordBatchCandle adds the bond's performed batch to the bond's candles,
+// given the bond's prices before the batch was performed
+func (k Keeper) RecordBatchCandle(ctx sdk.Context, token string, openPrices sdk.DecCoins) {
+	bond := k.MustGetBond(ctx, token)
+	batch := k.MustGetBatch(ctx, token)
+
+	// Prices that cannot be calculated (e.g. swapper function bond with no
+	// liquidity) are taken from the other end of the batch, and the batch is
+	// skipped if there are no prices before or after the batch
+	reserveBalances := k.GetReserveBalances(ctx, token)
+	closePrices, err := bond.GetCurrentPricesPT(reserveBalances)
+	if err != nil {
+		closePrices = openPrices
+	} else if openPrices.Empty() {
+		openPrices = closePrices
+	}
+	if closePrices.Empty() {
+		return
+	}
+
+	height := ctx.BlockHeight()
+	intervalBlocks := k.GetParams(ctx).CandleIntervalBlocks
+	startHeight := types.GetCandleStartHeight(height, intervalBlocks)
+	k.AddCandle(ctx, types.NewBatchCandle(
+		startHeight, height, openPrices, closePrices, batch))
+}
+
+func (k Keeper) DeleteCandles(ctx sdk.Context, token string) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := k.GetCandlesIterator(ctx, token)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,94 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixoworld/bonds/x/bonds/internal/types"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func newTestCandle(startHeight int64, price int64) types.Candle {
+	prices := sdk.NewDecCoins(sdk.NewCoins(sdk.NewInt64Coin(reserveToken, price)))
+	return types.NewBatchCandle(startHeight, startHeight,
+		prices, prices, types.NewBatch(token, sdk.OneUint()))
+}
+
+func TestAddCandleMergesAndKeepsMaxCandles(t *testing.T) {
+	app, ctx := createTestApp(false)
+	params := app.BondsKeeper.GetParams(ctx)
+	params.MaxCandles = 3
+	app.BondsKeeper.SetParams(ctx, params)
+
+	c0 := newTestCandle(0, 10)
+	c10 := newTestCandle(10, 20)
+	c20 := newTestCandle(20, 30)
+	for _, c := range []types.Candle{c0, c10, c20} {
+		app.BondsKeeper.AddCandle(ctx, c)
+	}
+	require.Equal(t, []types.Candle{c0, c10, c20}, app.BondsKeeper.GetCandles(ctx, token))
+
+	// Candle that does not start after the newest candle is merged into it
+	c20b := newTestCandle(20, 40)
+	app.BondsKeeper.AddCandle(ctx, c20b)
+	require.Equal(t, []types.Candle{c0, c10, c20.Merge(c20b)},
+		app.BondsKeeper.GetCandles(ctx, token))
+
+	// Oldest candle deleted when the max is exceeded
+	c30 := newTestCandle(30, 50)
+	app.BondsKeeper.AddCandle(ctx, c30)
+	require.Equal(t, []types.Candle{c10, c20.Merge(c20b), c30},
+		app.BondsKeeper.GetCandles(ctx, token))
+
+	// Reducing the max deletes the extra candles when the next one is added
+	params.MaxCandles = 1
+	app.BondsKeeper.SetParams(ctx, params)
+	c40 := newTestCandle(40, 60)
+	app.BondsKeeper.AddCandle(ctx, c40)
+	require.Equal(t, []types.Candle{c40}, app.BondsKeeper.GetCandles(ctx, token))
+
+	// Candles deleted
+	app.BondsKeeper.DeleteCandles(ctx, token)
+	require.Nil(t, app.BondsKeeper.GetCandles(ctx, token))
+}
+
+func TestRecordBatchCandle(t *testing.T) {
+	app, ctx := createTestApp(false)
+	ctx = ctx.WithBlockHeight(250)
+
+	// Bond with supply 10 (y = 12x^2 + 100) and a performed buy of 10 tokens
+	bond := getValidBond()
+	bond.CurrentSupply = sdk.NewInt64Coin(token, 10)
+	app.BondsKeeper.SetBond(ctx, token, bond)
+	batch := types.NewBatch(token, sdk.OneUint())
+	batch.Buys = []types.BuyOrder{types.NewBuyOrder(buyerAddress,
+		sdk.NewInt64Coin(token, 10), nil, nil)}
+	batch.BuyPrices = sdk.NewDecCoins(sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 500)))
+	app.BondsKeeper.SetBatch(ctx, token, batch)
+
+	openPrices := sdk.NewDecCoins(sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 100)))
+	app.BondsKeeper.RecordBatchCandle(ctx, token, openPrices)
+
+	// Candle starts at the start of the default 100-block interval
+	candles := app.BondsKeeper.GetCandles(ctx, token)
+	require.Len(t, candles, 1)
+	closePrices := sdk.NewDecCoins(sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 1300)))
+	require.Equal(t, int64(200), candles[0].StartHeight)
+	require.Equal(t, int64(250), candles[0].EndHeight)
+	require.Equal(t, openPrices, candles[0].Open)
+	require.Equal(t, closePrices, candles[0].High)
+	require.Equal(t, openPrices, candles[0].Low)
+	require.Equal(t, closePrices, candles[0].Close)
+	require.Equal(t, sdk.NewInt(10), candles[0].BuyVolume)
+	require.Equal(t, sdk.OneUint(), candles[0].Buys)
+}
+
+func TestRecordBatchCandleSkipsBondsWithoutPrices(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	// Swapper function bond with no liquidity has no prices
+	app.BondsKeeper.SetBond(ctx, token, getValidSwapperBond())
+	app.BondsKeeper.SetBatch(ctx, token, types.NewBatch(token, sdk.OneUint()))
+
+	app.BondsKeeper.RecordBatchCandle(ctx, token, nil)
+	require.Nil(t, app.BondsKeeper.GetCandles(ctx, token))
+}
@@ -90,6 +90,22 @@ func createTestApp(isCheckTx bool) (*simapp.SimApp, sdk.Context) {
 	return app, ctx
 }
 
+func setReserve(app *simapp.SimApp, ctx sdk.Context, token string, reserve sdk.Coins) error {
+	// Sets both the reserve tracked by the bond and the reserve address balance
+	bond := app.BondsKeeper.MustGetBond(ctx, token)
+	bond.CurrentReserve = reserve
+	app.BondsKeeper.SetBond(ctx, token, bond)
+	if err := app.BankKeeper.SetCoins(ctx, bond.ReserveAddress, reserve); err != nil {
+		return err
+	}
+	return nil
+}
+
+func addToReserve(app *simapp.SimApp, ctx sdk.Context, token string, amount sdk.Coins) error {
+	reserve := app.BondsKeeper.GetReserveBalances(ctx, token)
+	return setReserve(app, ctx, token, reserve.Add(amount))
+}
+
 func getValidPowerFunctionBond() types.Bond {
 	functionType := types.PowerFunction
 	functionParams := functionParametersPower
@@ -134,11 +150,11 @@ func getValidBaseOrder() types.BaseOrder {
 }
 
 func getValidBuyOrder() types.BuyOrder {
-	return types.NewBuyOrder(buyerAddress, buyAmount, maxPrices)
+	return types.NewBuyOrder(buyerAddress, buyAmount, maxPrices, nil)
 }
 
 func getValidSellOrder() types.SellOrder {
-	return types.NewSellOrder(sellerAddress, sellAmount)
+	return types.NewSellOrder(sellerAddress, sellAmount, initExitFeePercentage)
 }
 
 func getValidSwapOrder() types.SwapOrder {
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixoworld/bonds/x/bonds/internal/types"
+)
+
+// Hooks wrapper struct for bonds keeper
+type Hooks struct {
+	k Keeper
+}
+
+var _ types.BankHooks = Hooks{}
+
+// Return the wrapper struct
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// Settle any rewards accrued by the address for bond tokens that are about
+// to change, so that rewards accrued so far use the balance before the change
+func (h Hooks) BeforeCoinsChange(ctx sdk.Context, addr sdk.AccAddress, coins sdk.Coins) {
+	for _, c := range coins {
+		bond, found := h.k.GetBond(ctx, c.Denom)
+		if found && bond.HasHolderRewards() {
+			h.k.SettleHolderRewards(ctx, bond.Token, addr)
+		}
+	}
+}
+
+// Update the lots of any bond tokens that changed for bonds with a holding
+// period exit fee, so that the lots match the address' new balance, and prune
+// the address' tap vote for bonds with a tap if its balance dropped to zero
+func (h Hooks) AfterCoinsChange(ctx sdk.Context, addr sdk.AccAddress, coins sdk.Coins) {
+	for _, c := range coins {
+		bond, found := h.k.GetBond(ctx, c.Denom)
+		if found && bond.HasHoldingPeriodExitFee() {
+			h.k.UpdateHolderLots(ctx, bond.Token, addr)
+		}
+		if found && bond.HasTap() {
+			h.k.PruneTapVote(ctx, bond.Token, addr)
+		}
+	}
+}
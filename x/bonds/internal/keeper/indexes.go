@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	"bytes"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixoworld/bonds/x/bonds/internal/types"
+)
+
+var bondIndexKeyPrefixes = [][]byte{
+	types.CreatorIndexKeyPrefix,
+	types.SignerIndexKeyPrefix,
+	types.ReserveDenomIndexKeyPrefix,
+}
+
+func (k Keeper) GetBondIndexIterator(ctx sdk.Context, prefix []byte) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, prefix)
+}
+
+func (k Keeper) getBondTokensByIndexPrefix(ctx sdk.Context, prefix []byte) (tokens []string) {
+	iterator := k.GetBondIndexIterator(ctx, prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		tokens = append(tokens, string(iterator.Value()))
+	}
+	return tokens
+}
+
+func (k Keeper) GetBondTokensByCreator(ctx sdk.Context, creator sdk.AccAddress) []string {
+	return k.getBondTokensByIndexPrefix(ctx, types.GetCreatorIndexPrefix(creator))
+}
+
+func (k Keeper) GetBondTokensBySigner(ctx sdk.Context, signer sdk.AccAddress) []string {
+	return k.getBondTokensByIndexPrefix(ctx, types.GetSignerIndexPrefix(signer))
+}
+
+func (k Keeper) GetBondTokensByReserveDenom(ctx sdk.Context, denom string) []string {
+	return k.getBondTokensByIndexPrefix(ctx, types.GetReserveDenomIndexPrefix(denom))
+}
+
+// GetBondTokensByOrderAddress returns the bonds in whose current or last
+// batch the address has orders
+func (k Keeper) GetBondTokensByOrderAddress(ctx sdk.Context, address sdk.AccAddress) []string {
+	return k.getBondTokensByIndexPrefix(ctx, types.GetOrderAddressIndexPrefix(address))
+}
+
+func (k Keeper) setBondIndexes(ctx sdk.Context, bond types.Bond) {
+	store := ctx.KVStore(k.storeKey)
+	for _, key := range types.GetBondIndexKeys(bond) {
+		store.Set(key, []byte(bond.Token))
+	}
+}
+
+func (k Keeper) deleteBondIndexes(ctx sdk.Context, bond types.Bond) {
+	store := ctx.KVStore(k.storeKey)
+	for _, key := range types.GetBondIndexKeys(bond) {
+		store.Delete(key)
+	}
+}
+
+// updateBondIndexes replaces the old bond's index entries with the new bond's,
+// doing nothing if none of the indexed fields have changed
+func (k Keeper) updateBondIndexes(ctx sdk.Context, oldBond, newBond types.Bond) {
+	oldKeys := types.GetBondIndexKeys(oldBond)
+	newKeys := types.GetBondIndexKeys(newBond)
+	if len(oldKeys) == len(newKeys) {
+		changed := false
+		for i := range oldKeys {
+			if !bytes.Equal(oldKeys[i], newKeys[i]) {
+				changed = true
+				break
+			}
+		}
+		if !changed {
+			return
+		}
+	}
+
+	k.deleteBondIndexes(ctx, oldBond)
+	k.setBondIndexes(ctx, newBond)
+}
+
+// RebuildBondIndexes deletes all index entries and re-adds the entries of
+// every bond in the primary store
+func (k Keeper) RebuildBondIndexes(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	for _, prefix := range bondIndexKeyPrefixes {
+		var keys [][]byte
+		iterator := k.GetBondIndexIterator(ctx, prefix)
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+
+	iterator := k.GetBondIterator(ctx)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		k.setBondIndexes(ctx, k.MustGetBondByKey(ctx, iterator.Key()))
+	}
+}
+
+func (k Keeper) setOrderAddressIndex(ctx sdk.Context, address sdk.AccAddress, token string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetOrderAddressIndexKey(address, token), []byte(token))
+}
+
+// deleteOrderAddressIndexes deletes the index entries of the addresses with
+// orders in the removed batch, apart from addresses with orders in any of the
+// bond's remaining batches
+func (k Keeper) deleteOrderAddressIndexes(ctx sdk.Context, token string, removed types.Batch, remaining ...types.Batch) {
+	store := ctx.KVStore(k.storeKey)
+	for _, address := range removed.GetOrderAddresses() {
+		hasOrders := false
+		for _, batch := range remaining {
+			if batch.GetOrdersByAddress(address).NumberOfOrders() != 0 {
+				hasOrders = true
+				break
+			}
+		}
+		if !hasOrders {
+			store.Delete(types.GetOrderAddressIndexKey(address, token))
+		}
+	}
+}
+
+// getIndexedBatches returns the bond's current and last batches (if any),
+// which are the batches whose order addresses are indexed
+func (k Keeper) getIndexedBatches(ctx sdk.Context, token string) (batches []types.Batch) {
+	if k.BatchExists(ctx, token) {
+		batches = append(batches, k.MustGetBatch(ctx, token))
+	}
+	if k.LastBatchExists(ctx, token) {
+		batches = append(batches, k.MustGetLastBatch(ctx, token))
+	}
+	return batches
+}
+
+// RebuildOrderAddressIndexes deletes all order address index entries and
+// re-adds the entries of every bond's current and last batch
+func (k Keeper) RebuildOrderAddressIndexes(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	var keys [][]byte
+	iterator := k.GetBondIndexIterator(ctx, types.OrderAddressIndexKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	bondIterator := k.GetBondIterator(ctx)
+	defer bondIterator.Close()
+	for ; bondIterator.Valid(); bondIterator.Next() {
+		token := k.MustGetBondByKey(ctx, bondIterator.Key()).Token
+		for _, batch := range k.getIndexedBatches(ctx, token) {
+			for _, address := range batch.GetOrderAddresses() {
+				k.setOrderAddressIndex(ctx, address, token)
+			}
+		}
+	}
+}
+
+// GetOrdersByAddress returns the address' orders in the current (pending)
+// and last (settled) batches of every bond in which it has orders
+func (k Keeper) GetOrdersByAddress(ctx sdk.Context, address sdk.AccAddress) (orders []types.AddressOrders) {
+	for _, token := range k.GetBondTokensByOrderAddress(ctx, address) {
+		addressOrders := types.AddressOrders{Token: token}
+		if k.BatchExists(ctx, token) {
+			addressOrders.Pending = k.MustGetBatch(ctx, token).GetOrdersByAddress(address)
+		}
+		if k.LastBatchExists(ctx, token) {
+			addressOrders.Settled = k.MustGetLastBatch(ctx, token).GetOrdersByAddress(address)
+		}
+		orders = append(orders, addressOrders)
+	}
+	return orders
+}
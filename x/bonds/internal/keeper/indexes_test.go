@@ -0,0 +1,153 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixoworld/bonds/x/bonds/internal/keeper"
+	"github.com/ixoworld/bonds/x/bonds/internal/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"testing"
+)
+
+func TestBondIndexesFollowSetAndDeleteBond(t *testing.T) {
+	app, ctx := createTestApp(false)
+	otherSigner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	// Initially no bonds are indexed
+	require.Empty(t, app.BondsKeeper.GetBondTokensByCreator(ctx, initCreator))
+	require.Empty(t, app.BondsKeeper.GetBondTokensByReserveDenom(ctx, reserveToken))
+
+	// Add two bonds, the second of which also grants a role to another signer
+	app.BondsKeeper.SetBond(ctx, token1, getValidBondWithToken(token1))
+	bond2 := getValidBondWithToken(token2)
+	bond2.Roles = bond2.Roles.Set(types.NewBondRole(types.RolePauser,
+		[]sdk.AccAddress{initCreator, otherSigner}, sdk.OneUint()))
+	app.BondsKeeper.SetBond(ctx, token2, bond2)
+
+	require.Equal(t, []string{token1, token2}, app.BondsKeeper.GetBondTokensByCreator(ctx, initCreator))
+	require.Equal(t, []string{token1, token2}, app.BondsKeeper.GetBondTokensBySigner(ctx, initCreator))
+	require.Equal(t, []string{token2}, app.BondsKeeper.GetBondTokensBySigner(ctx, otherSigner))
+	require.Equal(t, []string{token1, token2}, app.BondsKeeper.GetBondTokensByReserveDenom(ctx, reserveToken))
+	require.Empty(t, app.BondsKeeper.GetBondTokensByReserveDenom(ctx, reserveToken2))
+
+	// Changing the second bond's roles and reserve tokens updates indexes
+	bond2.Roles = types.NewDefaultBondRoles([]sdk.AccAddress{otherSigner})
+	bond2.ReserveTokens = []string{reserveToken2}
+	app.BondsKeeper.SetBond(ctx, token2, bond2)
+
+	require.Equal(t, []string{token1}, app.BondsKeeper.GetBondTokensBySigner(ctx, initCreator))
+	require.Equal(t, []string{token2}, app.BondsKeeper.GetBondTokensBySigner(ctx, otherSigner))
+	require.Equal(t, []string{token1}, app.BondsKeeper.GetBondTokensByReserveDenom(ctx, reserveToken))
+	require.Equal(t, []string{token2}, app.BondsKeeper.GetBondTokensByReserveDenom(ctx, reserveToken2))
+
+	// Deleting the first bond removes it from the indexes
+	app.BondsKeeper.DeleteBond(ctx, token1)
+
+	require.Equal(t, []string{token2}, app.BondsKeeper.GetBondTokensByCreator(ctx, initCreator))
+	require.Empty(t, app.BondsKeeper.GetBondTokensBySigner(ctx, initCreator))
+	require.Empty(t, app.BondsKeeper.GetBondTokensByReserveDenom(ctx, reserveToken))
+
+	_, broken := keeper.BondIndexesInvariant(app.BondsKeeper)(ctx)
+	require.False(t, broken)
+}
+
+func TestRebuildBondIndexes(t *testing.T) {
+	app, ctx := createTestApp(false)
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+
+	app.BondsKeeper.SetBond(ctx, token1, getValidBondWithToken(token1))
+	app.BondsKeeper.SetBond(ctx, token2, getValidBondWithToken(token2))
+
+	// Remove an index entry and add a stale one
+	store.Delete(types.GetCreatorIndexKey(initCreator, token1))
+	store.Set(types.GetReserveDenomIndexKey(reserveToken2, token2), []byte(token2))
+
+	_, broken := keeper.BondIndexesInvariant(app.BondsKeeper)(ctx)
+	require.True(t, broken)
+
+	// Rebuilding the indexes fixes both entries
+	app.BondsKeeper.RebuildBondIndexes(ctx)
+
+	require.Equal(t, []string{token1, token2}, app.BondsKeeper.GetBondTokensByCreator(ctx, initCreator))
+	require.Empty(t, app.BondsKeeper.GetBondTokensByReserveDenom(ctx, reserveToken2))
+
+	_, broken = keeper.BondIndexesInvariant(app.BondsKeeper)(ctx)
+	require.False(t, broken)
+}
+
+func TestOrderAddressIndexesFollowBatches(t *testing.T) {
+	app, ctx := createTestApp(false)
+	other := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	for _, token := range []string{token1, token2} {
+		app.BondsKeeper.SetBond(ctx, token, getValidBondWithToken(token))
+		app.BondsKeeper.SetBatch(ctx, token, types.NewBatch(token, sdk.OneUint()))
+	}
+	checkInvariant := func() {
+		_, broken := keeper.OrderAddressIndexesInvariant(app.BondsKeeper)(ctx)
+		require.False(t, broken)
+	}
+	rollOver := func(token string) {
+		app.BondsKeeper.SetLastBatch(ctx, token, app.BondsKeeper.MustGetBatch(ctx, token))
+		app.BondsKeeper.SetBatch(ctx, token, types.NewBatch(token, sdk.OneUint()))
+	}
+
+	// Adding orders indexes the orders' addresses
+	bo := types.NewBuyOrder(initCreator, sdk.NewInt64Coin(token1, 10), nil, nil)
+	so := types.NewSellOrder(other, sdk.NewInt64Coin(token2, 5), sdk.ZeroDec())
+	app.BondsKeeper.AddBuyOrder(ctx, token1, bo, nil, nil)
+	app.BondsKeeper.AddSellOrder(ctx, token2, so, nil, nil)
+	require.Equal(t, []string{token1}, app.BondsKeeper.GetBondTokensByOrderAddress(ctx, initCreator))
+	require.Equal(t, []string{token2}, app.BondsKeeper.GetBondTokensByOrderAddress(ctx, other))
+	require.Equal(t, []types.AddressOrders{{Token: token1,
+		Pending: types.BatchOrders{Buys: []types.BuyOrder{bo}}}},
+		app.BondsKeeper.GetOrdersByAddress(ctx, initCreator))
+	checkInvariant()
+
+	// Orders in the last batch are still indexed, as settled orders
+	rollOver(token1)
+	require.Equal(t, []types.AddressOrders{{Token: token1,
+		Settled: types.BatchOrders{Buys: []types.BuyOrder{bo}}}},
+		app.BondsKeeper.GetOrdersByAddress(ctx, initCreator))
+	checkInvariant()
+
+	// Address is unindexed once its orders are no longer in the last batch
+	rollOver(token1)
+	require.Empty(t, app.BondsKeeper.GetBondTokensByOrderAddress(ctx, initCreator))
+	require.Empty(t, app.BondsKeeper.GetOrdersByAddress(ctx, initCreator))
+	checkInvariant()
+
+	// Deleting the batches (e.g. when closing a bond) unindexes addresses
+	rollOver(token2)
+	app.BondsKeeper.AddSellOrder(ctx, token2, so, nil, nil)
+	app.BondsKeeper.DeleteBatch(ctx, token2)
+	require.Equal(t, []string{token2}, app.BondsKeeper.GetBondTokensByOrderAddress(ctx, other))
+	app.BondsKeeper.DeleteLastBatch(ctx, token2)
+	require.Empty(t, app.BondsKeeper.GetBondTokensByOrderAddress(ctx, other))
+}
+
+func TestRebuildOrderAddressIndexes(t *testing.T) {
+	app, ctx := createTestApp(false)
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	other := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	app.BondsKeeper.SetBond(ctx, token1, getValidBondWithToken(token1))
+	batch := types.NewBatch(token1, sdk.OneUint())
+	batch.Buys = []types.BuyOrder{
+		types.NewBuyOrder(initCreator, sdk.NewInt64Coin(token1, 10), nil, nil)}
+	app.BondsKeeper.SetBatch(ctx, token1, batch)
+
+	// Batch set directly (e.g. from genesis) and a stale index entry
+	store.Set(types.GetOrderAddressIndexKey(other, token1), []byte(token1))
+
+	_, broken := keeper.OrderAddressIndexesInvariant(app.BondsKeeper)(ctx)
+	require.True(t, broken)
+
+	// Rebuilding the indexes fixes both entries
+	app.BondsKeeper.RebuildOrderAddressIndexes(ctx)
+
+	require.Equal(t, []string{token1}, app.BondsKeeper.GetBondTokensByOrderAddress(ctx, initCreator))
+	require.Empty(t, app.BondsKeeper.GetBondTokensByOrderAddress(ctx, other))
+
+	_, broken = keeper.OrderAddressIndexesInvariant(app.BondsKeeper)(ctx)
+	require.False(t, broken)
+}
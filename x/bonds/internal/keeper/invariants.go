@@ -16,6 +16,20 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 		SupplyInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "bonds-reserve",
 		ReserveInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "bonds-reserve-balance",
+		ReserveBalanceInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "bonds-rewards-pool",
+		RewardsPoolInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "bonds-holder-lots",
+		HolderLotsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "bonds-staked-reserve-index",
+		StakedReserveIndexInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "bonds-rounding-surplus",
+		RoundingSurplusInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "bonds-indexes",
+		BondIndexesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "bonds-order-address-indexes",
+		OrderAddressIndexesInvariant(k))
 }
 
 // AllInvariants runs all invariants of the bonds module.
@@ -25,7 +39,35 @@ func AllInvariants(k Keeper) sdk.Invariant {
 		if stop {
 			return res, stop
 		}
-		return ReserveInvariant(k)(ctx)
+		res, stop = ReserveInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		res, stop = ReserveBalanceInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		res, stop = RewardsPoolInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		res, stop = HolderLotsInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		res, stop = StakedReserveIndexInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		res, stop = RoundingSurplusInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		res, stop = BondIndexesInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return OrderAddressIndexesInvariant(k)(ctx)
 	}
 }
 
@@ -95,14 +137,20 @@ func ReserveInvariant(k Keeper) sdk.Invariant {
 			expectedRounded := expectedReserve.Ceil().TruncateInt()
 			actualReserve := k.GetReserveBalances(ctx, denom)
 
+			// Funds withdrawn through the bond's tap and losses of staked
+			// reserve are accounted for, given that buy and sell prices are
+			// adjusted for the withdrawn funds
+			withdrawn := bond.GetReserveWithdrawn()
+
 			for _, r := range actualReserve {
-				if r.Amount.LT(expectedRounded) {
+				if r.Amount.Add(withdrawn).LT(expectedRounded) {
 					count++
 					msg += fmt.Sprintf("%s reserve invariance:\n"+
 						"\texpected(ceil-rounded) %s reserve: %s\n"+
-						"\tactual %s reserve: %s\n",
+						"\tactual %s reserve: %s\n"+
+						"\twithdrawn through tap or lost through staking: %s\n",
 						denom, denom, expectedReserve.String(),
-						denom, r.String())
+						denom, r.String(), withdrawn.String())
 				}
 			}
 		}
@@ -112,3 +160,287 @@ func ReserveInvariant(k Keeper) sdk.Invariant {
 			"%d Bonds reserve invariants broken\n%s", count, msg)), broken
 	}
 }
+
+func ReserveBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+
+		iterator := k.GetBondIterator(ctx)
+		for ; iterator.Valid(); iterator.Next() {
+			bond := k.MustGetBondByKey(ctx, iterator.Key())
+			denom := bond.Token
+
+			// Reserve address has to hold at least the tracked reserve; any
+			// amount above the tracked reserve is the reserve surplus
+			trackedReserve := k.GetReserveBalances(ctx, denom)
+			actualReserve := k.GetActualReserveBalances(ctx, denom)
+
+			// Staked reserve is counted as held by the reserve address, and the
+			// reserve owed to queued sells is counted as tracked
+			if bond.HasReserveStaking() {
+				staked := k.GetStakedReserve(ctx, denom)
+				if staked.IsPositive() {
+					actualReserve = actualReserve.Add(sdk.Coins{
+						sdk.NewCoin(getStakingDenom(bond), staked)})
+				}
+				trackedReserve = trackedReserve.Add(bond.Staking.GetQueuedTotal())
+			}
+
+			if !actualReserve.IsAllGTE(trackedReserve) {
+				count++
+				msg += fmt.Sprintf("%s reserve balance invariance:\n"+
+					"\ttracked %s reserve: %s\n"+
+					"\tactual %s reserve: %s\n",
+					denom, denom, trackedReserve.String(),
+					denom, actualReserve.String())
+			}
+		}
+
+		broken := count != 0
+		return sdk.FormatInvariant(types.ModuleName, "reserve balance", fmt.Sprintf(
+			"%d Bonds reserve balance invariants broken\n%s", count, msg)), broken
+	}
+}
+
+func RewardsPoolInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+
+		// Rewards account has to hold at least the sum of all rewards pools
+		var totalRewardsPools sdk.Coins
+		iterator := k.GetBondIterator(ctx)
+		for ; iterator.Valid(); iterator.Next() {
+			bond := k.MustGetBondByKey(ctx, iterator.Key())
+			totalRewardsPools = totalRewardsPools.Add(bond.RewardsPool)
+		}
+
+		rewardsAddress := k.SupplyKeeper.GetModuleAddress(types.BondsRewardsAccount)
+		rewardsBalance := k.CoinKeeper.GetCoins(ctx, rewardsAddress)
+		if !rewardsBalance.IsAllGTE(totalRewardsPools) {
+			count++
+			msg += fmt.Sprintf("rewards pool invariance:\n"+
+				"\tsum of rewards pools: %s\n"+
+				"\tactual rewards balance: %s\n",
+				totalRewardsPools.String(), rewardsBalance.String())
+		}
+
+		broken := count != 0
+		return sdk.FormatInvariant(types.ModuleName, "rewards pool", fmt.Sprintf(
+			"%d Bonds rewards pool invariants broken\n%s", count, msg)), broken
+	}
+}
+
+func HolderLotsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+
+		// Each holder's lots have to add up to the holder's balance
+		iterator := k.GetAllHolderLotsIterator(ctx)
+		for ; iterator.Valid(); iterator.Next() {
+			holderLots := k.MustGetHolderLotsByKey(ctx, iterator.Key())
+			denom := holderLots.Token
+
+			lotsTotal := holderLots.GetTotal()
+			balance := k.CoinKeeper.GetCoins(ctx, holderLots.Address).AmountOf(denom)
+			if !lotsTotal.Equal(balance) {
+				count++
+				msg += fmt.Sprintf("%s holder lots invariance:\n"+
+					"\taddress: %s\n"+
+					"\tsum of lots: %s\n"+
+					"\tactual balance: %s\n",
+					denom, holderLots.Address.String(),
+					lotsTotal.String(), balance.String())
+			}
+		}
+
+		broken := count != 0
+		return sdk.FormatInvariant(types.ModuleName, "holder lots", fmt.Sprintf(
+			"%d Bonds holder lots invariants broken\n%s", count, msg)), broken
+	}
+}
+
+func StakedReserveIndexInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+
+		// Every bond with delegated or unbonding reserve should be indexed.
+		// Entries of bonds that no longer have any staked reserve are only
+		// removed in the next begin-blocker, so these are not stale.
+		store := ctx.KVStore(k.storeKey)
+		iterator := k.GetBondIterator(ctx)
+		for ; iterator.Valid(); iterator.Next() {
+			bond := k.MustGetBondByKey(ctx, iterator.Key())
+			if !bond.HasReserveStaking() || k.GetStakedReserve(ctx, bond.Token).IsZero() {
+				continue
+			}
+			key := types.GetStakedReserveIndexKey(bond.Token)
+			if value := store.Get(key); string(value) != bond.Token {
+				count++
+				msg += fmt.Sprintf("%s staked reserve index invariance:\n"+
+					"\tmissing or incorrect index entry\n", bond.Token)
+			}
+		}
+		iterator.Close()
+
+		// Every index entry should be of an existing bond
+		indexIterator := sdk.KVStorePrefixIterator(store, types.StakedReserveIndexKeyPrefix)
+		for ; indexIterator.Valid(); indexIterator.Next() {
+			if !k.BondExists(ctx, string(indexIterator.Value())) {
+				count++
+				msg += fmt.Sprintf("%s staked reserve index invariance:\n"+
+					"\tindex entry of non-existent bond\n", string(indexIterator.Value()))
+			}
+		}
+		indexIterator.Close()
+
+		broken := count != 0
+		return sdk.FormatInvariant(types.ModuleName, "staked reserve index", fmt.Sprintf(
+			"%d Bonds staked reserve index invariants broken\n%s", count, msg)), broken
+	}
+}
+
+func RoundingSurplusInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+
+		iterator := k.GetBondIterator(ctx)
+		for ; iterator.Valid(); iterator.Next() {
+			bond := k.MustGetBondByKey(ctx, iterator.Key())
+			denom := bond.Token
+
+			if bond.FunctionType == types.SwapperFunction {
+				continue // Check does not apply to swapper function
+			}
+
+			// Every change to the reserve is tracked exactly in the expected
+			// reserve, apart from rounding remainders, which are tracked in
+			// the rounding surplus, so the reserve should be exactly the two
+			// together. The expected reserve of a bond without a tap or
+			// reserve staking should also be exactly the curve integral.
+			actualReserve := k.GetReserveBalances(ctx, denom)
+			curveIntegral := bond.CurveIntegral(bond.CurrentSupply.Amount)
+			followsCurve := !bond.ReserveCanDivergeFromCurve() && !bond.IsDissolved()
+
+			for _, r := range bond.ReserveTokens {
+				surplus := bond.RoundingSurplus.AmountOf(r)
+				expected := bond.ExpectedReserve.AmountOf(r)
+				if !actualReserve.AmountOf(r).ToDec().Equal(expected.Add(surplus)) {
+					count++
+					msg += fmt.Sprintf("%s rounding surplus invariance:\n"+
+						"\trounding surplus: %s\n"+
+						"\texpected %s reserve: %s\n"+
+						"\tactual %s reserve: %s\n",
+						denom, surplus.String(), denom, expected.String(),
+						denom, actualReserve.String())
+				}
+				if followsCurve && !expected.Equal(curveIntegral) {
+					count++
+					msg += fmt.Sprintf("%s rounding surplus invariance:\n"+
+						"\texpected %s reserve: %s\n"+
+						"\tcurve integral: %s\n",
+						denom, denom, expected.String(), curveIntegral.String())
+				}
+			}
+		}
+
+		broken := count != 0
+		return sdk.FormatInvariant(types.ModuleName, "rounding surplus", fmt.Sprintf(
+			"%d Bonds rounding surplus invariants broken\n%s", count, msg)), broken
+	}
+}
+
+func BondIndexesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+
+		// Every index entry expected from the primary store should exist
+		expected := make(map[string]string)
+		store := ctx.KVStore(k.storeKey)
+		iterator := k.GetBondIterator(ctx)
+		for ; iterator.Valid(); iterator.Next() {
+			bond := k.MustGetBondByKey(ctx, iterator.Key())
+			for _, key := range types.GetBondIndexKeys(bond) {
+				expected[string(key)] = bond.Token
+				if value := store.Get(key); string(value) != bond.Token {
+					count++
+					msg += fmt.Sprintf("%s index invariance:\n"+
+						"\tmissing or incorrect index entry: %X\n",
+						bond.Token, key)
+				}
+			}
+		}
+		iterator.Close()
+
+		// Every index entry should be expected from the primary store
+		for _, prefix := range bondIndexKeyPrefixes {
+			indexIterator := k.GetBondIndexIterator(ctx, prefix)
+			for ; indexIterator.Valid(); indexIterator.Next() {
+				if _, ok := expected[string(indexIterator.Key())]; !ok {
+					count++
+					msg += fmt.Sprintf("%s index invariance:\n"+
+						"\tstale index entry: %X\n",
+						string(indexIterator.Value()), indexIterator.Key())
+				}
+			}
+			indexIterator.Close()
+		}
+
+		broken := count != 0
+		return sdk.FormatInvariant(types.ModuleName, "indexes", fmt.Sprintf(
+			"%d Bonds index invariants broken\n%s", count, msg)), broken
+	}
+}
+
+func OrderAddressIndexesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+
+		// Every address with orders in a bond's current or last batch should
+		// be indexed
+		expected := make(map[string]bool)
+		store := ctx.KVStore(k.storeKey)
+		iterator := k.GetBondIterator(ctx)
+		for ; iterator.Valid(); iterator.Next() {
+			token := k.MustGetBondByKey(ctx, iterator.Key()).Token
+			for _, batch := range k.getIndexedBatches(ctx, token) {
+				for _, address := range batch.GetOrderAddresses() {
+					key := types.GetOrderAddressIndexKey(address, token)
+					if expected[string(key)] {
+						continue
+					}
+					expected[string(key)] = true
+					if value := store.Get(key); string(value) != token {
+						count++
+						msg += fmt.Sprintf("%s order address index invariance:\n"+
+							"\tmissing or incorrect index entry for %s\n",
+							token, address.String())
+					}
+				}
+			}
+		}
+		iterator.Close()
+
+		// Every index entry should be expected from the batches
+		indexIterator := k.GetBondIndexIterator(ctx, types.OrderAddressIndexKeyPrefix)
+		for ; indexIterator.Valid(); indexIterator.Next() {
+			if !expected[string(indexIterator.Key())] {
+				count++
+				msg += fmt.Sprintf("%s order address index invariance:\n"+
+					"\tstale index entry: %X\n",
+					string(indexIterator.Value()), indexIterator.Key())
+			}
+		}
+		indexIterator.Close()
+
+		broken := count != 0
+		return sdk.FormatInvariant(types.ModuleName, "order address indexes", fmt.Sprintf(
+			"%d Bonds order address index invariants broken\n%s", count, msg)), broken
+	}
+}
@@ -6,6 +6,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/supply"
 	"github.com/ixoworld/bonds/x/bonds/internal/types"
@@ -13,32 +15,46 @@ import (
 )
 
 type Keeper struct {
-	CoinKeeper    bank.Keeper
-	SupplyKeeper  supply.Keeper
-	accountKeeper auth.AccountKeeper
-	StakingKeeper staking.Keeper
+	CoinKeeper         bank.Keeper
+	SupplyKeeper       supply.Keeper
+	accountKeeper      auth.AccountKeeper
+	StakingKeeper      staking.Keeper
+	DistributionKeeper distribution.Keeper
 
-	storeKey sdk.StoreKey
+	storeKey   sdk.StoreKey
+	paramSpace params.Subspace
 
 	cdc *codec.Codec
 }
 
 func NewKeeper(coinKeeper bank.Keeper, supplyKeeper supply.Keeper,
 	accountKeeper auth.AccountKeeper, stakingKeeper staking.Keeper,
-	storeKey sdk.StoreKey, cdc *codec.Codec) Keeper {
+	distributionKeeper distribution.Keeper, storeKey sdk.StoreKey, paramSpace params.Subspace, cdc *codec.Codec) Keeper {
 
 	// ensure batches module account is set
 	if addr := supplyKeeper.GetModuleAddress(types.BatchesIntermediaryAccount); addr == nil {
 		panic(fmt.Sprintf("%s module account has not been set", types.BatchesIntermediaryAccount))
 	}
 
+	// ensure deposits module account is set
+	if addr := supplyKeeper.GetModuleAddress(types.BondsDepositAccount); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.BondsDepositAccount))
+	}
+
+	// ensure rewards module account is set
+	if addr := supplyKeeper.GetModuleAddress(types.BondsRewardsAccount); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.BondsRewardsAccount))
+	}
+
 	return Keeper{
-		CoinKeeper:    coinKeeper,
-		SupplyKeeper:  supplyKeeper,
-		accountKeeper: accountKeeper,
-		StakingKeeper: stakingKeeper,
-		storeKey:      storeKey,
-		cdc:           cdc,
+		CoinKeeper:         coinKeeper,
+		SupplyKeeper:       supplyKeeper,
+		accountKeeper:      accountKeeper,
+		StakingKeeper:      stakingKeeper,
+		DistributionKeeper: distributionKeeper,
+		storeKey:           storeKey,
+		paramSpace:         paramSpace.WithKeyTable(types.ParamKeyTable()),
+		cdc:                cdc,
 	}
 }
 
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixoworld/bonds/x/bonds/internal/types"
+)
+
+func (k Keeper) GetAllHolderLotsIterator(ctx sdk.Context) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, types.HolderLotsKeyPrefix)
+}
+
+func (k Keeper) MustGetHolderLotsByKey(ctx sdk.Context, key []byte) types.HolderLots {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(key) {
+		panic("holder lots not found")
+	}
+	bz := store.Get(key)
+	var holderLots types.HolderLots
+	k.cdc.MustUnmarshalBinaryBare(bz, &holderLots)
+	return holderLots
+}
+
+func (k Keeper) GetHolderLots(ctx sdk.Context, token string, address sdk.AccAddress) types.HolderLots {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetHolderLotsKey(token, address))
+	if bz == nil {
+		return types.NewHolderLots(token, address)
+	}
+	var holderLots types.HolderLots
+	k.cdc.MustUnmarshalBinaryBare(bz, &holderLots)
+	return holderLots
+}
+
+func (k Keeper) SetHolderLots(ctx sdk.Context, holderLots types.HolderLots) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetHolderLotsKey(holderLots.Token, holderLots.Address),
+		k.cdc.MustMarshalBinaryBare(holderLots))
+}
+
+func (k Keeper) DeleteHolderLots(ctx sdk.Context, token string, address sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetHolderLotsKey(token, address))
+}
+
+func (k Keeper) UpdateHolderLots(ctx sdk.Context, token string, address sdk.AccAddress) {
+	holderLots := k.GetHolderLots(ctx, token, address)
+	balance := k.CoinKeeper.GetCoins(ctx, address).AmountOf(token)
+	total := holderLots.GetTotal()
+
+	// Bring the lots in line with the address' balance, by adding any
+	// increase as a lot acquired at the current height, or by removing any
+	// decrease from the oldest lots first
+	if balance.GT(total) {
+		holderLots = holderLots.Add(ctx.BlockHeight(), balance.Sub(total))
+	} else if balance.LT(total) {
+		holderLots = holderLots.Remove(total.Sub(balance))
+	} else {
+		return
+	}
+
+	if len(holderLots.Lots) == 0 {
+		k.DeleteHolderLots(ctx, token, address)
+	} else {
+		k.SetHolderLots(ctx, holderLots)
+	}
+}
+
+func (k Keeper) GetSellExitFeePercentage(ctx sdk.Context, token string,
+	address sdk.AccAddress, amount sdk.Int) sdk.Dec {
+	bond := k.MustGetBond(ctx, token)
+	if !bond.HasHoldingPeriodExitFee() {
+		return bond.ExitFeePercentage
+	}
+
+	// The tokens being sold are the address' oldest tokens
+	lots := k.GetHolderLots(ctx, token, address).GetOldest(amount)
+	return bond.GetExitFeePercentageForLots(lots, amount, ctx.BlockHeight())
+}
@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixoworld/bonds/x/bonds/internal/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"testing"
+)
+
+func TestHolderLotsFollowBalancesFirstInFirstOut(t *testing.T) {
+	app, ctx := createTestApp(false)
+	addr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	addr2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	// Add bond with an exit fee that decreases over a holding period
+	bond := getValidBond()
+	bond.MinExitFeePercentage = sdk.ZeroDec()
+	bond.ExitFeeHoldingPeriod = sdk.NewUint(100)
+	app.BondsKeeper.SetBond(ctx, token, bond)
+
+	// Mint 30 tokens to addr1 at height 10 and 20 tokens at height 20
+	mint := func(ctx sdk.Context, amount int64) {
+		tokens := sdk.NewCoins(sdk.NewInt64Coin(token, amount))
+		require.Nil(t, app.SupplyKeeper.MintCoins(ctx, types.BondsMintBurnAccount, tokens))
+		require.Nil(t, app.SupplyKeeper.SendCoinsFromModuleToAccount(
+			ctx, types.BondsMintBurnAccount, addr1, tokens))
+	}
+	mint(ctx.WithBlockHeight(10), 30)
+	mint(ctx.WithBlockHeight(20), 20)
+	require.Equal(t, []types.Lot{
+		types.NewLot(10, sdk.NewInt(30)),
+		types.NewLot(20, sdk.NewInt(20)),
+	}, app.BondsKeeper.GetHolderLots(ctx, token, addr1).Lots)
+
+	// addr1 sends 40 tokens to addr2 at height 30, oldest tokens first
+	require.Nil(t, app.BankKeeper.SendCoins(ctx.WithBlockHeight(30), addr1, addr2,
+		sdk.NewCoins(sdk.NewInt64Coin(token, 40))))
+	require.Equal(t, []types.Lot{
+		types.NewLot(20, sdk.NewInt(10)),
+	}, app.BondsKeeper.GetHolderLots(ctx, token, addr1).Lots)
+	require.Equal(t, []types.Lot{
+		types.NewLot(30, sdk.NewInt(40)),
+	}, app.BondsKeeper.GetHolderLots(ctx, token, addr2).Lots)
+
+	// Tokens received by addr2 count as just acquired at height 30
+	exitFeePercentage := app.BondsKeeper.GetSellExitFeePercentage(
+		ctx.WithBlockHeight(30), token, addr2, sdk.NewInt(40))
+	require.Equal(t, bond.ExitFeePercentage, exitFeePercentage)
+
+	// addr1's remaining tokens were held for 80 of the 100 blocks at height 100
+	exitFeePercentage = app.BondsKeeper.GetSellExitFeePercentage(
+		ctx.WithBlockHeight(100), token, addr1, sdk.NewInt(10))
+	require.Equal(t, bond.ExitFeePercentage.QuoInt64(5), exitFeePercentage)
+
+	// Lots are deleted once addr1's balance is burned
+	tokens := sdk.NewCoins(sdk.NewInt64Coin(token, 10))
+	require.Nil(t, app.SupplyKeeper.SendCoinsFromAccountToModule(
+		ctx, addr1, types.BondsMintBurnAccount, tokens))
+	require.Nil(t, app.SupplyKeeper.BurnCoins(ctx, types.BondsMintBurnAccount, tokens))
+	require.Empty(t, app.BondsKeeper.GetHolderLots(ctx, token, addr1).Lots)
+
+	// Only addr2's lots remain
+	iterator := app.BondsKeeper.GetAllHolderLotsIterator(ctx)
+	require.True(t, iterator.Valid())
+	require.Equal(t, addr2, app.BondsKeeper.MustGetHolderLotsByKey(ctx, iterator.Key()).Address)
+	iterator.Next()
+	require.False(t, iterator.Valid())
+}
+
+func TestHolderLotsNotTrackedWithoutHoldingPeriod(t *testing.T) {
+	app, ctx := createTestApp(false)
+	holder := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	// Add bond without a holding period
+	bond := getValidBond()
+	app.BondsKeeper.SetBond(ctx, token, bond)
+
+	// Mint tokens to holder
+	tokens := sdk.NewCoins(sdk.NewInt64Coin(token, 10))
+	require.Nil(t, app.SupplyKeeper.MintCoins(ctx, types.BondsMintBurnAccount, tokens))
+	require.Nil(t, app.SupplyKeeper.SendCoinsFromModuleToAccount(
+		ctx, types.BondsMintBurnAccount, holder, tokens))
+
+	// No lots are tracked and the exit fee percentage is flat
+	require.Empty(t, app.BondsKeeper.GetHolderLots(ctx, token, holder).Lots)
+	require.Equal(t, bond.ExitFeePercentage, app.BondsKeeper.GetSellExitFeePercentage(
+		ctx, token, holder, sdk.NewInt(10)))
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixoworld/bonds/x/bonds/internal/types"
+	"sort"
+	"strings"
+)
+
+// GetAccountPositions returns the address' positions in the bonds whose
+// tokens it holds or in whose current batch it has orders, sorted by token
+func (k Keeper) GetAccountPositions(ctx sdk.Context, address sdk.AccAddress) (positions []types.AccountPosition) {
+	balances := k.CoinKeeper.GetCoins(ctx, address)
+
+	var tokens []string
+	for _, balance := range balances {
+		if k.BondExists(ctx, balance.Denom) {
+			tokens = append(tokens, balance.Denom)
+		}
+	}
+	for _, token := range k.GetBondTokensByOrderAddress(ctx, address) {
+		if balances.AmountOf(token).IsZero() && k.BatchExists(ctx, token) &&
+			k.MustGetBatch(ctx, token).GetOrdersByAddress(address).NumberOfOrders() > 0 {
+			tokens = append(tokens, token)
+		}
+	}
+	sort.Strings(tokens)
+
+	for _, token := range tokens {
+		positions = append(positions, k.getAccountPosition(
+			ctx, token, address, sdk.NewCoin(token, balances.AmountOf(token))))
+	}
+	return positions
+}
+
+func (k Keeper) getAccountPosition(ctx sdk.Context, token string,
+	address sdk.AccAddress, balance sdk.Coin) types.AccountPosition {
+	bond := k.MustGetBond(ctx, token)
+	position := types.AccountPosition{Token: token, Balance: balance}
+
+	// Current price is left empty if it cannot be calculated, e.g. for a
+	// swapper function bond that has no reserve yet
+	reserveBalances := k.GetReserveBalances(ctx, token)
+	if reservePrices, err := bond.GetCurrentPricesPT(reserveBalances); err == nil {
+		position.CurrentPrice = types.RoundReservePrices(reservePrices)
+	}
+
+	// Returns are those of selling the whole balance, with the exit fee that
+	// the address would be charged based on how long it held the tokens
+	if strings.ToLower(bond.AllowSells) == types.TRUE && balance.IsPositive() {
+		exitFeePercentage := k.GetSellExitFeePercentage(
+			ctx, token, address, balance.Amount)
+
+		reserveReturns := bond.GetReturnsForBurn(balance.Amount, reserveBalances)
+		reserveReturnsRounded := types.RoundReserveReturns(reserveReturns)
+
+		txFees := bond.GetTxFees(reserveReturns)
+		exitFees := bond.GetExitFeesAtPercentage(reserveReturns, exitFeePercentage)
+		totalFees := types.AdjustFees(txFees.Add(exitFees), reserveReturnsRounded)
+
+		position.Returns = reserveReturnsRounded
+		position.TxFees = txFees
+		position.ExitFees = exitFees
+		position.TotalReturns = reserveReturnsRounded.Sub(totalFees)
+		position.TotalFees = totalFees
+	}
+
+	if k.BatchExists(ctx, token) {
+		position.PendingOrders = k.MustGetBatch(ctx, token).GetOrdersByAddress(address)
+	}
+
+	return position
+}
@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixoworld/bonds/x/bonds/internal/types"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestGetAccountPositions(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	// Bond with supply 10 (y = 12x^2 + 100) and a reserve of 5000
+	bond1 := getValidBondWithToken(token1)
+	bond1.CurrentSupply = sdk.NewInt64Coin(token1, 10)
+	app.BondsKeeper.SetBond(ctx, token1, bond1)
+	require.NoError(t, setReserve(app, ctx, token1,
+		sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 5000))))
+
+	// Bond in which the account only has a pending buy
+	bond2 := getValidBondWithToken(token2)
+	app.BondsKeeper.SetBond(ctx, token2, bond2)
+	app.BondsKeeper.SetBatch(ctx, token2, types.NewBatch(token2, sdk.OneUint()))
+	bo := types.NewBuyOrder(initCreator, sdk.NewInt64Coin(token2, 5),
+		sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 1000)), nil)
+	app.BondsKeeper.AddBuyOrder(ctx, token2, bo, nil, nil)
+
+	// Bond that does not allow sells
+	bond3 := getValidBondWithToken(token3)
+	bond3.CurrentSupply = sdk.NewInt64Coin(token3, 10)
+	bond3.AllowSells = types.FALSE
+	app.BondsKeeper.SetBond(ctx, token3, bond3)
+
+	// Account holds bond tokens and a token that is not a bond token
+	_, err := app.BondsKeeper.CoinKeeper.AddCoins(ctx, initCreator, sdk.NewCoins(
+		sdk.NewInt64Coin(token1, 4), sdk.NewInt64Coin(token3, 2),
+		sdk.NewInt64Coin(reserveToken, 100)))
+	require.Nil(t, err)
+
+	positions := app.BondsKeeper.GetAccountPositions(ctx, initCreator)
+	require.Len(t, positions, 3)
+
+	// Selling 4 burns from 10 to 6 for 5000 - (4*6^3 + 100*6) = 3536
+	reserveReturns := sdk.NewDecCoins(sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 3536)))
+	txFees := bond1.GetTxFees(reserveReturns)
+	exitFees := bond1.GetExitFees(reserveReturns)
+	require.Equal(t, types.AccountPosition{
+		Token:         token1,
+		Balance:       sdk.NewInt64Coin(token1, 4),
+		CurrentPrice:  sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 1300)),
+		Returns:       sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 3536)),
+		TxFees:        txFees,
+		ExitFees:      exitFees,
+		TotalReturns:  sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 3536)).Sub(txFees.Add(exitFees)),
+		TotalFees:     txFees.Add(exitFees),
+		PendingOrders: types.BatchOrders{},
+	}, positions[0])
+
+	// Position with no balance only includes the price and pending orders
+	require.Equal(t, types.AccountPosition{
+		Token:         token2,
+		Balance:       sdk.NewInt64Coin(token2, 0),
+		CurrentPrice:  sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 100)),
+		PendingOrders: types.BatchOrders{Buys: []types.BuyOrder{bo}},
+	}, positions[1])
+
+	// Position in bond that does not allow sells has no returns
+	require.Equal(t, sdk.NewInt64Coin(token3, 2), positions[2].Balance)
+	require.Nil(t, positions[2].Returns)
+	require.Nil(t, positions[2].TotalReturns)
+
+	// Account with no positions
+	require.Nil(t, app.BondsKeeper.GetAccountPositions(ctx, buyerAddress))
+}
@@ -7,20 +7,43 @@ import (
 	"github.com/ixoworld/bonds/x/bonds/client"
 	"github.com/ixoworld/bonds/x/bonds/internal/types"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"strconv"
 	"strings"
 )
 
 const (
-	QueryBonds          = "bonds"
-	QueryBond           = "bond"
-	QueryBatch          = "batch"
-	QueryLastBatch      = "last_batch"
-	QueryCurrentPrice   = "current_price"
-	QueryCurrentReserve = "current_reserve"
-	QueryCustomPrice    = "custom_price"
-	QueryBuyPrice       = "buy_price"
-	QuerySellReturn     = "sell_return"
-	QuerySwapReturn     = "swap_return"
+	QueryBonds               = "bonds"
+	QueryBondsDetailed       = "bonds_detailed"
+	QueryBondsByCreator      = "bonds_by_creator"
+	QueryBondsBySigner       = "bonds_by_signer"
+	QueryBondsByReserveDenom = "bonds_by_reserve_denom"
+	QueryBond                = "bond"
+	QueryBatch               = "batch"
+	QueryLastBatch           = "last_batch"
+	QueryOrderBook           = "order_book"
+	QueryOrdersByAddress     = "orders_by_address"
+	QueryAccountPositions    = "account_positions"
+	QueryCurrentPrice        = "current_price"
+	QueryCurrentReserve      = "current_reserve"
+	QueryReserveSurplus      = "reserve_surplus"
+	QueryClaimableRewards    = "claimable_rewards"
+	QueryTap                 = "tap"
+	QueryReserveStaking      = "reserve_staking"
+	QueryRoundingSurplus     = "rounding_surplus"
+	QueryReferrals           = "referrals"
+	QueryBondStats           = "bond_stats"
+	QueryCustomPrice         = "custom_price"
+	QueryPriceCurve          = "price_curve"
+	QueryCandles             = "candles"
+	QueryTwap                = "twap"
+	QueryPriceObservations   = "price_observations"
+	QueryBuyPrice            = "buy_price"
+	QuerySellReturn          = "sell_return"
+	QuerySwapReturn          = "swap_return"
+	QuerySwapInput           = "swap_input"
+	QueryQuoteBuy            = "quote_buy"
+	QueryQuoteSell           = "quote_sell"
+	QueryParams              = "params"
 )
 
 // NewQuerier is the module level router for state queries
@@ -29,24 +52,68 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 		switch path[0] {
 		case QueryBonds:
 			return queryBonds(ctx, keeper)
+		case QueryBondsDetailed:
+			return queryBondsDetailed(ctx, req, keeper)
+		case QueryBondsByCreator:
+			return queryBondsByCreator(ctx, path[1:], keeper)
+		case QueryBondsBySigner:
+			return queryBondsBySigner(ctx, path[1:], keeper)
+		case QueryBondsByReserveDenom:
+			return queryBondsByReserveDenom(ctx, path[1:], keeper)
 		case QueryBond:
 			return queryBond(ctx, path[1:], keeper)
 		case QueryBatch:
 			return queryBatch(ctx, path[1:], keeper)
 		case QueryLastBatch:
 			return queryLastBatch(ctx, path[1:], keeper)
+		case QueryOrderBook:
+			return queryOrderBook(ctx, path[1:], keeper)
+		case QueryOrdersByAddress:
+			return queryOrdersByAddress(ctx, path[1:], keeper)
+		case QueryAccountPositions:
+			return queryAccountPositions(ctx, path[1:], keeper)
 		case QueryCurrentPrice:
 			return queryCurrentPrice(ctx, path[1:], keeper)
 		case QueryCurrentReserve:
 			return queryCurrentReserve(ctx, path[1:], keeper)
+		case QueryReserveSurplus:
+			return queryReserveSurplus(ctx, path[1:], keeper)
+		case QueryClaimableRewards:
+			return queryClaimableRewards(ctx, path[1:], keeper)
+		case QueryTap:
+			return queryTap(ctx, path[1:], keeper)
+		case QueryReserveStaking:
+			return queryReserveStaking(ctx, path[1:], keeper)
+		case QueryRoundingSurplus:
+			return queryRoundingSurplus(ctx, path[1:], keeper)
+		case QueryReferrals:
+			return queryReferrals(ctx, path[1:], keeper)
+		case QueryBondStats:
+			return queryBondStats(ctx, path[1:], keeper)
 		case QueryCustomPrice:
 			return queryCustomPrice(ctx, path[1:], keeper)
+		case QueryPriceCurve:
+			return queryPriceCurve(ctx, path[1:], keeper)
+		case QueryCandles:
+			return queryCandles(ctx, path[1:], req, keeper)
+		case QueryTwap:
+			return queryTwap(ctx, path[1:], keeper)
+		case QueryPriceObservations:
+			return queryPriceObservations(ctx, path[1:], keeper)
 		case QueryBuyPrice:
 			return queryBuyPrice(ctx, path[1:], keeper)
 		case QuerySellReturn:
 			return querySellReturn(ctx, path[1:], keeper)
 		case QuerySwapReturn:
 			return querySwapReturn(ctx, path[1:], keeper)
+		case QuerySwapInput:
+			return querySwapInput(ctx, path[1:], keeper)
+		case QueryQuoteBuy:
+			return queryQuoteBuy(ctx, path[1:], keeper)
+		case QueryQuoteSell:
+			return queryQuoteSell(ctx, path[1:], keeper)
+		case QueryParams:
+			return queryParams(ctx, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown bonds query endpoint")
 		}
@@ -70,6 +137,107 @@ func queryBonds(ctx sdk.Context, keeper Keeper) (res []byte, err sdk.Error) {
 	return bz, nil
 }
 
+func queryBondsDetailed(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	params := types.NewQueryBondsDetailedParams(sdk.ZeroUint(), sdk.ZeroUint(), "", "", nil, "", "", "")
+	if len(req.Data) != 0 {
+		if err2 := keeper.cdc.UnmarshalJSON(req.Data, &params); err2 != nil {
+			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("failed to parse params: %s", err2))
+		}
+	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Count all matching bonds, but only add details for those in the page
+	result := types.QueryBondsDetailed{Total: sdk.ZeroUint()}
+	iterator := keeper.GetBondIterator(ctx)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var bond types.Bond
+		keeper.cdc.MustUnmarshalBinaryBare(iterator.Value(), &bond)
+		if !params.Matches(bond) {
+			continue
+		}
+
+		index := result.Total
+		result.Total = result.Total.Add(sdk.OneUint())
+		if index.LT(params.Offset) {
+			continue
+		} else if index.GTE(params.Offset.Add(params.Limit)) {
+			continue
+		}
+
+		// Current price is left empty if it cannot be calculated, e.g. for
+		// a swapper function bond that has no reserve yet
+		reserveBalances := keeper.GetReserveBalances(ctx, bond.Token)
+		var currentPrice sdk.Coins
+		if reservePrices, err := bond.GetCurrentPricesPT(reserveBalances); err == nil {
+			currentPrice = types.RoundReservePrices(reservePrices)
+		}
+
+		result.Bonds = append(result.Bonds, types.BondDetails{
+			Token:           bond.Token,
+			FunctionType:    bond.FunctionType,
+			ReserveTokens:   bond.ReserveTokens,
+			CurrentSupply:   bond.CurrentSupply,
+			CurrentPrice:    currentPrice,
+			ReserveBalances: reserveBalances,
+			AllowSells:      bond.AllowSells,
+			Paused:          bond.Paused,
+			Dissolved:       bond.Dissolved,
+		})
+	}
+
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, result)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+func queryBondsByCreator(ctx sdk.Context, path []string, keeper Keeper) (res []byte, err sdk.Error) {
+	creator, err2 := sdk.AccAddressFromBech32(path[0])
+	if err2 != nil {
+		return nil, sdk.ErrInvalidAddress(err2.Error())
+	}
+
+	bondsList := types.QueryBonds(keeper.GetBondTokensByCreator(ctx, creator))
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, bondsList)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+func queryBondsBySigner(ctx sdk.Context, path []string, keeper Keeper) (res []byte, err sdk.Error) {
+	signer, err2 := sdk.AccAddressFromBech32(path[0])
+	if err2 != nil {
+		return nil, sdk.ErrInvalidAddress(err2.Error())
+	}
+
+	bondsList := types.QueryBonds(keeper.GetBondTokensBySigner(ctx, signer))
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, bondsList)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+func queryBondsByReserveDenom(ctx sdk.Context, path []string, keeper Keeper) (res []byte, err sdk.Error) {
+	reserveDenom := path[0]
+
+	bondsList := types.QueryBonds(keeper.GetBondTokensByReserveDenom(ctx, reserveDenom))
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, bondsList)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
 func queryBond(ctx sdk.Context, path []string, keeper Keeper) (res []byte, err sdk.Error) {
 	bondToken := path[0]
 
@@ -120,6 +288,55 @@ func queryLastBatch(ctx sdk.Context, path []string, keeper Keeper) (res []byte,
 	return bz, nil
 }
 
+func queryOrderBook(ctx sdk.Context, path []string, keeper Keeper) (res []byte, err sdk.Error) {
+	bondToken := path[0]
+
+	if !keeper.BatchExists(ctx, bondToken) {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("batch for '%s' does not exist", bondToken))
+	}
+
+	orderBook := types.NewOrderBook(keeper.MustGetBatch(ctx, bondToken))
+
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, orderBook)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+func queryOrdersByAddress(ctx sdk.Context, path []string, keeper Keeper) (res []byte, err sdk.Error) {
+	address, err2 := sdk.AccAddressFromBech32(path[0])
+	if err2 != nil {
+		return nil, sdk.ErrInvalidAddress(err2.Error())
+	}
+
+	orders := types.QueryOrdersByAddress(keeper.GetOrdersByAddress(ctx, address))
+
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, orders)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+func queryAccountPositions(ctx sdk.Context, path []string, keeper Keeper) (res []byte, err sdk.Error) {
+	address, err2 := sdk.AccAddressFromBech32(path[0])
+	if err2 != nil {
+		return nil, sdk.ErrInvalidAddress(err2.Error())
+	}
+
+	positions := types.QueryAccountPositions(keeper.GetAccountPositions(ctx, address))
+
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, positions)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
 func queryCurrentPrice(ctx sdk.Context, path []string, keeper Keeper) (res []byte, err sdk.Error) {
 	bondToken := path[0]
 
@@ -146,13 +363,170 @@ func queryCurrentPrice(ctx sdk.Context, path []string, keeper Keeper) (res []byt
 func queryCurrentReserve(ctx sdk.Context, path []string, keeper Keeper) (res []byte, err sdk.Error) {
 	bondToken := path[0]
 
+	if !keeper.BondExists(ctx, bondToken) {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("bond '%s' does not exist", bondToken))
+	}
+
+	reserveBalances := keeper.GetReserveBalances(ctx, bondToken)
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, reserveBalances)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+func queryReserveSurplus(ctx sdk.Context, path []string, keeper Keeper) (res []byte, err sdk.Error) {
+	bondToken := path[0]
+
+	if !keeper.BondExists(ctx, bondToken) {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("bond '%s' does not exist", bondToken))
+	}
+
+	reserveSurplus := keeper.GetReserveSurplus(ctx, bondToken)
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, reserveSurplus)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+func queryClaimableRewards(ctx sdk.Context, path []string, keeper Keeper) (res []byte, err sdk.Error) {
+	bondToken := path[0]
+	holderAddress := path[1]
+
+	address, err2 := sdk.AccAddressFromBech32(holderAddress)
+	if err2 != nil {
+		return nil, sdk.ErrInvalidAddress(err2.Error())
+	}
+
+	if !keeper.BondExists(ctx, bondToken) {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("bond '%s' does not exist", bondToken))
+	}
+
+	claimableRewards := keeper.GetClaimableRewards(ctx, bondToken, address)
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, claimableRewards)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+func queryTap(ctx sdk.Context, path []string, keeper Keeper) (res []byte, err sdk.Error) {
+	bondToken := path[0]
+
 	bond, found := keeper.GetBond(ctx, bondToken)
 	if !found {
 		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("bond '%s' does not exist", bondToken))
 	}
 
-	reserveBalances := keeper.CoinKeeper.GetCoins(ctx, bond.ReserveAddress)
-	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, reserveBalances)
+	var floor sdk.Coins
+	accrued := sdk.ZeroInt()
+	if bond.HasTap() {
+		floor = bond.GetNewReserveCoins(bond.GetTapFloor())
+		accrued = bond.Tap.GetAccrued(ctx.BlockHeight())
+	}
+
+	tap := types.QueryTap{
+		Tap:             bond.Tap,
+		Dissolved:       bond.Dissolved,
+		Accrued:         accrued,
+		Floor:           floor,
+		Available:       keeper.GetTapAvailable(ctx, bondToken),
+		RaiseVotes:      keeper.GetTapVoteTally(ctx, bondToken, types.TapVoteRaise),
+		DissolveVotes:   keeper.GetTapVoteTally(ctx, bondToken, types.TapVoteDissolve),
+		TotalBondTokens: keeper.GetTotalBondTokens(ctx, bondToken),
+	}
+
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, tap)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+func queryReserveStaking(ctx sdk.Context, path []string, keeper Keeper) (res []byte, err sdk.Error) {
+	bondToken := path[0]
+
+	bond, found := keeper.GetBond(ctx, bondToken)
+	if !found {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("bond '%s' does not exist", bondToken))
+	}
+
+	reserveStaking := types.QueryReserveStaking{Staking: bond.Staking}
+	if bond.HasReserveStaking() {
+		denom := getStakingDenom(bond)
+		reserveStaking.Cap = sdk.NewCoins(sdk.NewCoin(denom, bond.GetStakingCap()))
+		reserveStaking.Liquid = sdk.NewCoins(sdk.NewCoin(denom, keeper.GetLiquidReserve(ctx, bondToken)))
+		reserveStaking.Delegated = sdk.NewCoins(sdk.NewCoin(denom, keeper.GetDelegatedReserve(ctx, bondToken)))
+		reserveStaking.Unbonding = sdk.NewCoins(sdk.NewCoin(denom, keeper.GetUnbondingReserve(ctx, bondToken)))
+		reserveStaking.QueuedTotal = bond.Staking.GetQueuedTotal()
+		reserveStaking.Delegations = keeper.GetReserveDelegations(ctx, bondToken)
+	}
+
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, reserveStaking)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+func queryRoundingSurplus(ctx sdk.Context, path []string, keeper Keeper) (res []byte, err sdk.Error) {
+	bondToken := path[0]
+
+	bond, found := keeper.GetBond(ctx, bondToken)
+	if !found {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("bond '%s' does not exist", bondToken))
+	}
+
+	roundingSurplus := types.QueryRoundingSurplus{
+		Surplus:   bond.RoundingSurplus,
+		Sweepable: keeper.GetSweepableRoundingSurplus(ctx, bondToken),
+	}
+
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, roundingSurplus)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+func queryReferrals(ctx sdk.Context, path []string, keeper Keeper) (res []byte, err sdk.Error) {
+	bondToken := path[0]
+
+	bond, found := keeper.GetBond(ctx, bondToken)
+	if !found {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("bond '%s' does not exist", bondToken))
+	}
+
+	referrals := types.QueryReferrals{
+		ReferralFeePercentage: bond.ReferralFeePercentage,
+		ReferrerTotals:        keeper.GetReferrerTotals(ctx, bondToken),
+	}
+
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, referrals)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+func queryBondStats(ctx sdk.Context, path []string, keeper Keeper) (res []byte, err sdk.Error) {
+	bondToken := path[0]
+
+	if !keeper.BondExists(ctx, bondToken) {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("bond '%s' does not exist", bondToken))
+	}
+
+	bondStats := keeper.GetBondStats(ctx, bondToken)
+
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, bondStats)
 	if err2 != nil {
 		panic("could not marshal result to JSON")
 	}
@@ -187,6 +561,130 @@ func queryCustomPrice(ctx sdk.Context, path []string, keeper Keeper) (res []byte
 	return bz, nil
 }
 
+func queryPriceCurve(ctx sdk.Context, path []string, keeper Keeper) (res []byte, err sdk.Error) {
+	bondToken := path[0]
+
+	from, ok := sdk.NewIntFromString(path[1])
+	if !ok {
+		return nil, types.ErrArgumentMissingOrNonInteger(types.DefaultCodespace, "from supply")
+	}
+	to, ok := sdk.NewIntFromString(path[2])
+	if !ok {
+		return nil, types.ErrArgumentMissingOrNonInteger(types.DefaultCodespace, "to supply")
+	}
+	samples, err2 := strconv.Atoi(path[3])
+	if err2 != nil {
+		return nil, types.ErrArgumentMissingOrNonInteger(types.DefaultCodespace, "samples")
+	}
+
+	bond, found := keeper.GetBond(ctx, bondToken)
+	if !found {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("bond '%s' does not exist", bondToken))
+	}
+
+	points, err := bond.GetPriceCurve(from, to, samples)
+	if err != nil {
+		return nil, err
+	}
+
+	priceCurve := types.QueryPriceCurve{
+		ReserveTokens: bond.ReserveTokens,
+		Points:        points,
+	}
+
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, priceCurve)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+func queryCandles(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	bondToken := path[0]
+
+	params := types.NewQueryCandlesParams(sdk.ZeroUint(), sdk.ZeroUint(), sdk.ZeroUint())
+	if len(req.Data) != 0 {
+		if err2 := keeper.cdc.UnmarshalJSON(req.Data, &params); err2 != nil {
+			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("failed to parse params: %s", err2))
+		}
+	}
+
+	if !keeper.BondExists(ctx, bondToken) {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("bond '%s' does not exist", bondToken))
+	}
+
+	candles := keeper.GetCandles(ctx, bondToken)
+	if !params.IntervalBlocks.IsZero() {
+		candles = types.RollUpCandles(candles, params.IntervalBlocks)
+	}
+
+	// Page through the candles from newest to oldest
+	result := types.QueryCandles{Total: sdk.NewUint(uint64(len(candles)))}
+	for i := len(candles) - 1; i >= 0; i-- {
+		index := sdk.NewUint(uint64(len(candles) - 1 - i))
+		if index.LT(params.Offset) {
+			continue
+		} else if !params.Limit.IsZero() && index.GTE(params.Offset.Add(params.Limit)) {
+			break
+		}
+		result.Candles = append(result.Candles, candles[i])
+	}
+
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, result)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+func queryTwap(ctx sdk.Context, path []string, keeper Keeper) (res []byte, err sdk.Error) {
+	bondToken := path[0]
+
+	fromHeight, err2 := strconv.ParseInt(path[1], 10, 64)
+	if err2 != nil {
+		return nil, types.ErrArgumentMissingOrNonInteger(types.DefaultCodespace, "from height")
+	}
+	toHeight, err2 := strconv.ParseInt(path[2], 10, 64)
+	if err2 != nil {
+		return nil, types.ErrArgumentMissingOrNonInteger(types.DefaultCodespace, "to height")
+	}
+
+	if !keeper.BondExists(ctx, bondToken) {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("bond '%s' does not exist", bondToken))
+	}
+
+	twap, err := keeper.GetTwap(ctx, bondToken, fromHeight, toHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, twap)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+func queryPriceObservations(ctx sdk.Context, path []string, keeper Keeper) (res []byte, err sdk.Error) {
+	bondToken := path[0]
+
+	if !keeper.BondExists(ctx, bondToken) {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("bond '%s' does not exist", bondToken))
+	}
+
+	observations := types.QueryPriceObservations(keeper.GetPriceObservations(ctx, bondToken))
+
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, observations)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
 func queryBuyPrice(ctx sdk.Context, path []string, keeper Keeper) (res []byte, err sdk.Error) {
 	bondToken := path[0]
 	bondAmount := path[1]
@@ -254,12 +752,25 @@ func querySellReturn(ctx sdk.Context, path []string, keeper Keeper) (res []byte,
 		return nil, types.ErrCannotBurnMoreThanSupply(types.DefaultCodespace)
 	}
 
+	// If a seller address is specified, the exit fee depends on how long the
+	// seller has held the tokens being sold, otherwise these are treated as
+	// having just been acquired
+	exitFeePercentage := bond.ExitFeePercentage
+	if len(path) > 2 {
+		seller, err2 := sdk.AccAddressFromBech32(path[2])
+		if err2 != nil {
+			return nil, sdk.ErrInvalidAddress(err2.Error())
+		}
+		exitFeePercentage = keeper.GetSellExitFeePercentage(
+			ctx, bondToken, seller, bondCoin.Amount)
+	}
+
 	reserveBalances := keeper.GetReserveBalances(ctx, bondToken)
 	reserveReturns := bond.GetReturnsForBurn(bondCoin.Amount, reserveBalances)
 	reserveReturnsRounded := types.RoundReserveReturns(reserveReturns)
 
 	txFees := bond.GetTxFees(reserveReturns)
-	exitFees := bond.GetExitFees(reserveReturns)
+	exitFees := bond.GetExitFeesAtPercentage(reserveReturns, exitFeePercentage)
 	totalFees := types.AdjustFees(txFees.Add(exitFees), reserveReturnsRounded)
 
 	var result types.QuerySellReturn
@@ -278,6 +789,158 @@ func querySellReturn(ctx sdk.Context, path []string, keeper Keeper) (res []byte,
 	return bz, nil
 }
 
+func queryQuoteBuy(ctx sdk.Context, path []string, keeper Keeper) (res []byte, err sdk.Error) {
+	bondToken := path[0]
+	bondAmount := path[1]
+
+	bondCoin, err2 := client.ParseCoin(bondAmount, bondToken)
+	if err2 != nil {
+		return nil, sdk.ErrInternal(err2.Error())
+	}
+
+	bond, found := keeper.GetBond(ctx, bondToken)
+	if !found {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("bond '%s' does not exist", bondToken))
+	}
+
+	// Orders cannot be added to dissolved, paused or halted bonds
+	if bond.IsDissolved() {
+		return nil, types.ErrBondIsDissolved(types.DefaultCodespace, bondToken)
+	} else if bond.IsPaused() {
+		return nil, types.ErrBondIsPaused(types.DefaultCodespace, bondToken)
+	} else if bond.IsHalted() {
+		return nil, types.ErrBondIsHalted(types.DefaultCodespace, bondToken, bond.HaltBlocksRemaining)
+	}
+
+	// Max supply cannot be less than supply (max supply >= supply)
+	adjustedSupply := keeper.GetSupplyAdjustedForBuy(ctx, bondToken)
+	if bond.MaxSupply.IsLT(adjustedSupply.Add(bondCoin)) {
+		return nil, types.ErrCannotMintMoreThanMaxSupply(types.DefaultCodespace)
+	}
+
+	// Simulate buy by bumping up total buy amount, which (as when the buy is
+	// added) matches the buy against any pending sells in the batch
+	batch := keeper.MustGetBatch(ctx, bondToken)
+	buyPricesBefore, sellPricesBefore, err := keeper.GetBatchBuySellPrices(ctx, bondToken, batch)
+	if err != nil {
+		return nil, err
+	}
+	batch.TotalBuyAmount = batch.TotalBuyAmount.Add(bondCoin)
+	buyPrices, sellPrices, err := keeper.GetBatchBuySellPrices(ctx, bondToken, batch)
+	if err != nil {
+		return nil, err
+	}
+
+	// Prices and fees as charged when the batch is performed
+	reservePrices := types.MultiplyDecCoinsByInt(buyPrices, bondCoin.Amount)
+	reservePricesRounded := types.RoundReservePrices(reservePrices)
+	txFees := bond.GetTxFees(reservePrices)
+
+	var result types.QueryBuyQuote
+	result.AdjustedSupply = adjustedSupply
+	result.BuyPrices = buyPrices
+	result.SellPrices = sellPrices
+	result.Prices = reservePricesRounded
+	result.TxFees = txFees
+	result.TotalFees = txFees
+	result.TotalPrices = reservePricesRounded.Add(txFees)
+	result.PriceImpacts = types.NewPriceImpacts(
+		buyPricesBefore, sellPricesBefore, buyPrices, sellPrices)
+
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, result)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+func queryQuoteSell(ctx sdk.Context, path []string, keeper Keeper) (res []byte, err sdk.Error) {
+	bondToken := path[0]
+	bondAmount := path[1]
+
+	bondCoin, err2 := client.ParseCoin(bondAmount, bondToken)
+	if err2 != nil {
+		return nil, sdk.ErrInternal(err2.Error())
+	}
+
+	bond, found := keeper.GetBond(ctx, bondToken)
+	if !found {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("bond '%s' does not exist", bondToken))
+	}
+
+	// Orders cannot be added to dissolved, paused or halted bonds
+	if bond.IsDissolved() {
+		return nil, types.ErrBondIsDissolved(types.DefaultCodespace, bondToken)
+	} else if bond.IsPaused() {
+		return nil, types.ErrBondIsPaused(types.DefaultCodespace, bondToken)
+	} else if bond.IsHalted() {
+		return nil, types.ErrBondIsHalted(types.DefaultCodespace, bondToken, bond.HaltBlocksRemaining)
+	}
+
+	if strings.ToLower(bond.AllowSells) == types.FALSE {
+		return nil, types.ErrBondDoesNotAllowSelling(types.DefaultCodespace)
+	}
+
+	// Cannot burn more tokens than what exists
+	adjustedSupply := keeper.GetSupplyAdjustedForSell(ctx, bondToken)
+	if adjustedSupply.IsLT(bondCoin) {
+		return nil, types.ErrCannotBurnMoreThanSupply(types.DefaultCodespace)
+	}
+
+	// If a seller address is specified, the exit fee depends on how long the
+	// seller has held the tokens being sold, otherwise these are treated as
+	// having just been acquired
+	exitFeePercentage := bond.ExitFeePercentage
+	if len(path) > 2 {
+		seller, err2 := sdk.AccAddressFromBech32(path[2])
+		if err2 != nil {
+			return nil, sdk.ErrInvalidAddress(err2.Error())
+		}
+		exitFeePercentage = keeper.GetSellExitFeePercentage(
+			ctx, bondToken, seller, bondCoin.Amount)
+	}
+
+	// Simulate sell by bumping up total sell amount, which (as when the sell
+	// is added) matches the sell against any pending buys in the batch
+	batch := keeper.MustGetBatch(ctx, bondToken)
+	buyPricesBefore, sellPricesBefore, err := keeper.GetBatchBuySellPrices(ctx, bondToken, batch)
+	if err != nil {
+		return nil, err
+	}
+	batch.TotalSellAmount = batch.TotalSellAmount.Add(bondCoin)
+	buyPrices, sellPrices, err := keeper.GetBatchBuySellPrices(ctx, bondToken, batch)
+	if err != nil {
+		return nil, err
+	}
+
+	// Returns and fees as paid out when the batch is performed
+	reserveReturns := types.MultiplyDecCoinsByInt(sellPrices, bondCoin.Amount)
+	reserveReturnsRounded := types.RoundReserveReturns(reserveReturns)
+	txFees := bond.GetTxFees(reserveReturns)
+	exitFees := bond.GetExitFeesAtPercentage(reserveReturns, exitFeePercentage)
+	totalFees := types.AdjustFees(txFees.Add(exitFees), reserveReturnsRounded)
+
+	var result types.QuerySellQuote
+	result.AdjustedSupply = adjustedSupply
+	result.BuyPrices = buyPrices
+	result.SellPrices = sellPrices
+	result.Returns = reserveReturnsRounded
+	result.TxFees = txFees
+	result.ExitFees = exitFees
+	result.TotalReturns = reserveReturnsRounded.Sub(totalFees)
+	result.TotalFees = totalFees
+	result.PriceImpacts = types.NewPriceImpacts(
+		buyPricesBefore, sellPricesBefore, buyPrices, sellPrices)
+
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, result)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
 func querySwapReturn(ctx sdk.Context, path []string, keeper Keeper) (res []byte, err sdk.Error) {
 	bondToken := path[0]
 	fromToken := path[1]
@@ -294,17 +957,119 @@ func querySwapReturn(ctx sdk.Context, path []string, keeper Keeper) (res []byte,
 		return nil, types.ErrBondDoesNotExist(types.DefaultCodespace, bondToken)
 	}
 
+	result, err := getSwapQuote(ctx, keeper, bond, fromCoin, toToken)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, result)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+func querySwapInput(ctx sdk.Context, path []string, keeper Keeper) (res []byte, err sdk.Error) {
+	bondToken := path[0]
+	toToken := path[1]
+	toAmount := path[2]
+	fromToken := path[3]
+
+	toCoin, err2 := client.ParseCoin(toAmount, toToken)
+	if err2 != nil {
+		return nil, sdk.ErrInternal(err2.Error())
+	}
+
+	bond, found := keeper.GetBond(ctx, bondToken)
+	if !found {
+		return nil, types.ErrBondDoesNotExist(types.DefaultCodespace, bondToken)
+	}
+
+	// Quote for the smallest from amount that returns the wanted to amount
 	reserveBalances := keeper.GetReserveBalances(ctx, bondToken)
-	reserveReturns, txFee, err := bond.GetReturnsForSwap(fromCoin, toToken, reserveBalances)
+	fromCoin, err := bond.GetInputForSwap(fromToken, toCoin, reserveBalances)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := getSwapQuote(ctx, keeper, bond, fromCoin, toCoin.Denom)
 	if err != nil {
 		return nil, err
 	}
 
-	var result types.QuerySwapReturn
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, result)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+func getSwapQuote(ctx sdk.Context, keeper Keeper, bond types.Bond,
+	fromCoin sdk.Coin, toToken string) (result types.QuerySwapReturn, err sdk.Error) {
+	if !fromCoin.Amount.IsPositive() {
+		return types.QuerySwapReturn{}, types.ErrArgumentMustBePositive(types.DefaultCodespace, "from amount")
+	}
+
+	// No rates can be quoted while either reserve is empty, e.g. before the
+	// swapper function bond's first buy
+	reserveBalances := keeper.GetReserveBalances(ctx, bond.Token)
+	if bond.FunctionType == types.SwapperFunction {
+		for _, denom := range []string{fromCoin.Denom, toToken} {
+			if bond.ReserveDenomsContain(denom) && reserveBalances.AmountOf(denom).IsZero() {
+				return types.QuerySwapReturn{}, types.ErrSwapReserveIsEmpty(types.DefaultCodespace, bond.Token, denom)
+			}
+		}
+	}
+
+	reserveReturns, txFee, err := bond.GetReturnsForSwap(fromCoin, toToken, reserveBalances)
+	if err != nil {
+		return types.QuerySwapReturn{}, err
+	}
+
+	// New reserves are calculated in the same way as when the swap is
+	// performed, with the liquidity fee (if any) kept in the reserve
+	liquidityFee := bond.GetLiquidityFee(txFee)
+	reserveInput := fromCoin.Sub(txFee).Add(liquidityFee)
+	newReserveBalances := reserveBalances.Add(sdk.Coins{reserveInput}).Sub(reserveReturns)
+
+	// The spot rate can still be truncated to zero if the from reserve is
+	// far larger than the to reserve, in which case no price impact can be
+	// calculated relative to it
+	spotRate := sdk.NewDecFromInt(reserveBalances.AmountOf(toToken)).QuoInt(
+		reserveBalances.AmountOf(fromCoin.Denom))
+	if spotRate.IsZero() {
+		return types.QuerySwapReturn{}, types.ErrSwapSpotRateIsZero(types.DefaultCodespace, fromCoin.Denom, toToken)
+	}
+	effectiveRate := sdk.NewDecFromInt(reserveReturns.AmountOf(toToken)).QuoInt(
+		fromCoin.Amount)
+
+	var swapsAhead []types.SwapOrder
+	if keeper.BatchExists(ctx, bond.Token) {
+		for _, s := range keeper.MustGetBatch(ctx, bond.Token).Swaps {
+			if !s.IsCancelled() {
+				swapsAhead = append(swapsAhead, s)
+			}
+		}
+	}
+
+	result.FromAmount = fromCoin
 	result.TotalFees = sdk.Coins{txFee}
 	result.TotalReturns = reserveReturns
+	result.SpotRate = spotRate
+	result.EffectiveRate = effectiveRate
+	result.PriceImpact = effectiveRate.Sub(spotRate).Quo(spotRate).MulInt64(100)
+	result.ViolatesSanityRate = bond.ReservesViolateSanityRate(newReserveBalances)
+	result.SwapsAhead = swapsAhead
 
-	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, result)
+	return result, nil
+}
+
+func queryParams(ctx sdk.Context, keeper Keeper) (res []byte, err sdk.Error) {
+	params := keeper.GetParams(ctx)
+
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, params)
 	if err2 != nil {
 		panic("could not marshal result to JSON")
 	}
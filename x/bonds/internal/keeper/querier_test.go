@@ -6,6 +6,7 @@ import (
 	"github.com/ixoworld/bonds/x/bonds/internal/types"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"strconv"
 	"testing"
 )
 
@@ -45,6 +46,134 @@ func TestQueryBonds(t *testing.T) {
 	require.Equal(t, queryResult, types.QueryBonds{token})
 }
 
+func TestQueryBondsDetailed(t *testing.T) {
+	app, ctx := createTestApp(false)
+	querier := keeper.NewQuerier(app.BondsKeeper)
+	var queryResult types.QueryBondsDetailed
+
+	queryWithParams := func(params types.QueryBondsDetailedParams) types.QueryBondsDetailed {
+		req := abci.RequestQuery{Data: types.ModuleCdc.MustMarshalJSON(params)}
+		res, err := querier(ctx, []string{keeper.QueryBondsDetailed}, req)
+		require.NoError(t, err)
+		var result types.QueryBondsDetailed
+		types.ModuleCdc.MustUnmarshalJSON(res, &result)
+		return result
+	}
+	noFilters := types.NewQueryBondsDetailedParams(
+		sdk.ZeroUint(), sdk.ZeroUint(), "", "", nil, "", "", "")
+
+	// Initially no errors and zero bonds, even without params
+	res, err := querier(ctx, []string{keeper.QueryBondsDetailed}, abci.RequestQuery{})
+	require.NoError(t, err)
+	types.ModuleCdc.MustUnmarshalJSON(res, &queryResult)
+	require.True(t, queryResult.Total.IsZero())
+	require.Empty(t, queryResult.Bonds)
+
+	// Add two power function bonds, the second of which is paused, and a
+	// swapper function bond with no reserve (so no current price)
+	app.BondsKeeper.SetBond(ctx, token1, getValidBondWithToken(token1))
+	bond2 := getValidBondWithToken(token2)
+	bond2.Paused = types.TRUE
+	app.BondsKeeper.SetBond(ctx, token2, bond2)
+	bond3 := getValidSwapperBond()
+	bond3.Token = token3
+	bond3.MaxSupply = sdk.NewCoin(token3, bond3.MaxSupply.Amount)
+	app.BondsKeeper.SetBond(ctx, token3, bond3)
+
+	// All bonds are listed in order, with their details
+	queryResult = queryWithParams(noFilters)
+	require.Equal(t, sdk.NewUint(3), queryResult.Total)
+	require.Len(t, queryResult.Bonds, 3)
+	require.Equal(t, token1, queryResult.Bonds[0].Token)
+	require.Equal(t, types.PowerFunction, queryResult.Bonds[0].FunctionType)
+	require.Equal(t, powerReserves, queryResult.Bonds[0].ReserveTokens)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 100)), queryResult.Bonds[0].CurrentPrice)
+	require.Equal(t, types.TRUE, queryResult.Bonds[1].Paused)
+	require.Equal(t, token3, queryResult.Bonds[2].Token)
+	require.True(t, queryResult.Bonds[2].CurrentPrice.Empty())
+
+	// Paginated
+	params := noFilters
+	params.Offset = sdk.OneUint()
+	params.Limit = sdk.OneUint()
+	queryResult = queryWithParams(params)
+	require.Equal(t, sdk.NewUint(3), queryResult.Total)
+	require.Len(t, queryResult.Bonds, 1)
+	require.Equal(t, token2, queryResult.Bonds[0].Token)
+
+	// Filtered by function type and by paused flag
+	params = noFilters
+	params.FunctionType = types.PowerFunction
+	params.Paused = types.FALSE
+	queryResult = queryWithParams(params)
+	require.Equal(t, sdk.OneUint(), queryResult.Total)
+	require.Equal(t, token1, queryResult.Bonds[0].Token)
+
+	// Filtered by reserve token
+	params = noFilters
+	params.ReserveToken = reserveToken2
+	queryResult = queryWithParams(params)
+	require.Equal(t, sdk.OneUint(), queryResult.Total)
+	require.Equal(t, token3, queryResult.Bonds[0].Token)
+
+	// Error if params are invalid
+	params = noFilters
+	params.Dissolved = "maybe"
+	req := abci.RequestQuery{Data: types.ModuleCdc.MustMarshalJSON(params)}
+	_, err = querier(ctx, []string{keeper.QueryBondsDetailed}, req)
+	require.Error(t, err)
+
+	// Error if limit exceeds the max limit
+	params = noFilters
+	params.Limit = sdk.NewUint(types.MaxBondsDetailedLimit + 1)
+	req = abci.RequestQuery{Data: types.ModuleCdc.MustMarshalJSON(params)}
+	_, err = querier(ctx, []string{keeper.QueryBondsDetailed}, req)
+	require.Error(t, err)
+
+	// A zero limit returns at most the default limit of bonds, but counts
+	// all matching bonds in the total
+	for i := 0; i < types.DefaultBondsDetailedLimit; i++ {
+		extraToken := "extra" + strconv.Itoa(i)
+		bond := getValidBondWithToken(extraToken)
+		app.BondsKeeper.SetBond(ctx, extraToken, bond)
+	}
+	queryResult = queryWithParams(noFilters)
+	require.Equal(t, sdk.NewUint(types.DefaultBondsDetailedLimit+3), queryResult.Total)
+	require.Len(t, queryResult.Bonds, types.DefaultBondsDetailedLimit)
+}
+
+func TestQueryBondsByIndexes(t *testing.T) {
+	app, ctx := createTestApp(false)
+	querier := keeper.NewQuerier(app.BondsKeeper)
+	req := abci.RequestQuery{}
+	var queryResult types.QueryBonds
+
+	// Add two bonds with the same creator, one with another reserve token
+	app.BondsKeeper.SetBond(ctx, token1, getValidBondWithToken(token1))
+	bond2 := getValidBondWithToken(token2)
+	bond2.ReserveTokens = []string{reserveToken2}
+	app.BondsKeeper.SetBond(ctx, token2, bond2)
+
+	res, err := querier(ctx, []string{keeper.QueryBondsByCreator, initCreator.String()}, req)
+	require.NoError(t, err)
+	types.ModuleCdc.MustUnmarshalJSON(res, &queryResult)
+	require.Equal(t, types.QueryBonds{token1, token2}, queryResult)
+
+	res, err = querier(ctx, []string{keeper.QueryBondsBySigner, initCreator.String()}, req)
+	require.NoError(t, err)
+	types.ModuleCdc.MustUnmarshalJSON(res, &queryResult)
+	require.Equal(t, types.QueryBonds{token1, token2}, queryResult)
+
+	res, err = querier(ctx, []string{keeper.QueryBondsByReserveDenom, reserveToken2}, req)
+	require.NoError(t, err)
+	types.ModuleCdc.MustUnmarshalJSON(res, &queryResult)
+	require.Equal(t, types.QueryBonds{token2}, queryResult)
+
+	// Error if address is invalid
+	_, err = querier(ctx, []string{keeper.QueryBondsByCreator, "invalid"}, req)
+	require.Error(t, err)
+}
+
 func TestQueryBond(t *testing.T) {
 	app, ctx := createTestApp(false)
 	querier := keeper.NewQuerier(app.BondsKeeper)
@@ -114,6 +243,69 @@ func TestQueryLastBatch(t *testing.T) {
 	require.Equal(t, queryResult, batch)
 }
 
+func TestQueryOrderBookAndOrdersByAddress(t *testing.T) {
+	app, ctx := createTestApp(false)
+	querier := keeper.NewQuerier(app.BondsKeeper)
+	req := abci.RequestQuery{}
+
+	app.BondsKeeper.SetBond(ctx, token, getValidBond())
+	app.BondsKeeper.SetBatch(ctx, token, types.NewBatch(token, sdk.OneUint()))
+	bo := types.NewBuyOrder(initCreator, sdk.NewInt64Coin(token, 10),
+		sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 1000)), nil)
+	app.BondsKeeper.AddBuyOrder(ctx, token, bo, nil, nil)
+
+	// Order book of the current batch
+	var orderBook types.OrderBook
+	res, err := querier(ctx, []string{keeper.QueryOrderBook, token}, req)
+	require.NoError(t, err)
+	types.ModuleCdc.MustUnmarshalJSON(res, &orderBook)
+	require.Equal(t, types.NewOrderBook(app.BondsKeeper.MustGetBatch(ctx, token)), orderBook)
+	require.Equal(t, sdk.NewInt64Coin(token, 10), orderBook.TotalBuyAmount)
+
+	// Orders by address
+	var orders types.QueryOrdersByAddress
+	res, err = querier(ctx, []string{keeper.QueryOrdersByAddress, initCreator.String()}, req)
+	require.NoError(t, err)
+	types.ModuleCdc.MustUnmarshalJSON(res, &orders)
+	require.Len(t, orders, 1)
+	require.Equal(t, token, orders[0].Token)
+	require.Len(t, orders[0].Pending.Buys, 1)
+	require.Equal(t, 0, orders[0].Settled.NumberOfOrders())
+
+	// Error if batch does not exist or address is invalid
+	_, err = querier(ctx, []string{keeper.QueryOrderBook, "invalid"}, req)
+	require.Error(t, err)
+	_, err = querier(ctx, []string{keeper.QueryOrdersByAddress, "invalid"}, req)
+	require.Error(t, err)
+}
+
+func TestQueryAccountPositions(t *testing.T) {
+	app, ctx := createTestApp(false)
+	querier := keeper.NewQuerier(app.BondsKeeper)
+	req := abci.RequestQuery{}
+
+	bond := getValidBond()
+	bond.CurrentSupply = sdk.NewInt64Coin(token, 10)
+	app.BondsKeeper.SetBond(ctx, token, bond)
+	require.NoError(t, setReserve(app, ctx, token,
+		sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 5000))))
+	_, err := app.BondsKeeper.CoinKeeper.AddCoins(ctx, initCreator,
+		sdk.NewCoins(sdk.NewInt64Coin(token, 4)))
+	require.Nil(t, err)
+
+	var positions types.QueryAccountPositions
+	res, err := querier(ctx, []string{keeper.QueryAccountPositions, initCreator.String()}, req)
+	require.NoError(t, err)
+	types.ModuleCdc.MustUnmarshalJSON(res, &positions)
+	require.Len(t, positions, 1)
+	require.Equal(t, types.QueryAccountPositions(
+		app.BondsKeeper.GetAccountPositions(ctx, initCreator)), positions)
+
+	// Error if address is invalid
+	_, err = querier(ctx, []string{keeper.QueryAccountPositions, "invalid"}, req)
+	require.Error(t, err)
+}
+
 func TestQueryCurrentPrice(t *testing.T) {
 	app, ctx := createTestApp(false)
 	querier := keeper.NewQuerier(app.BondsKeeper)
@@ -204,7 +396,7 @@ func TestQueryCurrentPriceForSwapper(t *testing.T) {
 		sdk.NewInt64Coin(reserveToken, 200),
 		sdk.NewInt64Coin(reserveToken2, 300),
 	)
-	_, _ = app.BankKeeper.AddCoins(ctx, bond.ReserveAddress, newReserve)
+	_ = addToReserve(app, ctx, bond.Token, newReserve)
 
 	// Get current price directly
 	reserveBalances = app.BondsKeeper.GetReserveBalances(ctx, token)
@@ -255,7 +447,7 @@ func TestQueryCurrentReserve(t *testing.T) {
 		sdk.NewInt64Coin(reserveToken, 200),
 		sdk.NewInt64Coin(reserveToken2, 300),
 	)
-	_, _ = app.BankKeeper.AddCoins(ctx, bond.ReserveAddress, newReserve)
+	_ = addToReserve(app, ctx, bond.Token, newReserve)
 
 	// Get current reserve (now 200token2,300token3)
 	reserveBalances := app.BondsKeeper.GetReserveBalances(ctx, token)
@@ -269,6 +461,41 @@ func TestQueryCurrentReserve(t *testing.T) {
 	require.Equal(t, queryResult, newReserve)
 }
 
+func TestQueryReserveSurplus(t *testing.T) {
+	app, ctx := createTestApp(false)
+	querier := keeper.NewQuerier(app.BondsKeeper)
+	req := abci.RequestQuery{}
+	var queryResult sdk.Coins
+
+	// Initially error since no bond
+	res, err := querier(ctx, []string{keeper.QueryReserveSurplus, token}, req)
+	require.Error(t, err)
+	require.Nil(t, res)
+
+	// Add bond with reserve
+	bond := getValidBond()
+	app.BondsKeeper.SetBond(ctx, token, bond)
+	_ = addToReserve(app, ctx, bond.Token, sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 200)))
+
+	// Check that surplus is initially empty
+	res, err = querier(ctx, []string{keeper.QueryReserveSurplus, token}, req)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	types.ModuleCdc.MustUnmarshalJSON(res, &queryResult)
+	require.Nil(t, queryResult)
+
+	// Send 50res directly to reserve address
+	surplus := sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 50))
+	_, _ = app.BankKeeper.AddCoins(ctx, bond.ReserveAddress, surplus)
+
+	// Check that surplus is now 50res
+	res, err = querier(ctx, []string{keeper.QueryReserveSurplus, token}, req)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	types.ModuleCdc.MustUnmarshalJSON(res, &queryResult)
+	require.Equal(t, surplus, queryResult)
+}
+
 func TestQueryCustomPrice(t *testing.T) {
 	app, ctx := createTestApp(false)
 	querier := keeper.NewQuerier(app.BondsKeeper)
@@ -325,6 +552,127 @@ func TestQueryCustomPrice(t *testing.T) {
 	require.Equal(t, queryResult, manualPrices)
 }
 
+func TestQueryPriceCurve(t *testing.T) {
+	app, ctx := createTestApp(false)
+	querier := keeper.NewQuerier(app.BondsKeeper)
+	req := abci.RequestQuery{}
+	var queryResult types.QueryPriceCurve
+
+	app.BondsKeeper.SetBond(ctx, token, getValidBond())
+
+	// Five samples from 0 to 100 (y = 12x^2 + 100)
+	res, err := querier(ctx, []string{keeper.QueryPriceCurve, token, "0", "100", "5"}, req)
+	require.NoError(t, err)
+	types.ModuleCdc.MustUnmarshalJSON(res, &queryResult)
+	require.Equal(t, powerReserves, queryResult.ReserveTokens)
+	require.Len(t, queryResult.Points, 5)
+	require.Equal(t, sdk.NewInt(25), queryResult.Points[1].Supply)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 7600))),
+		queryResult.Points[1].Prices)
+
+	// Error if samples exceed the max
+	_, err = querier(ctx, []string{keeper.QueryPriceCurve, token, "0", "100",
+		strconv.Itoa(types.MaxPriceCurveSamples + 1)}, req)
+	require.Error(t, err)
+
+	// Error if supply is not an integer
+	_, err = querier(ctx, []string{keeper.QueryPriceCurve, token, "0", "1.5", "5"}, req)
+	require.Error(t, err)
+
+	// Error if bond does not exist
+	_, err = querier(ctx, []string{keeper.QueryPriceCurve, "invalid", "0", "100", "5"}, req)
+	require.Error(t, err)
+}
+
+func TestQueryCandles(t *testing.T) {
+	app, ctx := createTestApp(false)
+	querier := keeper.NewQuerier(app.BondsKeeper)
+	req := abci.RequestQuery{}
+	var queryResult types.QueryCandles
+
+	app.BondsKeeper.SetBond(ctx, token, getValidBond())
+	c0 := newTestCandle(0, 10)
+	c10 := newTestCandle(10, 20)
+	c20 := newTestCandle(20, 30)
+	for _, c := range []types.Candle{c0, c10, c20} {
+		app.BondsKeeper.AddCandle(ctx, c)
+	}
+
+	// All candles, from newest to oldest
+	res, err := querier(ctx, []string{keeper.QueryCandles, token}, req)
+	require.NoError(t, err)
+	types.ModuleCdc.MustUnmarshalJSON(res, &queryResult)
+	require.Equal(t, sdk.NewUint(3), queryResult.Total)
+	require.Equal(t, []types.Candle{c20, c10, c0}, queryResult.Candles)
+
+	// Second page of one candle
+	queryResult = types.QueryCandles{}
+	req.Data = types.ModuleCdc.MustMarshalJSON(types.NewQueryCandlesParams(
+		sdk.OneUint(), sdk.OneUint(), sdk.ZeroUint()))
+	res, err = querier(ctx, []string{keeper.QueryCandles, token}, req)
+	require.NoError(t, err)
+	types.ModuleCdc.MustUnmarshalJSON(res, &queryResult)
+	require.Equal(t, sdk.NewUint(3), queryResult.Total)
+	require.Equal(t, []types.Candle{c10}, queryResult.Candles)
+
+	// Candles rolled up into 20-block intervals
+	queryResult = types.QueryCandles{}
+	req.Data = types.ModuleCdc.MustMarshalJSON(types.NewQueryCandlesParams(
+		sdk.ZeroUint(), sdk.ZeroUint(), sdk.NewUint(20)))
+	res, err = querier(ctx, []string{keeper.QueryCandles, token}, req)
+	require.NoError(t, err)
+	types.ModuleCdc.MustUnmarshalJSON(res, &queryResult)
+	require.Equal(t, sdk.NewUint(2), queryResult.Total)
+	require.Equal(t, []types.Candle{c20, c0.Merge(c10)}, queryResult.Candles)
+
+	// Error if bond does not exist
+	_, err = querier(ctx, []string{keeper.QueryCandles, "invalid"}, req)
+	require.Error(t, err)
+}
+
+func TestQueryTwapAndPriceObservations(t *testing.T) {
+	app, ctx := createTestApp(false)
+	querier := keeper.NewQuerier(app.BondsKeeper)
+	req := abci.RequestQuery{}
+
+	// Prices of 100 from height 10 and 1300 from height 20 (y = 12x^2 + 100)
+	bond := getValidBond()
+	app.BondsKeeper.SetBond(ctx, token, bond)
+	app.BondsKeeper.RecordPriceObservation(ctx.WithBlockHeight(10), token)
+	bond.CurrentSupply = sdk.NewInt64Coin(token, 10)
+	app.BondsKeeper.SetBond(ctx, token, bond)
+	app.BondsKeeper.RecordPriceObservation(ctx.WithBlockHeight(20), token)
+	ctx = ctx.WithBlockHeight(30)
+
+	var observations types.QueryPriceObservations
+	res, err := querier(ctx, []string{keeper.QueryPriceObservations, token}, req)
+	require.NoError(t, err)
+	types.ModuleCdc.MustUnmarshalJSON(res, &observations)
+	require.Equal(t, types.QueryPriceObservations(
+		app.BondsKeeper.GetPriceObservations(ctx, token)), observations)
+	require.Len(t, observations, 2)
+
+	// TWAP: (100*10 + 1300*10) / 20
+	var twap types.Twap
+	res, err = querier(ctx, []string{keeper.QueryTwap, token, "10", "30"}, req)
+	require.NoError(t, err)
+	types.ModuleCdc.MustUnmarshalJSON(res, &twap)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewCoins(
+		sdk.NewInt64Coin(reserveToken, 700))), twap.Prices)
+
+	// Error if heights are invalid
+	_, err = querier(ctx, []string{keeper.QueryTwap, token, "10", "x"}, req)
+	require.Error(t, err)
+	_, err = querier(ctx, []string{keeper.QueryTwap, token, "10", "40"}, req)
+	require.Error(t, err)
+
+	// Error if bond does not exist
+	_, err = querier(ctx, []string{keeper.QueryTwap, "invalid", "10", "30"}, req)
+	require.Error(t, err)
+	_, err = querier(ctx, []string{keeper.QueryPriceObservations, "invalid"}, req)
+	require.Error(t, err)
+}
+
 func TestQueryBuyPrice(t *testing.T) {
 	app, ctx := createTestApp(false)
 	querier := keeper.NewQuerier(app.BondsKeeper)
@@ -376,7 +724,7 @@ func TestQueryBuyPrice(t *testing.T) {
 	require.Equal(t, queryResult.TotalPrices, roundedTotalPrices)
 
 	// Simulate the above buy taking place
-	_, _ = app.BankKeeper.AddCoins(ctx, bond.ReserveAddress, queryResult.Prices)
+	_ = addToReserve(app, ctx, bond.Token, queryResult.Prices)
 	_, _ = app.BankKeeper.AddCoins(ctx, bond.FeeAddress, queryResult.TotalFees)
 	app.BondsKeeper.SetCurrentSupply(ctx, token, sdk.NewCoin(token, buyAmount))
 
@@ -412,6 +760,41 @@ func TestQueryBuyPrice(t *testing.T) {
 	require.Equal(t, queryResult.TotalPrices, roundedTotalPrices)
 }
 
+func TestQueryBuyPriceAppliesFeeSchedule(t *testing.T) {
+	app, ctx := createTestApp(false)
+	querier := keeper.NewQuerier(app.BondsKeeper)
+	req := abci.RequestQuery{}
+	var queryResult types.QueryBuyPrice
+
+	// Add bond with a 1% tx fee, halved for orders of at least 1000res
+	bond := getValidBond()
+	bond.TxFeePercentage = sdk.NewDec(1)
+	bond.FeeSchedule = types.NewFeeSchedule(types.FeeTiers{
+		types.NewFeeTier(sdk.NewDec(1000), sdk.MustNewDecFromStr("0.5"))},
+		nil, sdk.ZeroUint())
+	app.BondsKeeper.SetBond(ctx, token, bond)
+	app.BondsKeeper.SetBatch(ctx, token, getValidBatch())
+
+	// Buying 1 token costs 4+100=104res, so the full 1% fee applies (2res
+	// after rounding up), whereas buying 10 tokens costs 5000res, so only
+	// half of the 1% fee applies (25res)
+	testCases := []struct {
+		amount      string
+		expectedFee int64
+	}{
+		{"1", 2},
+		{"10", 25},
+	}
+	for _, tc := range testCases {
+		res, err := querier(ctx,
+			[]string{keeper.QueryBuyPrice, token, tc.amount}, req)
+		require.NoError(t, err)
+		types.ModuleCdc.MustUnmarshalJSON(res, &queryResult)
+		expectedFees := sdk.Coins{sdk.NewInt64Coin(reserveToken, tc.expectedFee)}
+		require.Equal(t, expectedFees, queryResult.TxFees)
+	}
+}
+
 func TestQuerySellPrice(t *testing.T) {
 	app, ctx := createTestApp(false)
 	querier := keeper.NewQuerier(app.BondsKeeper)
@@ -446,7 +829,7 @@ func TestQuerySellPrice(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 	types.ModuleCdc.MustUnmarshalJSON(res, &buyQueryResult)
-	_, _ = app.BankKeeper.AddCoins(ctx, bond.ReserveAddress, buyQueryResult.Prices)
+	_ = addToReserve(app, ctx, bond.Token, buyQueryResult.Prices)
 	_, _ = app.BankKeeper.AddCoins(ctx, bond.FeeAddress, buyQueryResult.TotalFees)
 	app.BondsKeeper.SetCurrentSupply(ctx, token, sdk.NewCoin(token, buyAmount))
 
@@ -484,6 +867,304 @@ func TestQuerySellPrice(t *testing.T) {
 	require.Equal(t, queryResult.TotalReturns, roundedTotalReturns)
 }
 
+func TestQuerySellReturnForSellerAppliesHoldingPeriod(t *testing.T) {
+	app, ctx := createTestApp(false)
+	querier := keeper.NewQuerier(app.BondsKeeper)
+	req := abci.RequestQuery{}
+	var queryResult types.QuerySellReturn
+
+	// Add bond with a 10% exit fee that decreases to 0% over 100 blocks
+	bond := getValidBond()
+	bond.TxFeePercentage = sdk.ZeroDec()
+	bond.ExitFeePercentage = sdk.NewDec(10)
+	bond.MinExitFeePercentage = sdk.ZeroDec()
+	bond.ExitFeeHoldingPeriod = sdk.NewUint(100)
+	app.BondsKeeper.SetBond(ctx, token, bond)
+	app.BondsKeeper.SetBatch(ctx, token, getValidBatch())
+
+	// Seller acquires 10 tokens at height 0, backed by a reserve of 5000res
+	tokens := sdk.NewCoins(sdk.NewInt64Coin(token, 10))
+	require.Nil(t, app.SupplyKeeper.MintCoins(ctx, types.BondsMintBurnAccount, tokens))
+	require.Nil(t, app.SupplyKeeper.SendCoinsFromModuleToAccount(
+		ctx, types.BondsMintBurnAccount, sellerAddress, tokens))
+	app.BondsKeeper.SetCurrentSupply(ctx, token, tokens[0])
+	_ = addToReserve(app, ctx, token, sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 5000)))
+
+	// At height 50, the seller's exit fee is 5% of 5000res, whereas without
+	// a seller the full 10% exit fee of 5000res is reported
+	ctx = ctx.WithBlockHeight(50)
+	testCases := []struct {
+		path        []string
+		expectedFee int64
+	}{
+		{[]string{keeper.QuerySellReturn, token, "10", sellerAddress.String()}, 250},
+		{[]string{keeper.QuerySellReturn, token, "10"}, 500},
+	}
+	for _, tc := range testCases {
+		res, err := querier(ctx, tc.path, req)
+		require.NoError(t, err)
+		types.ModuleCdc.MustUnmarshalJSON(res, &queryResult)
+		expectedFees := sdk.Coins{sdk.NewInt64Coin(reserveToken, tc.expectedFee)}
+		require.Equal(t, expectedFees, queryResult.ExitFees)
+	}
+
+	// Error if seller address is invalid
+	_, err := querier(ctx,
+		[]string{keeper.QuerySellReturn, token, "10", "invalid"}, req)
+	require.Error(t, err)
+}
+
+func TestQueryQuoteBuyAndSell(t *testing.T) {
+	app, ctx := createTestApp(false)
+	querier := keeper.NewQuerier(app.BondsKeeper)
+	req := abci.RequestQuery{}
+	decPrices := func(price int64) sdk.DecCoins {
+		return sdk.NewDecCoins(sdk.NewCoins(sdk.NewInt64Coin(reserveToken, price)))
+	}
+
+	// Bond with supply 10 (y = 12x^2 + 100) and a pending sell of 5 tokens,
+	// so buys pay the current price (1300) and sells get 800 per token
+	bond := getValidBond()
+	bond.CurrentSupply = sdk.NewInt64Coin(token, 10)
+	app.BondsKeeper.SetBond(ctx, token, bond)
+	require.NoError(t, setReserve(app, ctx, token,
+		sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 5000))))
+	batch := types.NewBatch(token, sdk.OneUint())
+	batch.TotalSellAmount = sdk.NewInt64Coin(token, 5)
+	app.BondsKeeper.SetBatch(ctx, token, batch)
+
+	// Buy of 10 matches the 5 sells at 1300 and mints 5 more for 10000
+	var buyQuote types.QueryBuyQuote
+	res, err := querier(ctx, []string{keeper.QueryQuoteBuy, token, "10"}, req)
+	require.NoError(t, err)
+	types.ModuleCdc.MustUnmarshalJSON(res, &buyQuote)
+	reservePrices := decPrices(16500)
+	txFees := bond.GetTxFees(reservePrices)
+	require.Equal(t, decPrices(1650), buyQuote.BuyPrices)
+	require.Equal(t, decPrices(1300), buyQuote.SellPrices)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 16500)), buyQuote.Prices)
+	require.Equal(t, txFees, buyQuote.TxFees)
+	require.Equal(t, txFees, buyQuote.TotalFees)
+	require.Equal(t, buyQuote.Prices.Add(txFees), buyQuote.TotalPrices)
+
+	// Other buys pay more and other sells get more (800 -> 1300)
+	require.Equal(t, []types.PriceImpact{{
+		Denom:     reserveToken,
+		BuyPrice:  sdk.NewDec(350).Quo(sdk.NewDec(1300)).MulInt64(100),
+		SellPrice: sdk.MustNewDecFromStr("62.5"),
+	}}, buyQuote.PriceImpacts)
+
+	// Sell of 5 burns 10 in total for 5000, i.e. 500 per token
+	var sellQuote types.QuerySellQuote
+	res, err = querier(ctx, []string{keeper.QueryQuoteSell, token, "5"}, req)
+	require.NoError(t, err)
+	types.ModuleCdc.MustUnmarshalJSON(res, &sellQuote)
+	reserveReturns := decPrices(2500)
+	txFees = bond.GetTxFees(reserveReturns)
+	exitFees := bond.GetExitFeesAtPercentage(reserveReturns, bond.ExitFeePercentage)
+	require.Equal(t, decPrices(1300), sellQuote.BuyPrices)
+	require.Equal(t, decPrices(500), sellQuote.SellPrices)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 2500)), sellQuote.Returns)
+	require.Equal(t, txFees, sellQuote.TxFees)
+	require.Equal(t, exitFees, sellQuote.ExitFees)
+	require.Equal(t, txFees.Add(exitFees), sellQuote.TotalFees)
+	require.Equal(t, sellQuote.Returns.Sub(sellQuote.TotalFees), sellQuote.TotalReturns)
+
+	// Other sells get less (800 -> 500) and buys are unaffected
+	require.Equal(t, []types.PriceImpact{{
+		Denom:     reserveToken,
+		BuyPrice:  sdk.ZeroDec(),
+		SellPrice: sdk.MustNewDecFromStr("-37.5"),
+	}}, sellQuote.PriceImpacts)
+
+	// Error if buying more than max supply or selling more than supply
+	_, err = querier(ctx, []string{keeper.QueryQuoteBuy, token, "10000"}, req)
+	require.Error(t, err)
+	_, err = querier(ctx, []string{keeper.QueryQuoteSell, token, "6"}, req)
+	require.Error(t, err)
+
+	// Error if bond does not exist
+	_, err = querier(ctx, []string{keeper.QueryQuoteBuy, "invalid", "10"}, req)
+	require.Error(t, err)
+	_, err = querier(ctx, []string{keeper.QueryQuoteSell, "invalid", "5"}, req)
+	require.Error(t, err)
+
+	// Error if bond is paused, halted or dissolved, as when placing an order
+	for _, tc := range []struct {
+		update func(bond *types.Bond)
+		code   sdk.CodeType
+	}{
+		{func(bond *types.Bond) { bond.Paused = types.TRUE }, types.CodeBondPaused},
+		{func(bond *types.Bond) { bond.HaltBlocksRemaining = sdk.OneUint() }, types.CodeBondHalted},
+		{func(bond *types.Bond) { bond.Dissolved = types.TRUE }, types.CodeBondDissolved},
+	} {
+		updated := bond
+		tc.update(&updated)
+		app.BondsKeeper.SetBond(ctx, token, updated)
+		_, err = querier(ctx, []string{keeper.QueryQuoteBuy, token, "10"}, req)
+		require.Equal(t, tc.code, err.Code())
+		_, err = querier(ctx, []string{keeper.QueryQuoteSell, token, "5"}, req)
+		require.Equal(t, tc.code, err.Code())
+	}
+}
+
+func TestQueryTap(t *testing.T) {
+	app, ctx := createTestApp(false)
+	querier := keeper.NewQuerier(app.BondsKeeper)
+	req := abci.RequestQuery{}
+	var queryResult types.QueryTap
+
+	// Add bond with supply 10 and reserve 5000res, with a tap of 100 per
+	// block that cannot withdraw below 50% of the curve integral (2500)
+	bond := getValidBond()
+	bond.CurrentSupply = sdk.NewInt64Coin(token, 10)
+	tap := types.NewTap(sellerAddress, sdk.NewInt(100), sdk.NewDec(50), 0)
+	bond.Tap = &tap
+	app.BondsKeeper.SetBond(ctx, token, bond)
+	_ = addToReserve(app, ctx, token, sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 5000)))
+
+	// At height 10, the 1000 accrued is available
+	ctx = ctx.WithBlockHeight(10)
+	res, err := querier(ctx, []string{keeper.QueryTap, token}, req)
+	require.NoError(t, err)
+	types.ModuleCdc.MustUnmarshalJSON(res, &queryResult)
+	require.Equal(t, tap.Rate, queryResult.Tap.Rate)
+	require.Equal(t, sdk.NewInt(1000), queryResult.Accrued)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 2500)), queryResult.Floor)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 1000)), queryResult.Available)
+
+	// Error if bond does not exist
+	_, err = querier(ctx, []string{keeper.QueryTap, "invalid"}, req)
+	require.Error(t, err)
+}
+
+func TestQueryReserveStaking(t *testing.T) {
+	app, ctx := createTestApp(false)
+	querier := keeper.NewQuerier(app.BondsKeeper)
+	req := abci.RequestQuery{}
+	var queryResult types.QueryReserveStaking
+	stakingDenom := app.StakingKeeper.BondDenom(ctx)
+
+	// Without reserve staking, only the empty staking config is returned
+	app.BondsKeeper.SetBond(ctx, token, getValidBond())
+	res, err := querier(ctx, []string{keeper.QueryReserveStaking, token}, req)
+	require.NoError(t, err)
+	types.ModuleCdc.MustUnmarshalJSON(res, &queryResult)
+	require.Nil(t, queryResult.Staking)
+	require.True(t, queryResult.Cap.Empty())
+
+	// Add bond with reserve 5000 and a staking cap of 50% (2500)
+	app.BondsKeeper.SetBond(ctx, token, getValidStakingBond(stakingDenom))
+	_ = addToReserve(app, ctx, token, sdk.NewCoins(sdk.NewInt64Coin(stakingDenom, 5000)))
+
+	res, err = querier(ctx, []string{keeper.QueryReserveStaking, token}, req)
+	require.NoError(t, err)
+	types.ModuleCdc.MustUnmarshalJSON(res, &queryResult)
+	require.Equal(t, sdk.NewDec(50), queryResult.Staking.CapPercentage)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(stakingDenom, 2500)), queryResult.Cap)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(stakingDenom, 5000)), queryResult.Liquid)
+	require.True(t, queryResult.Delegated.Empty())
+	require.Empty(t, queryResult.Delegations)
+
+	// Error if bond does not exist
+	_, err = querier(ctx, []string{keeper.QueryReserveStaking, "invalid"}, req)
+	require.Error(t, err)
+}
+
+func TestQueryRoundingSurplus(t *testing.T) {
+	app, ctx := createTestApp(false)
+	querier := keeper.NewQuerier(app.BondsKeeper)
+	req := abci.RequestQuery{}
+	var queryResult types.QueryRoundingSurplus
+
+	// Add bond with supply 10 (curve integral 5000) and reserve 5001
+	bond := getValidBond()
+	bond.CurrentSupply = sdk.NewInt64Coin(token, 10)
+	app.BondsKeeper.SetBond(ctx, token, bond)
+	_ = setReserve(app, ctx, token, sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 5001)))
+
+	// Initially no surplus
+	res, err := querier(ctx, []string{keeper.QueryRoundingSurplus, token}, req)
+	require.NoError(t, err)
+	types.ModuleCdc.MustUnmarshalJSON(res, &queryResult)
+	require.True(t, queryResult.Surplus.IsZero())
+	require.True(t, queryResult.Sweepable.IsZero())
+
+	// Surplus of 1.5 of which 1 is sweepable
+	surplus := sdk.NewDecCoins(sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 3))).
+		QuoDec(sdk.NewDec(2))
+	app.BondsKeeper.AddRoundingSurplus(ctx, token, surplus)
+	res, err = querier(ctx, []string{keeper.QueryRoundingSurplus, token}, req)
+	require.NoError(t, err)
+	types.ModuleCdc.MustUnmarshalJSON(res, &queryResult)
+	require.Equal(t, surplus, queryResult.Surplus)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 1)), queryResult.Sweepable)
+
+	// Error if bond does not exist
+	_, err = querier(ctx, []string{keeper.QueryRoundingSurplus, "invalid"}, req)
+	require.Error(t, err)
+}
+
+func TestQueryReferrals(t *testing.T) {
+	app, ctx := createTestApp(false)
+	querier := keeper.NewQuerier(app.BondsKeeper)
+	req := abci.RequestQuery{}
+	var queryResult types.QueryReferrals
+
+	// Add bond with 50% of tx fees going to referrers
+	bond := getValidBond()
+	bond.ReferralFeePercentage = sdk.NewDec(50)
+	app.BondsKeeper.SetBond(ctx, token, bond)
+
+	// Initially no referrer totals
+	res, err := querier(ctx, []string{keeper.QueryReferrals, token}, req)
+	require.NoError(t, err)
+	types.ModuleCdc.MustUnmarshalJSON(res, &queryResult)
+	require.Equal(t, sdk.NewDec(50), queryResult.ReferralFeePercentage)
+	require.Empty(t, queryResult.ReferrerTotals)
+
+	// Referrer total after one payout of 5
+	referrerTotal := types.NewReferrerTotal(token, buyerAddress).AddPayout(
+		sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 5)))
+	app.BondsKeeper.SetReferrerTotal(ctx, referrerTotal)
+	res, err = querier(ctx, []string{keeper.QueryReferrals, token}, req)
+	require.NoError(t, err)
+	types.ModuleCdc.MustUnmarshalJSON(res, &queryResult)
+	require.Equal(t, []types.ReferrerTotal{referrerTotal}, queryResult.ReferrerTotals)
+
+	// Error if bond does not exist
+	_, err = querier(ctx, []string{keeper.QueryReferrals, "invalid"}, req)
+	require.Error(t, err)
+}
+
+func TestQueryBondStats(t *testing.T) {
+	app, ctx := createTestApp(false)
+	querier := keeper.NewQuerier(app.BondsKeeper)
+	req := abci.RequestQuery{}
+	var queryResult types.BondStats
+
+	app.BondsKeeper.SetBond(ctx, token, getValidBond())
+
+	// Initially no orders performed
+	res, err := querier(ctx, []string{keeper.QueryBondStats, token}, req)
+	require.NoError(t, err)
+	types.ModuleCdc.MustUnmarshalJSON(res, &queryResult)
+	require.Equal(t, types.NewBondStats(token), queryResult)
+
+	// Stats after one buy of 10
+	app.BondsKeeper.RecordBuyStats(ctx, token, buyerAddress, sdk.NewInt(10),
+		sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 2)))
+	res, err = querier(ctx, []string{keeper.QueryBondStats, token}, req)
+	require.NoError(t, err)
+	types.ModuleCdc.MustUnmarshalJSON(res, &queryResult)
+	require.Equal(t, app.BondsKeeper.GetBondStats(ctx, token), queryResult)
+	require.Equal(t, sdk.NewInt(10), queryResult.LargestBuy)
+
+	// Error if bond does not exist
+	_, err = querier(ctx, []string{keeper.QueryBondStats, "invalid"}, req)
+	require.Error(t, err)
+}
+
 func TestQuerySwapReturn(t *testing.T) {
 	app, ctx := createTestApp(false)
 	querier := keeper.NewQuerier(app.BondsKeeper)
@@ -516,7 +1197,7 @@ func TestQuerySwapReturn(t *testing.T) {
 		sdk.NewInt64Coin(reserveToken, 200),
 		sdk.NewInt64Coin(reserveToken2, 300),
 	)
-	_, _ = app.BankKeeper.AddCoins(ctx, bond.ReserveAddress, newReserve)
+	_ = addToReserve(app, ctx, bond.Token, newReserve)
 
 	// Get swap return directly
 	fromCoin := sdk.NewInt64Coin(reserveToken, 100)
@@ -540,4 +1221,131 @@ func TestQuerySwapReturn(t *testing.T) {
 	require.Equal(t, queryResult.TotalReturns, swapReturns)
 	require.Equal(t, queryResult.TotalReturns, manualSwapReturns)
 	require.Equal(t, queryResult.TotalFees, sdk.Coins{txFee})
+
+	// Spot rate is 300/200 = 1.5rez per res, but effective rate is 99/100
+	require.Equal(t, fromCoin, queryResult.FromAmount)
+	require.Equal(t, sdk.MustNewDecFromStr("1.5"), queryResult.SpotRate)
+	require.Equal(t, sdk.MustNewDecFromStr("0.99"), queryResult.EffectiveRate)
+	require.Equal(t, sdk.NewDec(-34), queryResult.PriceImpact)
+	require.False(t, queryResult.ViolatesSanityRate)
+	require.Empty(t, queryResult.SwapsAhead)
+
+	// Sanity rate violated since reserves become 299res,201rez (1.49 > 1.2)
+	bond = app.BondsKeeper.MustGetBond(ctx, token)
+	bond.SanityRate = sdk.OneDec()
+	bond.SanityMarginPercentage = sdk.NewDec(20)
+	app.BondsKeeper.SetBond(ctx, token, bond)
+
+	// Pending swaps in the batch (but not cancelled ones) are ahead
+	pendingSwap := types.NewSwapOrder(swapperAddress, sdk.NewInt64Coin(reserveToken2, 5), reserveToken)
+	cancelledSwap := types.NewSwapOrder(swapperAddress, sdk.NewInt64Coin(reserveToken, 5), reserveToken2)
+	cancelledSwap.Cancelled = types.TRUE
+	batch := types.NewBatch(token, sdk.OneUint())
+	batch.Swaps = []types.SwapOrder{pendingSwap, cancelledSwap}
+	app.BondsKeeper.SetBatch(ctx, token, batch)
+
+	res, err = querier(ctx, []string{keeper.QuerySwapReturn, token,
+		fromCoin.Denom, fromCoin.Amount.String(), toToken}, req)
+	require.NoError(t, err)
+	queryResult = types.QuerySwapReturn{}
+	types.ModuleCdc.MustUnmarshalJSON(res, &queryResult)
+	require.True(t, queryResult.ViolatesSanityRate)
+	require.Equal(t, []types.SwapOrder{pendingSwap}, queryResult.SwapsAhead)
+}
+
+func TestQuerySwapReturnForEmptySwapperBond(t *testing.T) {
+	app, ctx := createTestApp(false)
+	querier := keeper.NewQuerier(app.BondsKeeper)
+	req := abci.RequestQuery{}
+
+	// Add swapper bond with an empty reserve
+	bond := getValidSwapperBond()
+	app.BondsKeeper.SetBond(ctx, token, bond)
+
+	// Error since neither reserve has any tokens to quote a swap with
+	_, err := querier(ctx, []string{keeper.QuerySwapReturn, token,
+		reserveToken, "100", reserveToken2}, req)
+	require.Equal(t, types.CodeSwapQuoteUnavailable, err.Code())
+
+	// Error if only the to reserve is empty
+	require.NoError(t, setReserve(app, ctx, token,
+		sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 200))))
+	_, err = querier(ctx, []string{keeper.QuerySwapReturn, token,
+		reserveToken, "100", reserveToken2}, req)
+	require.Equal(t, types.CodeSwapQuoteUnavailable, err.Code())
+
+	// Error if swapping a zero amount
+	require.NoError(t, setReserve(app, ctx, token, sdk.NewCoins(
+		sdk.NewInt64Coin(reserveToken, 200), sdk.NewInt64Coin(reserveToken2, 300))))
+	_, err = querier(ctx, []string{keeper.QuerySwapReturn, token,
+		reserveToken, "0", reserveToken2}, req)
+	require.Equal(t, types.CodeArgumentInvalid, err.Code())
+
+	// Error if the spot rate (2/10^20 rez per res) is truncated to zero, even
+	// though swapping 10^21res still returns 1rez
+	largeAmount, ok := sdk.NewIntFromString("100000000000000000000")
+	require.True(t, ok)
+	require.NoError(t, setReserve(app, ctx, token, sdk.NewCoins(
+		sdk.NewCoin(reserveToken, largeAmount), sdk.NewInt64Coin(reserveToken2, 2))))
+	_, err = querier(ctx, []string{keeper.QuerySwapReturn, token,
+		reserveToken, largeAmount.MulRaw(10).String(), reserveToken2}, req)
+	require.Equal(t, types.CodeSwapQuoteUnavailable, err.Code())
+}
+
+func TestQuerySwapInput(t *testing.T) {
+	app, ctx := createTestApp(false)
+	querier := keeper.NewQuerier(app.BondsKeeper)
+	req := abci.RequestQuery{}
+	var queryResult types.QuerySwapReturn
+
+	// Add swapper bond with 200res,300rez in reserve
+	bond := getValidSwapperBond()
+	bond.CurrentSupply = sdk.NewInt64Coin(bond.Token, 2)
+	app.BondsKeeper.SetBond(ctx, token, bond)
+	require.NoError(t, setReserve(app, ctx, token, sdk.NewCoins(
+		sdk.NewInt64Coin(reserveToken, 200), sdk.NewInt64Coin(reserveToken2, 300))))
+
+	// Swapping 100res returns 99rez (see TestQuerySwapReturn), and this is the
+	// smallest amount of res that returns 99rez
+	res, err := querier(ctx, []string{keeper.QuerySwapInput, token,
+		reserveToken2, "99", reserveToken}, req)
+	require.NoError(t, err)
+	types.ModuleCdc.MustUnmarshalJSON(res, &queryResult)
+	require.Equal(t, sdk.NewInt64Coin(reserveToken, 100), queryResult.FromAmount)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(reserveToken2, 99)), queryResult.TotalReturns)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 1)), queryResult.TotalFees)
+
+	// Error if wanted amount would deplete the reserve
+	_, err = querier(ctx, []string{keeper.QuerySwapInput, token,
+		reserveToken2, "300", reserveToken}, req)
+	require.Error(t, err)
+
+	// Error if bond does not exist
+	_, err = querier(ctx, []string{keeper.QuerySwapInput, "invalid",
+		reserveToken2, "99", reserveToken}, req)
+	require.Error(t, err)
+}
+
+func TestQueryParams(t *testing.T) {
+	app, ctx := createTestApp(false)
+	querier := keeper.NewQuerier(app.BondsKeeper)
+	req := abci.RequestQuery{}
+	var queryResult types.Params
+
+	// Default params
+	res, err := querier(ctx, []string{keeper.QueryParams}, req)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	types.ModuleCdc.MustUnmarshalJSON(res, &queryResult)
+	require.Equal(t, types.DefaultParams().String(), queryResult.String())
+
+	// Updated params
+	params := types.DefaultParams()
+	params.MaxOrdersPerBatch = 5
+	app.BondsKeeper.SetParams(ctx, params)
+
+	res, err = querier(ctx, []string{keeper.QueryParams}, req)
+	require.NoError(t, err)
+	types.ModuleCdc.MustUnmarshalJSON(res, &queryResult)
+	require.Equal(t, uint64(5), queryResult.MaxOrdersPerBatch)
 }
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"fmt"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixoworld/bonds/x/bonds/internal/types"
+)
+
+func (k Keeper) GetAllReferrerTotalsIterator(ctx sdk.Context) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, types.ReferrerTotalsKeyPrefix)
+}
+
+func (k Keeper) GetReferrerTotalsIterator(ctx sdk.Context, token string) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, types.GetReferrerTotalsPrefix(token))
+}
+
+func (k Keeper) MustGetReferrerTotalByKey(ctx sdk.Context, key []byte) types.ReferrerTotal {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(key) {
+		panic("referrer total not found")
+	}
+	bz := store.Get(key)
+	var referrerTotal types.ReferrerTotal
+	k.cdc.MustUnmarshalBinaryBare(bz, &referrerTotal)
+	return referrerTotal
+}
+
+func (k Keeper) GetReferrerTotal(ctx sdk.Context, token string, referrer sdk.AccAddress) types.ReferrerTotal {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetReferrerTotalsKey(token, referrer))
+	if bz == nil {
+		return types.NewReferrerTotal(token, referrer)
+	}
+	var referrerTotal types.ReferrerTotal
+	k.cdc.MustUnmarshalBinaryBare(bz, &referrerTotal)
+	return referrerTotal
+}
+
+func (k Keeper) GetReferrerTotals(ctx sdk.Context, token string) (referrerTotals []types.ReferrerTotal) {
+	iterator := k.GetReferrerTotalsIterator(ctx, token)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		referrerTotals = append(referrerTotals, k.MustGetReferrerTotalByKey(ctx, iterator.Key()))
+	}
+	return referrerTotals
+}
+
+func (k Keeper) SetReferrerTotal(ctx sdk.Context, referrerTotal types.ReferrerTotal) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetReferrerTotalsKey(referrerTotal.Token, referrerTotal.Referrer),
+		k.cdc.MustMarshalBinaryBare(referrerTotal))
+}
+
+func (k Keeper) DeleteReferrerTotals(ctx sdk.Context, token string) {
+	store := ctx.KVStore(k.storeKey)
+	for _, referrerTotal := range k.GetReferrerTotals(ctx, token) {
+		store.Delete(types.GetReferrerTotalsKey(token, referrerTotal.Referrer))
+	}
+}
+
+func (k Keeper) PayReferralFeesFromModule(ctx sdk.Context, token string,
+	fromModule string, referrer sdk.AccAddress, referralFees sdk.Coins) sdk.Error {
+
+	err := k.SupplyKeeper.SendCoinsFromModuleToAccount(
+		ctx, fromModule, referrer, referralFees)
+	if err != nil {
+		return err
+	}
+
+	referrerTotal := k.GetReferrerTotal(ctx, token, referrer)
+	k.SetReferrerTotal(ctx, referrerTotal.AddPayout(referralFees))
+
+	logger := k.Logger(ctx)
+	logger.Info(fmt.Sprintf("paid referral fees %s of bond %s to %s",
+		referralFees.String(), token, referrer.String()))
+
+	return nil
+}
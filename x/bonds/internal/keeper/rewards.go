@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"fmt"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixoworld/bonds/x/bonds/internal/types"
+)
+
+func (k Keeper) GetHolderRewardsIterator(ctx sdk.Context, token string) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, types.GetHolderRewardsPrefix(token))
+}
+
+func (k Keeper) GetAllHolderRewardsIterator(ctx sdk.Context) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, types.HolderRewardsKeyPrefix)
+}
+
+func (k Keeper) MustGetHolderRewardsByKey(ctx sdk.Context, key []byte) types.HolderRewards {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(key) {
+		panic("holder rewards not found")
+	}
+	bz := store.Get(key)
+	var holderRewards types.HolderRewards
+	k.cdc.MustUnmarshalBinaryBare(bz, &holderRewards)
+	return holderRewards
+}
+
+func (k Keeper) GetHolderRewards(ctx sdk.Context, token string, address sdk.AccAddress) types.HolderRewards {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetHolderRewardsKey(token, address))
+	if bz == nil {
+		return types.NewHolderRewards(token, address)
+	}
+	var holderRewards types.HolderRewards
+	k.cdc.MustUnmarshalBinaryBare(bz, &holderRewards)
+	return holderRewards
+}
+
+func (k Keeper) SetHolderRewards(ctx sdk.Context, holderRewards types.HolderRewards) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetHolderRewardsKey(holderRewards.Token, holderRewards.Address),
+		k.cdc.MustMarshalBinaryBare(holderRewards))
+}
+
+func (k Keeper) DeleteHolderRewards(ctx sdk.Context, token string, address sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetHolderRewardsKey(token, address))
+}
+
+func (k Keeper) GetTotalBondTokens(ctx sdk.Context, token string) sdk.Int {
+	// Unlike the bond's current supply, this excludes tokens that were
+	// already burned by sell orders that are still waiting to be performed
+	return k.SupplyKeeper.GetSupply(ctx).GetTotal().AmountOf(token)
+}
+
+func (k Keeper) SettleHolderRewards(ctx sdk.Context, token string, address sdk.AccAddress) {
+	bond := k.MustGetBond(ctx, token)
+	holderRewards := k.GetHolderRewards(ctx, token, address)
+	balance := k.CoinKeeper.GetCoins(ctx, address).AmountOf(token)
+
+	// Add rewards accrued since last settlement to the holder's unclaimed
+	// rewards and mark the bond's current reward per token as paid
+	accrued := holderRewards.GetAccrued(bond.RewardPerToken, balance)
+	holderRewards.Unclaimed = holderRewards.Unclaimed.Add(accrued)
+	holderRewards.RewardPerTokenPaid = bond.RewardPerToken
+
+	// The record is kept even if the balance is zero, since settlement happens
+	// right before the balance changes and the paid reward per token applies
+	// to the balance that the address is about to have
+	k.SetHolderRewards(ctx, holderRewards)
+}
+
+func (k Keeper) AddHolderRewards(ctx sdk.Context, token string, rewards sdk.Coins) {
+	bond := k.MustGetBond(ctx, token)
+	totalTokens := k.GetTotalBondTokens(ctx, token)
+	if totalTokens.IsZero() {
+		panic(fmt.Sprintf("cannot add holder rewards to bond %s with no tokens", token))
+	}
+
+	// Reward per token is truncated so that holders can never be owed more
+	// than the rewards actually added to the pool
+	rewardPerToken := sdk.NewDecCoins(rewards).QuoDecTruncate(sdk.NewDecFromInt(totalTokens))
+	bond.RewardPerToken = bond.RewardPerToken.Add(rewardPerToken)
+	bond.RewardsPool = bond.RewardsPool.Add(rewards)
+	k.SetBond(ctx, token, bond)
+}
+
+func (k Keeper) GetClaimableRewards(ctx sdk.Context, token string, address sdk.AccAddress) sdk.Coins {
+	bond := k.MustGetBond(ctx, token)
+	holderRewards := k.GetHolderRewards(ctx, token, address)
+	balance := k.CoinKeeper.GetCoins(ctx, address).AmountOf(token)
+
+	accrued := holderRewards.GetAccrued(bond.RewardPerToken, balance)
+	claimable, _ := holderRewards.Unclaimed.Add(accrued).TruncateDecimal()
+	return claimable
+}
+
+func (k Keeper) ClaimHolderRewards(ctx sdk.Context, token string,
+	address sdk.AccAddress) (claimed sdk.Coins, err sdk.Error) {
+	k.SettleHolderRewards(ctx, token, address)
+	holderRewards := k.GetHolderRewards(ctx, token, address)
+
+	// Only whole coins can be claimed; the change stays unclaimed
+	claimed, change := holderRewards.Unclaimed.TruncateDecimal()
+	if claimed.IsZero() {
+		return nil, types.ErrNoRewardsToClaim(types.DefaultCodespace, token, address)
+	}
+
+	err = k.SupplyKeeper.SendCoinsFromModuleToAccount(
+		ctx, types.BondsRewardsAccount, address, claimed)
+	if err != nil {
+		return nil, err
+	}
+
+	bond := k.MustGetBond(ctx, token)
+	bond.RewardsPool = bond.RewardsPool.Sub(claimed)
+	k.SetBond(ctx, token, bond)
+
+	// Records of addresses that hold no tokens and have nothing left to claim
+	// are not kept, since a zero balance cannot accrue any rewards
+	holderRewards.Unclaimed = change
+	balance := k.CoinKeeper.GetCoins(ctx, address).AmountOf(token)
+	if balance.IsZero() && holderRewards.Unclaimed.IsZero() {
+		k.DeleteHolderRewards(ctx, token, address)
+	} else {
+		k.SetHolderRewards(ctx, holderRewards)
+	}
+	return claimed, nil
+}
+
+func (k Keeper) PayOutAllHolderRewards(ctx sdk.Context, token string) sdk.Error {
+	// Collect holders first so that records are not modified while iterating
+	var holders []sdk.AccAddress
+	iterator := k.GetHolderRewardsIterator(ctx, token)
+	for ; iterator.Valid(); iterator.Next() {
+		holders = append(holders, k.MustGetHolderRewardsByKey(ctx, iterator.Key()).Address)
+	}
+	iterator.Close()
+
+	for _, holder := range holders {
+		k.SettleHolderRewards(ctx, token, holder)
+		holderRewards := k.GetHolderRewards(ctx, token, holder)
+		claimable, _ := holderRewards.Unclaimed.TruncateDecimal()
+		if !claimable.IsZero() {
+			err := k.SupplyKeeper.SendCoinsFromModuleToAccount(
+				ctx, types.BondsRewardsAccount, holder, claimable)
+			if err != nil {
+				return err
+			}
+			bond := k.MustGetBond(ctx, token)
+			bond.RewardsPool = bond.RewardsPool.Sub(claimable)
+			k.SetBond(ctx, token, bond)
+		}
+		k.DeleteHolderRewards(ctx, token, holder)
+	}
+	return nil
+}
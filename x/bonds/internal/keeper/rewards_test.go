@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	supplyexported "github.com/cosmos/cosmos-sdk/x/supply/exported"
+	"github.com/ixoworld/bonds/x/bonds/internal/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"testing"
+)
+
+func TestHolderRewardsAreProportionalToBalancesOverTime(t *testing.T) {
+	app, ctx := createTestApp(false)
+	addr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	addr2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	// Add bond with reserve, with 50% of fees going to holders
+	bond := getValidBond()
+	bond.HolderRewardsPercentage = sdk.NewDec(50)
+	app.BondsKeeper.SetBond(ctx, token, bond)
+	reserveCoins, _ := sdk.ParseCoins("100res1")
+	require.Nil(t, setReserve(app, ctx, token, reserveCoins))
+
+	// Mint 75 tokens to addr1 and 25 tokens to addr2
+	tokens := sdk.NewCoins(sdk.NewInt64Coin(token, 100))
+	require.Nil(t, app.SupplyKeeper.MintCoins(ctx, types.BondsMintBurnAccount, tokens))
+	require.Nil(t, app.SupplyKeeper.SendCoinsFromModuleToAccount(ctx, types.BondsMintBurnAccount,
+		addr1, sdk.NewCoins(sdk.NewInt64Coin(token, 75))))
+	require.Nil(t, app.SupplyKeeper.SendCoinsFromModuleToAccount(ctx, types.BondsMintBurnAccount,
+		addr2, sdk.NewCoins(sdk.NewInt64Coin(token, 25))))
+
+	// Pay fees; holders get 4res1 split 3/1
+	fees, _ := sdk.ParseCoins("8res1")
+	require.Nil(t, app.BondsKeeper.PayFeesFromReserve(ctx, token, fees))
+	require.Equal(t, sdk.NewInt(4), app.BankKeeper.GetCoins(ctx, initFeeAddress).AmountOf("res1"))
+	require.Equal(t, "3res1", app.BondsKeeper.GetClaimableRewards(ctx, token, addr1).String())
+	require.Equal(t, "1res1", app.BondsKeeper.GetClaimableRewards(ctx, token, addr2).String())
+
+	// addr2 sends all of its tokens to addr1, keeping its accrued rewards
+	require.Nil(t, app.BankKeeper.SendCoins(ctx, addr2, addr1,
+		sdk.NewCoins(sdk.NewInt64Coin(token, 25))))
+
+	// Pay fees again; addr1 now holds all tokens and gets all 4res1
+	require.Nil(t, app.BondsKeeper.PayFeesFromReserve(ctx, token, fees))
+	require.Equal(t, "7res1", app.BondsKeeper.GetClaimableRewards(ctx, token, addr1).String())
+	require.Equal(t, "1res1", app.BondsKeeper.GetClaimableRewards(ctx, token, addr2).String())
+
+	expectedPool, _ := sdk.ParseCoins("8res1")
+	require.Equal(t, expectedPool, app.BondsKeeper.MustGetBond(ctx, token).RewardsPool)
+}
+
+func TestClaimHolderRewards(t *testing.T) {
+	app, ctx := createTestApp(false)
+	holder := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	// Add bond with 10 tokens held by the holder
+	bond := getValidBond()
+	bond.HolderRewardsPercentage = sdk.NewDec(100)
+	app.BondsKeeper.SetBond(ctx, token, bond)
+	tokens := sdk.NewCoins(sdk.NewInt64Coin(token, 10))
+	require.Nil(t, app.SupplyKeeper.MintCoins(ctx, types.BondsMintBurnAccount, tokens))
+	require.Nil(t, app.SupplyKeeper.SendCoinsFromModuleToAccount(
+		ctx, types.BondsMintBurnAccount, holder, tokens))
+
+	// Nothing to claim yet
+	_, err := app.BondsKeeper.ClaimHolderRewards(ctx, token, holder)
+	require.NotNil(t, err)
+	require.Equal(t, types.CodeNoRewardsToClaim, err.Code())
+
+	// Add 25res1 of rewards to the pool
+	rewards, _ := sdk.ParseCoins("25res1")
+	rewardsAddress := app.SupplyKeeper.GetModuleAddress(types.BondsRewardsAccount)
+	require.Nil(t, app.BankKeeper.SetCoins(ctx, rewardsAddress, rewards))
+	app.BondsKeeper.AddHolderRewards(ctx, token, rewards)
+
+	// Claim all rewards
+	claimed, err := app.BondsKeeper.ClaimHolderRewards(ctx, token, holder)
+	require.Nil(t, err)
+	require.Equal(t, rewards, claimed)
+	require.Equal(t, rewards, app.BankKeeper.GetCoins(ctx, holder).Sub(tokens))
+	require.True(t, app.BondsKeeper.MustGetBond(ctx, token).RewardsPool.IsZero())
+
+	// Nothing left to claim
+	_, err = app.BondsKeeper.ClaimHolderRewards(ctx, token, holder)
+	require.NotNil(t, err)
+	require.Equal(t, types.CodeNoRewardsToClaim, err.Code())
+}
+
+func TestPayFeesFromReserveCreatesRewardsModuleAccount(t *testing.T) {
+	app, ctx := createTestApp(false)
+	holder := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	// Add bond with reserve and 10 tokens held by the holder
+	bond := getValidBond()
+	bond.HolderRewardsPercentage = sdk.NewDec(100)
+	app.BondsKeeper.SetBond(ctx, token, bond)
+	reserveCoins, _ := sdk.ParseCoins("100res1")
+	require.Nil(t, setReserve(app, ctx, token, reserveCoins))
+	tokens := sdk.NewCoins(sdk.NewInt64Coin(token, 10))
+	require.Nil(t, app.SupplyKeeper.MintCoins(ctx, types.BondsMintBurnAccount, tokens))
+	require.Nil(t, app.SupplyKeeper.SendCoinsFromModuleToAccount(
+		ctx, types.BondsMintBurnAccount, holder, tokens))
+
+	// Rewards module account does not exist before fees are first paid
+	rewardsAddress := app.SupplyKeeper.GetModuleAddress(types.BondsRewardsAccount)
+	require.Nil(t, app.AccountKeeper.GetAccount(ctx, rewardsAddress))
+
+	// Paying fees from the reserve creates the rewards module account
+	fees, _ := sdk.ParseCoins("8res1")
+	require.Nil(t, app.BondsKeeper.PayFeesFromReserve(ctx, token, fees))
+	_, isModuleAccount := app.AccountKeeper.GetAccount(ctx, rewardsAddress).(supplyexported.ModuleAccountI)
+	require.True(t, isModuleAccount)
+}
@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"fmt"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixoworld/bonds/x/bonds/internal/types"
+)
+
+func (k Keeper) AddRoundingSurplus(ctx sdk.Context, token string, surplus sdk.DecCoins) {
+	// Swapper function bonds have no curve integral for the reserve to drift
+	// away from, so their rounding remainders are left to liquidity providers
+	bond := k.MustGetBond(ctx, token)
+	if bond.FunctionType == types.SwapperFunction || surplus.IsZero() {
+		return
+	}
+	bond.RoundingSurplus = bond.RoundingSurplus.Add(surplus)
+	k.SetBond(ctx, token, bond)
+}
+
+// AddExpectedReserve adds the exact (i.e. unrounded) amount by which the
+// bond's reserve grows to the bond's expected reserve
+func (k Keeper) AddExpectedReserve(ctx sdk.Context, token string, amount sdk.DecCoins) {
+	bond := k.MustGetBond(ctx, token)
+	if bond.FunctionType == types.SwapperFunction || amount.IsZero() {
+		return
+	}
+	bond.ExpectedReserve = bond.ExpectedReserve.Add(amount)
+	k.SetBond(ctx, token, bond)
+}
+
+// SubtractExpectedReserve subtracts the exact (i.e. unrounded) amount by which
+// the bond's reserve shrinks from the bond's expected reserve. Any amount above
+// the expected reserve (e.g. a pro-rata return that exceeds it at the precision
+// of sdk.Dec, or a staking loss) is taken out of the rounding surplus instead.
+func (k Keeper) SubtractExpectedReserve(ctx sdk.Context, token string, amount sdk.DecCoins) {
+	bond := k.MustGetBond(ctx, token)
+	if bond.FunctionType == types.SwapperFunction || amount.IsZero() {
+		return
+	}
+
+	var fromExpected, fromSurplus sdk.DecCoins
+	for _, a := range amount {
+		expected := bond.ExpectedReserve.AmountOf(a.Denom)
+		if a.Amount.LTE(expected) {
+			fromExpected = fromExpected.Add(sdk.DecCoins{a})
+			continue
+		}
+		if expected.IsPositive() {
+			fromExpected = fromExpected.Add(sdk.DecCoins{sdk.NewDecCoinFromDec(a.Denom, expected)})
+		}
+		surplus := bond.RoundingSurplus.AmountOf(a.Denom)
+		excess := sdk.MinDec(a.Amount.Sub(expected), surplus)
+		if excess.IsPositive() {
+			fromSurplus = fromSurplus.Add(sdk.DecCoins{sdk.NewDecCoinFromDec(a.Denom, excess)})
+		}
+	}
+	bond.ExpectedReserve = bond.ExpectedReserve.Sub(fromExpected)
+	bond.RoundingSurplus = bond.RoundingSurplus.Sub(fromSurplus)
+	k.SetBond(ctx, token, bond)
+}
+
+func (k Keeper) GetSweepableRoundingSurplus(ctx sdk.Context, token string) (sweepable sdk.Coins) {
+	bond := k.MustGetBond(ctx, token)
+	if bond.FunctionType == types.SwapperFunction {
+		return nil
+	}
+
+	// Only whole tokens of the surplus can be swept, and only as far as the
+	// reserve stays above the reserve required by the reserve invariant
+	required := bond.CurveIntegral(bond.CurrentSupply.Amount).Ceil().TruncateInt()
+	required = required.Sub(bond.GetReserveWithdrawn())
+	for _, s := range bond.RoundingSurplus {
+		amount := sdk.MinInt(s.Amount.TruncateInt(),
+			bond.CurrentReserve.AmountOf(s.Denom).Sub(required))
+
+		// Staked reserve cannot be swept, so only the surplus that is
+		// actually held by the reserve address can be swept
+		if bond.HasReserveStaking() {
+			amount = sdk.MinInt(amount, k.GetActualReserveBalances(ctx, token).AmountOf(s.Denom))
+		}
+
+		if amount.IsPositive() {
+			sweepable = sweepable.Add(sdk.Coins{sdk.NewCoin(s.Denom, amount)})
+		}
+	}
+	return sweepable
+}
+
+func (k Keeper) SweepRoundingSurplus(ctx sdk.Context, token string) (swept sdk.Coins, err sdk.Error) {
+	swept = k.GetSweepableRoundingSurplus(ctx, token)
+	if swept.IsZero() {
+		return nil, types.ErrNoRoundingSurplusToSweep(types.DefaultCodespace, token)
+	}
+
+	bond := k.MustGetBond(ctx, token)
+	err = k.WithdrawReserve(ctx, token, bond.FeeAddress, swept)
+	if err != nil {
+		return nil, err
+	}
+
+	bond = k.MustGetBond(ctx, token)
+	bond.RoundingSurplus = bond.RoundingSurplus.Sub(sdk.NewDecCoins(swept))
+	k.SetBond(ctx, token, bond)
+
+	logger := k.Logger(ctx)
+	logger.Info(fmt.Sprintf("swept rounding surplus %s of bond %s", swept.String(), token))
+
+	return swept, nil
+}
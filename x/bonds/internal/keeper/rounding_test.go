@@ -0,0 +1,70 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixoworld/bonds/x/bonds/internal/types"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestSweepRoundingSurplusKeepsReserveAboveCurveIntegral(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	// Add bond with supply 10, for which the curve integral is 5000
+	bond := getValidBond()
+	bond.CurrentSupply = sdk.NewInt64Coin(token, 10)
+	app.BondsKeeper.SetBond(ctx, token, bond)
+	require.Nil(t, setReserve(app, ctx, token,
+		sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 5002))))
+
+	// Rounding surplus of 3.5 recorded, but only 2 is above the integral
+	surplus := sdk.NewDecCoins(sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 7))).
+		QuoDec(sdk.NewDec(2))
+	app.BondsKeeper.AddRoundingSurplus(ctx, token, surplus)
+	require.Equal(t, surplus, app.BondsKeeper.MustGetBond(ctx, token).RoundingSurplus)
+	expectedSweepable := sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 2))
+	require.Equal(t, expectedSweepable, app.BondsKeeper.GetSweepableRoundingSurplus(ctx, token))
+
+	// Sweeping moves the sweepable surplus from the reserve to the fee address
+	swept, err := app.BondsKeeper.SweepRoundingSurplus(ctx, token)
+	require.Nil(t, err)
+	require.Equal(t, expectedSweepable, swept)
+	require.Equal(t, expectedSweepable, app.BankKeeper.GetCoins(ctx, initFeeAddress))
+	bond = app.BondsKeeper.MustGetBond(ctx, token)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 5000)), bond.CurrentReserve)
+	require.Equal(t, surplus.Sub(sdk.NewDecCoins(swept)), bond.RoundingSurplus)
+
+	// Nothing left to sweep
+	_, err = app.BondsKeeper.SweepRoundingSurplus(ctx, token)
+	require.Equal(t, types.CodeNoRoundingSurplusToSweep, err.Code())
+}
+
+func TestRoundingSurplusIsNotTrackedForSwapperBonds(t *testing.T) {
+	app, ctx := createTestApp(false)
+	app.BondsKeeper.SetBond(ctx, token, getValidSwapperBond())
+
+	surplus := sdk.NewDecCoins(sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 1)))
+	app.BondsKeeper.AddRoundingSurplus(ctx, token, surplus)
+	require.True(t, app.BondsKeeper.MustGetBond(ctx, token).RoundingSurplus.IsZero())
+	require.True(t, app.BondsKeeper.GetSweepableRoundingSurplus(ctx, token).IsZero())
+}
+
+func TestSubtractExpectedReserveTakesExcessFromRoundingSurplus(t *testing.T) {
+	app, ctx := createTestApp(false)
+	app.BondsKeeper.SetBond(ctx, token, getValidBond())
+
+	// Expected reserve of 10 and rounding surplus of 0.5
+	app.BondsKeeper.AddExpectedReserve(ctx, token,
+		sdk.NewDecCoins(sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 10))))
+	app.BondsKeeper.AddRoundingSurplus(ctx, token, sdk.DecCoins{
+		sdk.NewDecCoinFromDec(reserveToken, sdk.NewDecWithPrec(5, 1))})
+
+	// Subtracting 10.2 takes the 0.2 above the expected reserve out of the
+	// rounding surplus
+	app.BondsKeeper.SubtractExpectedReserve(ctx, token, sdk.DecCoins{
+		sdk.NewDecCoinFromDec(reserveToken, sdk.NewDecWithPrec(102, 1))})
+	bond := app.BondsKeeper.MustGetBond(ctx, token)
+	require.True(t, bond.ExpectedReserve.IsZero())
+	require.Equal(t, sdk.DecCoins{sdk.NewDecCoinFromDec(reserveToken,
+		sdk.NewDecWithPrec(3, 1))}, bond.RoundingSurplus)
+}
@@ -0,0 +1,420 @@
+package keeper
+
+import (
+	"fmt"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/ixoworld/bonds/x/bonds/internal/types"
+	"time"
+)
+
+// The only reserve token of a bond with reserve staking is the staking denom,
+// which is checked when reserve staking is enabled for the bond
+func getStakingDenom(bond types.Bond) string {
+	return bond.ReserveTokens[0]
+}
+
+func (k Keeper) GetReserveDelegations(ctx sdk.Context, token string) (delegations []types.ReserveDelegation) {
+	bond := k.MustGetBond(ctx, token)
+	denom := getStakingDenom(bond)
+
+	for _, d := range k.StakingKeeper.GetAllDelegatorDelegations(ctx, bond.ReserveAddress) {
+		validator, found := k.StakingKeeper.GetValidator(ctx, d.ValidatorAddress)
+		if !found {
+			continue
+		}
+		// Truncated so that the delegation is never overestimated
+		amount := validator.TokensFromShares(d.Shares).TruncateInt()
+		delegations = append(delegations, types.NewReserveDelegation(
+			d.ValidatorAddress, sdk.NewCoin(denom, amount)))
+	}
+	return delegations
+}
+
+func (k Keeper) GetDelegatedReserve(ctx sdk.Context, token string) sdk.Int {
+	delegated := sdk.ZeroInt()
+	for _, d := range k.GetReserveDelegations(ctx, token) {
+		delegated = delegated.Add(d.Amount.Amount)
+	}
+	return delegated
+}
+
+func (k Keeper) GetUnbondingReserve(ctx sdk.Context, token string) sdk.Int {
+	bond := k.MustGetBond(ctx, token)
+
+	unbonding := sdk.ZeroInt()
+	for _, ubd := range k.StakingKeeper.GetAllUnbondingDelegations(ctx, bond.ReserveAddress) {
+		for _, entry := range ubd.Entries {
+			unbonding = unbonding.Add(entry.Balance)
+		}
+	}
+	return unbonding
+}
+
+// The staked reserve includes any reserve that is still unbonding, given that
+// it is not yet held by the reserve address
+func (k Keeper) GetStakedReserve(ctx sdk.Context, token string) sdk.Int {
+	return k.GetDelegatedReserve(ctx, token).Add(k.GetUnbondingReserve(ctx, token))
+}
+
+// The liquid reserve excludes the rounding surplus, which is kept aside to be
+// swept to the fee address, so that it is neither staked nor paid out
+func (k Keeper) GetLiquidReserve(ctx sdk.Context, token string) sdk.Int {
+	bond := k.MustGetBond(ctx, token)
+	denom := getStakingDenom(bond)
+	balance := k.GetActualReserveBalances(ctx, token).AmountOf(denom)
+	return bond.GetReserveExcludingSurplus(sdk.NewCoin(denom, balance)).TruncateInt()
+}
+
+// GetBondTokensWithStakedReserve returns the bonds that have delegated any
+// of their reserve and that may still have some of it delegated or unbonding
+func (k Keeper) GetBondTokensWithStakedReserve(ctx sdk.Context) (tokens []string) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.StakedReserveIndexKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		tokens = append(tokens, string(iterator.Value()))
+	}
+	return tokens
+}
+
+func (k Keeper) setStakedReserveIndex(ctx sdk.Context, token string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetStakedReserveIndexKey(token), []byte(token))
+}
+
+// UpdateStakedReserveIndex removes the bond from the staked reserve index
+// once none of its reserve is delegated or unbonding
+func (k Keeper) UpdateStakedReserveIndex(ctx sdk.Context, token string) {
+	if k.GetStakedReserve(ctx, token).IsZero() {
+		store := ctx.KVStore(k.storeKey)
+		store.Delete(types.GetStakedReserveIndexKey(token))
+	}
+}
+
+// RebuildStakedReserveIndex deletes all staked reserve index entries and
+// re-adds the entries of every bond with delegated or unbonding reserve
+func (k Keeper) RebuildStakedReserveIndex(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	var keys [][]byte
+	iterator := sdk.KVStorePrefixIterator(store, types.StakedReserveIndexKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	bondIterator := k.GetBondIterator(ctx)
+	defer bondIterator.Close()
+	for ; bondIterator.Valid(); bondIterator.Next() {
+		bond := k.MustGetBondByKey(ctx, bondIterator.Key())
+		if bond.HasReserveStaking() && k.GetStakedReserve(ctx, bond.Token).IsPositive() {
+			k.setStakedReserveIndex(ctx, bond.Token)
+		}
+	}
+}
+
+func (k Keeper) DelegateReserve(ctx sdk.Context, token string,
+	validator staking.Validator, amount sdk.Int) sdk.Error {
+	bond := k.MustGetBond(ctx, token)
+
+	// Collect rewards first, since any change to the delegation withdraws its
+	// rewards to the reserve address, where they would not be accounted for
+	err := k.collectStakingRewardsFrom(ctx, token, validator.OperatorAddress)
+	if err != nil {
+		return err
+	}
+
+	_, err = k.StakingKeeper.Delegate(ctx, bond.ReserveAddress,
+		amount, sdk.Unbonded, validator, true)
+	if err != nil {
+		return err
+	}
+
+	// Delegated tokens are rounded down, so this records any rounding loss
+	k.setStakedReserveIndex(ctx, token)
+	k.SyncStakedReserve(ctx, token)
+	return nil
+}
+
+func (k Keeper) UndelegateReserve(ctx sdk.Context, token string,
+	validator sdk.ValAddress, amount sdk.Int) (time.Time, sdk.Error) {
+	bond := k.MustGetBond(ctx, token)
+
+	shares, err := k.StakingKeeper.ValidateUnbondAmount(
+		ctx, bond.ReserveAddress, validator, amount)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	err = k.collectStakingRewardsFrom(ctx, token, validator)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	completionTime, err := k.StakingKeeper.Undelegate(
+		ctx, bond.ReserveAddress, validator, shares)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	k.SyncStakedReserve(ctx, token)
+	return completionTime, nil
+}
+
+// Rewards are collected at the end of a block, where a failure cannot be
+// reverted by failing a transaction, so the rewards from each validator are
+// collected in a cached context that is only written if the collection
+// succeeds. Failures are logged and emitted as events, and the rewards are
+// collected again once the bond's next batch has been processed.
+func (k Keeper) CollectStakingRewards(ctx sdk.Context, token string) {
+	logger := k.Logger(ctx)
+	bond := k.MustGetBond(ctx, token)
+	for _, d := range k.StakingKeeper.GetAllDelegatorDelegations(ctx, bond.ReserveAddress) {
+		cacheCtx, writeCache := ctx.CacheContext()
+		err := k.collectStakingRewardsFrom(cacheCtx, token, d.ValidatorAddress)
+		if err != nil {
+			logger.Error(fmt.Sprintf("bond %s could not collect staking rewards from %s: %s",
+				token, d.ValidatorAddress.String(), err.Error()))
+
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeStakingRewardsFailed,
+				sdk.NewAttribute(types.AttributeKeyBond, token),
+				sdk.NewAttribute(types.AttributeKeyValidator, d.ValidatorAddress.String()),
+				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+			))
+			continue
+		}
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+}
+
+func (k Keeper) collectStakingRewardsFrom(ctx sdk.Context, token string, validator sdk.ValAddress) sdk.Error {
+	bond := k.MustGetBond(ctx, token)
+
+	// Nothing to collect if the reserve is not delegated to the validator
+	_, found := k.StakingKeeper.GetDelegation(ctx, bond.ReserveAddress, validator)
+	if !found {
+		return nil
+	}
+
+	// Rewards are withdrawn to the reserve address
+	rewards, err := k.DistributionKeeper.WithdrawDelegationRewards(
+		ctx, bond.ReserveAddress, validator)
+	if err != nil {
+		return err
+	} else if rewards.IsZero() {
+		return nil
+	}
+
+	// Staking denom rewards are added to the reserve if the bond is set up to
+	// do so. Any other rewards always go to the bond's fee address.
+	toFeeAddress := rewards
+	if bond.Staking.RewardsGoToReserve() {
+		denom := getStakingDenom(bond)
+		toReserve := sdk.Coins{sdk.NewCoin(denom, rewards.AmountOf(denom))}
+		if !toReserve.IsZero() {
+			bond.CurrentReserve = bond.CurrentReserve.Add(toReserve)
+			k.SetBond(ctx, token, bond)
+			k.AddExpectedReserve(ctx, token, sdk.NewDecCoins(toReserve))
+			toFeeAddress = toFeeAddress.Sub(toReserve)
+		}
+	}
+	if !toFeeAddress.IsZero() {
+		err = k.CoinKeeper.SendCoins(ctx, bond.ReserveAddress, bond.FeeAddress, toFeeAddress)
+		if err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeStakingRewards,
+		sdk.NewAttribute(types.AttributeKeyBond, token),
+		sdk.NewAttribute(types.AttributeKeyValidator, validator.String()),
+		sdk.NewAttribute(types.AttributeKeyAmount, rewards.String()),
+	))
+
+	return nil
+}
+
+// Any difference between the reserve that the bond expects to hold (i.e. its
+// current reserve, including the rounding surplus, and the reserve owed to
+// queued sells) and the reserve that it actually holds or has staked is a
+// loss (e.g. due to slashing), which is taken out of the bond's current reserve
+func (k Keeper) SyncStakedReserve(ctx sdk.Context, token string) {
+	bond := k.MustGetBond(ctx, token)
+	if !bond.HasReserveStaking() {
+		return
+	}
+	denom := getStakingDenom(bond)
+
+	expected := bond.CurrentReserve.AmountOf(denom).Add(
+		bond.Staking.GetQueuedTotal().AmountOf(denom))
+	held := k.GetActualReserveBalances(ctx, token).AmountOf(denom)
+	actual := held.Add(k.GetStakedReserve(ctx, token))
+	if actual.GTE(expected) {
+		return
+	}
+
+	// Queued sells have already been taken out of the current reserve, so the
+	// loss cannot be more than the current reserve
+	loss := sdk.MinInt(expected.Sub(actual), bond.CurrentReserve.AmountOf(denom))
+	if loss.IsZero() {
+		return
+	}
+	lossCoins := sdk.Coins{sdk.NewCoin(denom, loss)}
+	bond.CurrentReserve = bond.CurrentReserve.Sub(lossCoins)
+	bond.Staking.Losses = bond.Staking.Losses.Add(loss)
+	k.SetBond(ctx, token, bond)
+	k.SubtractExpectedReserve(ctx, token, sdk.NewDecCoins(lossCoins))
+
+	logger := k.Logger(ctx)
+	logger.Info(fmt.Sprintf("bond %s lost %s of its staked reserve", token, lossCoins.String()))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeStakingLoss,
+		sdk.NewAttribute(types.AttributeKeyBond, token),
+		sdk.NewAttribute(types.AttributeKeyAmount, lossCoins.String()),
+	))
+}
+
+func (k Keeper) QueueSell(ctx sdk.Context, token string, qs types.QueuedSell) {
+	bond := k.MustGetBond(ctx, token)
+
+	// The returns and fees are taken out of the current reserve immediately,
+	// as if the sell had been paid out
+	bond.CurrentReserve = bond.CurrentReserve.Sub(qs.GetTotal())
+	bond.Staking.QueuedSells = append(bond.Staking.QueuedSells, qs)
+	k.SetBond(ctx, token, bond)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeQueueSell,
+		sdk.NewAttribute(types.AttributeKeyBond, token),
+		sdk.NewAttribute(types.AttributeKeyAddress, qs.Address.String()),
+		sdk.NewAttribute(types.AttributeKeyTokensBurned, qs.Amount.Amount.String()),
+		sdk.NewAttribute(types.AttributeKeyChargedFees, qs.Fees.String()),
+		sdk.NewAttribute(types.AttributeKeyReturnedToAddress, qs.Returns.String()),
+	))
+}
+
+// Queued sells are paid out in the order that they were queued, for as long
+// as the liquid reserve is enough to pay out the next queued sell
+func (k Keeper) PayQueuedSells(ctx sdk.Context, token string) {
+	for {
+		bond := k.MustGetBond(ctx, token)
+		if !bond.HasReserveStaking() || !bond.Staking.HasQueuedSells() {
+			return
+		}
+		qs := bond.Staking.QueuedSells[0]
+		liquid := k.GetLiquidReserve(ctx, token)
+		if liquid.LT(qs.GetTotal().AmountOf(getStakingDenom(bond))) {
+			return
+		}
+
+		// The sell's returns and fees are added back to the current reserve
+		// so that they can be paid out of the reserve as usual
+		bond.CurrentReserve = bond.CurrentReserve.Add(qs.GetTotal())
+		bond.Staking.QueuedSells = bond.Staking.QueuedSells[1:]
+		k.SetBond(ctx, token, bond)
+
+		err := k.WithdrawReserve(ctx, token, qs.Address, qs.Returns)
+		if err != nil {
+			panic(err)
+		}
+		if !qs.Fees.IsZero() {
+			err = k.PayFeesFromReserve(ctx, token, qs.Fees)
+			if err != nil {
+				panic(err)
+			}
+		}
+
+		logger := k.Logger(ctx)
+		logger.Info(fmt.Sprintf("paid queued sell for %s to %s", qs.Amount.String(), qs.Address.String()))
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypePayQueuedSell,
+			sdk.NewAttribute(types.AttributeKeyBond, token),
+			sdk.NewAttribute(types.AttributeKeyAddress, qs.Address.String()),
+			sdk.NewAttribute(types.AttributeKeyReturnedToAddress, qs.Returns.String()),
+		))
+	}
+}
+
+// Undelegates enough of the reserve to pay out all queued sells, taking into
+// account the liquid reserve and any reserve that is already unbonding
+func (k Keeper) UndelegateForQueuedSells(ctx sdk.Context, token string) {
+	bond := k.MustGetBond(ctx, token)
+	if !bond.HasReserveStaking() || !bond.Staking.HasQueuedSells() {
+		return
+	}
+
+	queued := bond.Staking.GetQueuedTotal().AmountOf(getStakingDenom(bond))
+	available := k.GetLiquidReserve(ctx, token).Add(k.GetUnbondingReserve(ctx, token))
+	if available.GTE(queued) {
+		return
+	}
+	k.undelegateReserveAcrossValidators(ctx, token, queued.Sub(available))
+}
+
+// Undelegates all of the reserve of a dissolved bond, so that holders can be
+// refunded once the reserve has finished unbonding
+func (k Keeper) UndelegateDissolvedReserve(ctx sdk.Context, token string) {
+	bond := k.MustGetBond(ctx, token)
+	if !bond.HasReserveStaking() || !bond.IsDissolved() {
+		return
+	}
+
+	delegated := k.GetDelegatedReserve(ctx, token)
+	if delegated.IsPositive() {
+		k.undelegateReserveAcrossValidators(ctx, token, delegated)
+	}
+}
+
+func (k Keeper) undelegateReserveAcrossValidators(ctx sdk.Context, token string, needed sdk.Int) {
+	logger := k.Logger(ctx)
+	for _, d := range k.GetReserveDelegations(ctx, token) {
+		if !needed.IsPositive() {
+			break
+		}
+		amount := sdk.MinInt(needed, d.Amount.Amount)
+		if amount.IsZero() {
+			continue
+		}
+
+		// Failures (e.g. too many unbonding entries) do not stop the rest of
+		// the amount from being undelegated from other validators, and the
+		// amount is undelegated again in a later block, but they are logged
+		// and emitted as events so that they do not go unnoticed. Since the
+		// rewards are collected before undelegating, the undelegation is
+		// performed in a cached context that is only written if it succeeds.
+		cacheCtx, writeCache := ctx.CacheContext()
+		completionTime, err := k.UndelegateReserve(cacheCtx, token, d.Validator, amount)
+		if err != nil {
+			logger.Error(fmt.Sprintf("bond %s could not undelegate %s from %s: %s",
+				token, amount.String(), d.Validator.String(), err.Error()))
+
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeUndelegateReserveFailed,
+				sdk.NewAttribute(types.AttributeKeyBond, token),
+				sdk.NewAttribute(types.AttributeKeyValidator, d.Validator.String()),
+				sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+			))
+			continue
+		}
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		needed = needed.Sub(amount)
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeUndelegateReserve,
+			sdk.NewAttribute(types.AttributeKeyBond, token),
+			sdk.NewAttribute(types.AttributeKeyValidator, d.Validator.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.String()),
+		))
+	}
+}
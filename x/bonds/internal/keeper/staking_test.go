@@ -0,0 +1,209 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	simapp "github.com/ixoworld/bonds/x/bonds/app"
+	"github.com/ixoworld/bonds/x/bonds/internal/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"testing"
+)
+
+func createBondedValidator(t *testing.T, app *simapp.SimApp, ctx sdk.Context) staking.Validator {
+	pubKey := ed25519.GenPrivKey().PubKey()
+	valAddress := sdk.ValAddress(pubKey.Address())
+	selfDelegation := sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), sdk.TokensFromConsensusPower(1))
+	_, err := app.BondsKeeper.CoinKeeper.AddCoins(ctx, sdk.AccAddress(valAddress), sdk.NewCoins(selfDelegation))
+	require.Nil(t, err)
+
+	msg := staking.NewMsgCreateValidator(valAddress, pubKey, selfDelegation,
+		staking.Description{}, staking.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.OneInt())
+	res := staking.NewHandler(app.StakingKeeper)(ctx, msg)
+	require.True(t, res.IsOK())
+
+	app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddress)
+	require.True(t, found)
+	return validator
+}
+
+func getValidStakingBond(stakingDenom string) types.Bond {
+	bond := getValidBond()
+	bond.ReserveTokens = []string{stakingDenom}
+	staking := types.NewReserveStaking(sdk.NewDec(50), types.FALSE)
+	bond.Staking = &staking
+	return bond
+}
+
+func TestSyncStakedReserveRecordsSlashingLosses(t *testing.T) {
+	app, ctx := createTestApp(false)
+	stakingDenom := app.StakingKeeper.BondDenom(ctx)
+	validator := createBondedValidator(t, app, ctx)
+
+	// Add bond with a reserve of 2 power worth of the staking denom
+	app.BondsKeeper.SetBond(ctx, token, getValidStakingBond(stakingDenom))
+	reserve := sdk.NewCoin(stakingDenom, sdk.TokensFromConsensusPower(2))
+	require.Nil(t, addToReserve(app, ctx, token, sdk.NewCoins(reserve)))
+
+	// Slashing burns tokens, so the supply must account for all tokens
+	totalSupply := sdk.NewCoin(stakingDenom, sdk.TokensFromConsensusPower(3))
+	app.SupplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.NewCoins(totalSupply)))
+
+	// Delegate half of the reserve, so the validator has a power of 2
+	delegated := sdk.TokensFromConsensusPower(1)
+	err := app.BondsKeeper.DelegateReserve(ctx, token, validator, delegated)
+	require.Nil(t, err)
+	app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.Equal(t, delegated, app.BondsKeeper.GetDelegatedReserve(ctx, token))
+	require.Equal(t, delegated, app.BondsKeeper.GetLiquidReserve(ctx, token))
+
+	// Without any slashing, nothing is lost
+	app.BondsKeeper.SyncStakedReserve(ctx, token)
+	bond := app.BondsKeeper.MustGetBond(ctx, token)
+	require.Equal(t, sdk.ZeroInt(), bond.Staking.Losses)
+	require.Equal(t, reserve.Amount, bond.CurrentReserve.AmountOf(stakingDenom))
+
+	// Slashing the validator by 10% loses 10% of the delegated reserve
+	consAddress := sdk.ConsAddress(validator.ConsPubKey.Address())
+	app.StakingKeeper.Slash(ctx, consAddress, ctx.BlockHeight(), 2, sdk.NewDecWithPrec(1, 1))
+	expectedLoss := delegated.QuoRaw(10)
+
+	app.BondsKeeper.SyncStakedReserve(ctx, token)
+	bond = app.BondsKeeper.MustGetBond(ctx, token)
+	require.Equal(t, expectedLoss, bond.Staking.Losses)
+	require.Equal(t, reserve.Amount.Sub(expectedLoss), bond.CurrentReserve.AmountOf(stakingDenom))
+	require.Equal(t, delegated.Sub(expectedLoss), app.BondsKeeper.GetDelegatedReserve(ctx, token))
+}
+
+func TestPayQueuedSellsPaysInOrderFromLiquidReserve(t *testing.T) {
+	app, ctx := createTestApp(false)
+	stakingDenom := app.StakingKeeper.BondDenom(ctx)
+
+	// Add bond with a reserve of 1000
+	app.BondsKeeper.SetBond(ctx, token, getValidStakingBond(stakingDenom))
+	require.Nil(t, addToReserve(app, ctx, token,
+		sdk.NewCoins(sdk.NewInt64Coin(stakingDenom, 1000))))
+
+	// Queue sells of 600 and 300, which are taken out of the current reserve
+	sellerReturns := sdk.NewCoins(sdk.NewInt64Coin(stakingDenom, 590))
+	buyerReturns := sdk.NewCoins(sdk.NewInt64Coin(stakingDenom, 290))
+	fees := sdk.NewCoins(sdk.NewInt64Coin(stakingDenom, 10))
+	app.BondsKeeper.QueueSell(ctx, token, types.NewQueuedSell(
+		sellerAddress, sdk.NewInt64Coin(token, 1), sellerReturns, fees))
+	app.BondsKeeper.QueueSell(ctx, token, types.NewQueuedSell(
+		buyerAddress, sdk.NewInt64Coin(token, 1), buyerReturns, fees))
+	bond := app.BondsKeeper.MustGetBond(ctx, token)
+	require.Len(t, bond.Staking.QueuedSells, 2)
+	require.Equal(t, sdk.NewInt(900), bond.Staking.GetQueuedTotal().AmountOf(stakingDenom))
+	require.Equal(t, sdk.NewInt(100), bond.CurrentReserve.AmountOf(stakingDenom))
+
+	// With only 500 liquid, neither sell is paid, since the first cannot be
+	lockedCoins := sdk.NewCoins(sdk.NewInt64Coin(stakingDenom, 500))
+	err := app.BondsKeeper.CoinKeeper.SendCoins(ctx, bond.ReserveAddress, initCreator, lockedCoins)
+	require.Nil(t, err)
+	app.BondsKeeper.PayQueuedSells(ctx, token)
+	bond = app.BondsKeeper.MustGetBond(ctx, token)
+	require.Len(t, bond.Staking.QueuedSells, 2)
+
+	// Once the reserve is liquid again, both sells are paid in order
+	err = app.BondsKeeper.CoinKeeper.SendCoins(ctx, initCreator, bond.ReserveAddress, lockedCoins)
+	require.Nil(t, err)
+	app.BondsKeeper.PayQueuedSells(ctx, token)
+	bond = app.BondsKeeper.MustGetBond(ctx, token)
+	require.False(t, bond.Staking.HasQueuedSells())
+	require.Equal(t, sellerReturns, app.BondsKeeper.CoinKeeper.GetCoins(ctx, sellerAddress))
+	require.Equal(t, buyerReturns, app.BondsKeeper.CoinKeeper.GetCoins(ctx, buyerAddress))
+	require.Equal(t, fees.Add(fees), app.BondsKeeper.CoinKeeper.GetCoins(ctx, initFeeAddress))
+	require.Equal(t, sdk.NewInt(100), app.BondsKeeper.GetLiquidReserve(ctx, token))
+	require.Equal(t, sdk.NewInt(100), bond.CurrentReserve.AmountOf(stakingDenom))
+}
+
+func TestLiquidReserveExcludesRoundingSurplus(t *testing.T) {
+	app, ctx := createTestApp(false)
+	stakingDenom := app.StakingKeeper.BondDenom(ctx)
+
+	// Add bond with a reserve of 1000, of which 2.5 is rounding surplus
+	bond := getValidStakingBond(stakingDenom)
+	bond.RoundingSurplus = sdk.NewDecCoins(sdk.NewCoins(sdk.NewInt64Coin(stakingDenom, 2))).Add(
+		sdk.DecCoins{sdk.NewDecCoinFromDec(stakingDenom, sdk.NewDecWithPrec(5, 1))})
+	app.BondsKeeper.SetBond(ctx, token, bond)
+	require.Nil(t, addToReserve(app, ctx, token,
+		sdk.NewCoins(sdk.NewInt64Coin(stakingDenom, 1000))))
+
+	// The surplus (rounded up) is neither liquid nor counted as a loss
+	require.Equal(t, sdk.NewInt(997), app.BondsKeeper.GetLiquidReserve(ctx, token))
+	app.BondsKeeper.SyncStakedReserve(ctx, token)
+	bond = app.BondsKeeper.MustGetBond(ctx, token)
+	require.Equal(t, sdk.ZeroInt(), bond.Staking.Losses)
+
+	// The whole tokens of the surplus can still be swept
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(stakingDenom, 2)),
+		app.BondsKeeper.GetSweepableRoundingSurplus(ctx, token))
+}
+
+func TestFailedUndelegationsAreEmittedAsEvents(t *testing.T) {
+	app, ctx := createTestApp(false)
+	stakingDenom := app.StakingKeeper.BondDenom(ctx)
+	validator := createBondedValidator(t, app, ctx)
+
+	// Allow only one unbonding entry per delegator and validator
+	stakingParams := app.StakingKeeper.GetParams(ctx)
+	stakingParams.MaxEntries = 1
+	app.StakingKeeper.SetParams(ctx, stakingParams)
+
+	// Add bond with a reserve of 2000, of which 1000 is delegated
+	app.BondsKeeper.SetBond(ctx, token, getValidStakingBond(stakingDenom))
+	require.Nil(t, addToReserve(app, ctx, token,
+		sdk.NewCoins(sdk.NewInt64Coin(stakingDenom, 2000))))
+	err := app.BondsKeeper.DelegateReserve(ctx, token, validator, sdk.NewInt(1000))
+	require.Nil(t, err)
+
+	// Undelegate 100, which uses up the only unbonding entry
+	_, err = app.BondsKeeper.UndelegateReserve(ctx, token, validator.OperatorAddress, sdk.NewInt(100))
+	require.Nil(t, err)
+
+	// Queue a sell of 1500, for which 400 more has to be undelegated
+	app.BondsKeeper.QueueSell(ctx, token, types.NewQueuedSell(sellerAddress,
+		sdk.NewInt64Coin(token, 1), sdk.NewCoins(sdk.NewInt64Coin(stakingDenom, 1500)), nil))
+
+	// The undelegation fails, which is emitted as an event
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	app.BondsKeeper.UndelegateForQueuedSells(ctx, token)
+	require.Equal(t, sdk.NewInt(900), app.BondsKeeper.GetDelegatedReserve(ctx, token))
+
+	events := ctx.EventManager().Events()
+	failed := events[len(events)-1]
+	require.Equal(t, types.EventTypeUndelegateReserveFailed, failed.Type)
+	require.Equal(t, types.AttributeKeyError, string(failed.Attributes[3].Key))
+}
+
+func TestFailedStakingRewardCollectionsAreEmittedAsEvents(t *testing.T) {
+	app, ctx := createTestApp(false)
+	stakingDenom := app.StakingKeeper.BondDenom(ctx)
+	validator := createBondedValidator(t, app, ctx)
+
+	// Add bond with a reserve of 2000, of which 1000 is delegated
+	app.BondsKeeper.SetBond(ctx, token, getValidStakingBond(stakingDenom))
+	require.Nil(t, addToReserve(app, ctx, token,
+		sdk.NewCoins(sdk.NewInt64Coin(stakingDenom, 2000))))
+	err := app.BondsKeeper.DelegateReserve(ctx, token, validator, sdk.NewInt(1000))
+	require.Nil(t, err)
+	bondBefore := app.BondsKeeper.MustGetBond(ctx, token)
+
+	// Withdrawing the rewards fails without the delegation's starting info
+	reserveAddress := bondBefore.ReserveAddress
+	app.DistrKeeper.DeleteDelegatorStartingInfo(ctx, validator.OperatorAddress, reserveAddress)
+
+	// The failure does not panic, leaves the bond as it was, and is emitted
+	// as an event
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NotPanics(t, func() { app.BondsKeeper.CollectStakingRewards(ctx, token) })
+	require.Equal(t, bondBefore, app.BondsKeeper.MustGetBond(ctx, token))
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeStakingRewardsFailed, events[0].Type)
+	require.Equal(t, types.AttributeKeyError, string(events[0].Attributes[2].Key))
+}
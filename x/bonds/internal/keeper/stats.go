@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixoworld/bonds/x/bonds/internal/types"
+)
+
+func (k Keeper) GetAllBondStatsIterator(ctx sdk.Context) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, types.BondStatsKeyPrefix)
+}
+
+func (k Keeper) MustGetBondStatsByKey(ctx sdk.Context, key []byte) types.BondStats {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(key) {
+		panic("bond stats not found")
+	}
+	bz := store.Get(key)
+	var bondStats types.BondStats
+	k.cdc.MustUnmarshalBinaryBare(bz, &bondStats)
+	return bondStats
+}
+
+func (k Keeper) GetBondStats(ctx sdk.Context, token string) types.BondStats {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetBondStatsKey(token))
+	if bz == nil {
+		return types.NewBondStats(token)
+	}
+	var bondStats types.BondStats
+	k.cdc.MustUnmarshalBinaryBare(bz, &bondStats)
+	return bondStats
+}
+
+func (k Keeper) SetBondStats(ctx sdk.Context, bondStats types.BondStats) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetBondStatsKey(bondStats.Token),
+		k.cdc.MustMarshalBinaryBare(bondStats))
+}
+
+func (k Keeper) GetAllBondBuyersIterator(ctx sdk.Context) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, types.BondBuyersKeyPrefix)
+}
+
+func (k Keeper) GetBondBuyersIterator(ctx sdk.Context, token string) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, types.GetBondBuyersPrefix(token))
+}
+
+func (k Keeper) MustGetBondBuyerByKey(ctx sdk.Context, key []byte) types.BondBuyer {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(key) {
+		panic("bond buyer not found")
+	}
+	bz := store.Get(key)
+	var bondBuyer types.BondBuyer
+	k.cdc.MustUnmarshalBinaryBare(bz, &bondBuyer)
+	return bondBuyer
+}
+
+func (k Keeper) IsBondBuyer(ctx sdk.Context, token string, address sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetBondBuyerKey(token, address))
+}
+
+func (k Keeper) SetBondBuyer(ctx sdk.Context, bondBuyer types.BondBuyer) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetBondBuyerKey(bondBuyer.Token, bondBuyer.Address),
+		k.cdc.MustMarshalBinaryBare(bondBuyer))
+}
+
+func (k Keeper) DeleteBondStats(ctx sdk.Context, token string) {
+	// Deletes the bond's stats together with its buyers
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetBondStatsKey(token))
+
+	iterator := k.GetBondBuyersIterator(ctx, token)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+func (k Keeper) RecordBuyStats(ctx sdk.Context, token string,
+	buyer sdk.AccAddress, amount sdk.Int, fees sdk.Coins) {
+	newBuyer := !k.IsBondBuyer(ctx, token, buyer)
+	if newBuyer {
+		k.SetBondBuyer(ctx, types.NewBondBuyer(token, buyer))
+	}
+	bondStats := k.GetBondStats(ctx, token)
+	k.SetBondStats(ctx, bondStats.AddBuy(amount, fees, newBuyer))
+}
+
+func (k Keeper) RecordSellStats(ctx sdk.Context, token string, amount sdk.Int, fees sdk.Coins) {
+	bondStats := k.GetBondStats(ctx, token)
+	k.SetBondStats(ctx, bondStats.AddSell(amount, fees))
+}
+
+func (k Keeper) RecordSwapStats(ctx sdk.Context, token string, amount sdk.Coin, fees sdk.Coins) {
+	bondStats := k.GetBondStats(ctx, token)
+	k.SetBondStats(ctx, bondStats.AddSwap(amount, fees))
+}
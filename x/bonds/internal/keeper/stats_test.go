@@ -0,0 +1,42 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixoworld/bonds/x/bonds/internal/types"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestRecordAndDeleteBondStats(t *testing.T) {
+	app, ctx := createTestApp(false)
+	fees := sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 1))
+
+	// Stats of a bond with no performed orders
+	require.Equal(t, types.NewBondStats(token), app.BondsKeeper.GetBondStats(ctx, token))
+
+	// Repeated buys by the same buyer count as one unique buyer
+	app.BondsKeeper.RecordBuyStats(ctx, token, buyerAddress, sdk.NewInt(10), fees)
+	app.BondsKeeper.RecordBuyStats(ctx, token, buyerAddress, sdk.NewInt(20), fees)
+	app.BondsKeeper.RecordBuyStats(ctx, token, sellerAddress, sdk.NewInt(5), fees)
+	app.BondsKeeper.RecordSellStats(ctx, token, sdk.NewInt(15), fees)
+	app.BondsKeeper.RecordSwapStats(ctx, token, sdk.NewInt64Coin(reserveToken, 7), nil)
+
+	stats := app.BondsKeeper.GetBondStats(ctx, token)
+	require.Equal(t, sdk.NewUint(3), stats.Buys)
+	require.Equal(t, sdk.NewUint(2), stats.UniqueBuyers)
+	require.Equal(t, sdk.NewInt(35), stats.TotalMinted)
+	require.Equal(t, sdk.NewInt(15), stats.TotalBurned)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 4)), stats.TotalFees)
+	require.True(t, app.BondsKeeper.IsBondBuyer(ctx, token, buyerAddress))
+	require.True(t, app.BondsKeeper.IsBondBuyer(ctx, token, sellerAddress))
+
+	// Other bonds' stats are unaffected by deleting the bond's stats
+	app.BondsKeeper.RecordBuyStats(ctx, token1, buyerAddress, sdk.NewInt(10), fees)
+	app.BondsKeeper.DeleteBondStats(ctx, token)
+
+	require.Equal(t, types.NewBondStats(token), app.BondsKeeper.GetBondStats(ctx, token))
+	require.False(t, app.BondsKeeper.IsBondBuyer(ctx, token, buyerAddress))
+	require.False(t, app.BondsKeeper.IsBondBuyer(ctx, token, sellerAddress))
+	require.True(t, app.BondsKeeper.IsBondBuyer(ctx, token1, buyerAddress))
+	require.Equal(t, sdk.OneUint(), app.BondsKeeper.GetBondStats(ctx, token1).Buys)
+}
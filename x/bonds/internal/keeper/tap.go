@@ -0,0 +1,202 @@
+package keeper
+
+import (
+	"fmt"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixoworld/bonds/x/bonds/internal/types"
+)
+
+func (k Keeper) GetAllTapVotesIterator(ctx sdk.Context) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, types.TapVotesKeyPrefix)
+}
+
+func (k Keeper) GetTapVotesIterator(ctx sdk.Context, token string) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, types.GetTapVotesPrefix(token))
+}
+
+func (k Keeper) MustGetTapVoteByKey(ctx sdk.Context, key []byte) types.TapVote {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(key) {
+		panic("tap vote not found")
+	}
+	bz := store.Get(key)
+	var tapVote types.TapVote
+	k.cdc.MustUnmarshalBinaryBare(bz, &tapVote)
+	return tapVote
+}
+
+func (k Keeper) GetTapVote(ctx sdk.Context, token string, voter sdk.AccAddress) (tapVote types.TapVote, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTapVotesKey(token, voter))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &tapVote)
+	return tapVote, true
+}
+
+func (k Keeper) GetTapVotes(ctx sdk.Context, token string) (tapVotes []types.TapVote) {
+	iterator := k.GetTapVotesIterator(ctx, token)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		tapVotes = append(tapVotes, k.MustGetTapVoteByKey(ctx, iterator.Key()))
+	}
+	return tapVotes
+}
+
+func (k Keeper) SetTapVote(ctx sdk.Context, tapVote types.TapVote) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTapVotesKey(tapVote.Token, tapVote.Voter),
+		k.cdc.MustMarshalBinaryBare(tapVote))
+}
+
+func (k Keeper) PruneTapVote(ctx sdk.Context, token string, voter sdk.AccAddress) {
+	// A vote from an address that no longer holds any bond tokens carries no
+	// weight, so it is deleted rather than being tallied on every later vote
+	if k.CoinKeeper.GetCoins(ctx, voter).AmountOf(token).IsZero() {
+		store := ctx.KVStore(k.storeKey)
+		store.Delete(types.GetTapVotesKey(token, voter))
+	}
+}
+
+func (k Keeper) DeleteTapVotes(ctx sdk.Context, token, option string) {
+	// Deletes the bond's votes for the option, or all of its votes if the
+	// option is empty
+	store := ctx.KVStore(k.storeKey)
+	for _, tapVote := range k.GetTapVotes(ctx, token) {
+		if option == "" || tapVote.Option == option {
+			store.Delete(types.GetTapVotesKey(token, tapVote.Voter))
+		}
+	}
+}
+
+func (k Keeper) GetTapVoteTally(ctx sdk.Context, token, option string) sdk.Int {
+	// Votes are weighted by the voters' current bond token balances, so
+	// tokens transferred after voting no longer count towards the vote
+	tally := sdk.ZeroInt()
+	for _, tapVote := range k.GetTapVotes(ctx, token) {
+		if tapVote.Option == option {
+			balance := k.CoinKeeper.GetCoins(ctx, tapVote.Voter).AmountOf(token)
+			tally = tally.Add(balance)
+		}
+	}
+	return tally
+}
+
+func (k Keeper) TapVotePasses(ctx sdk.Context, token, option string) bool {
+	// A vote passes once the voters hold more than half of all bond tokens
+	tally := k.GetTapVoteTally(ctx, token, option)
+	totalTokens := k.GetTotalBondTokens(ctx, token)
+	return tally.IsPositive() && tally.MulRaw(2).GT(totalTokens)
+}
+
+func (k Keeper) GetTapAvailable(ctx sdk.Context, token string) sdk.Coins {
+	bond := k.MustGetBond(ctx, token)
+	reserveBalances := k.GetReserveBalances(ctx, token)
+	available := bond.GetTapAvailable(reserveBalances, ctx.BlockHeight())
+	if available.IsZero() {
+		return nil
+	}
+	return bond.GetNewReserveCoins(available)
+}
+
+func (k Keeper) WithdrawTap(ctx sdk.Context, token string) (withdrawn sdk.Coins, err sdk.Error) {
+	bond := k.MustGetBond(ctx, token)
+	if !bond.HasTap() {
+		return nil, types.ErrBondDoesNotHaveTap(types.DefaultCodespace, token)
+	} else if bond.IsDissolved() {
+		return nil, types.ErrBondIsDissolved(types.DefaultCodespace, token)
+	}
+
+	withdrawn = k.GetTapAvailable(ctx, token)
+	if withdrawn.IsZero() {
+		return nil, types.ErrNoTapFundsToDraw(types.DefaultCodespace, token)
+	}
+
+	err = k.WithdrawReserve(ctx, token, bond.Tap.Beneficiary, withdrawn)
+	if err != nil {
+		return nil, err
+	}
+	k.SubtractExpectedReserve(ctx, token, sdk.NewDecCoins(withdrawn))
+
+	// Any accrued amount that could not be withdrawn because of the floor is
+	// forfeited, so that the tap cannot build up a backlog to drain the
+	// reserve as soon as it grows above the floor
+	bond = k.MustGetBond(ctx, token)
+	tap := bond.Tap.Accrue(ctx.BlockHeight())
+	tap.Accrued = sdk.ZeroInt()
+	tap.Withdrawn = tap.Withdrawn.Add(withdrawn[0].Amount)
+	bond.Tap = &tap
+	k.SetBond(ctx, token, bond)
+
+	return withdrawn, nil
+}
+
+func (k Keeper) RaiseTap(ctx sdk.Context, token string) {
+	bond := k.MustGetBond(ctx, token)
+
+	// Lock in the amount accrued at the old rate before applying the new rate
+	tap := bond.Tap.Accrue(ctx.BlockHeight())
+	tap.Rate = tap.ProposedRate
+	tap.ProposedRate = sdk.ZeroInt()
+	bond.Tap = &tap
+	k.SetBond(ctx, token, bond)
+
+	k.DeleteTapVotes(ctx, token, types.TapVoteRaise)
+
+	logger := k.Logger(ctx)
+	logger.Info(fmt.Sprintf("bond %s tap raised to %s", token, bond.Tap.Rate))
+}
+
+func (k Keeper) DissolveBond(ctx sdk.Context, token string) {
+	bond := k.MustGetBond(ctx, token)
+	bond.Dissolved = types.TRUE
+	k.SetBond(ctx, token, bond)
+
+	k.DeleteTapVotes(ctx, token, "")
+
+	logger := k.Logger(ctx)
+	logger.Info(fmt.Sprintf("bond %s dissolved", token))
+}
+
+func (k Keeper) Refund(ctx sdk.Context, holder sdk.AccAddress, amount sdk.Coin) (refunds sdk.Coins, err sdk.Error) {
+	token := amount.Denom
+	bond := k.MustGetBond(ctx, token)
+	if !bond.IsDissolved() {
+		return nil, types.ErrBondIsNotDissolved(types.DefaultCodespace, token)
+	}
+
+	// Get refunds before the burn decreases the bond's current supply
+	reserveBalances := k.GetReserveBalances(ctx, token)
+	refunds = bond.GetRefundsForBurn(amount.Amount, reserveBalances)
+
+	// Send coins to be burned from holder (enforces amount <= balance)
+	err = k.SupplyKeeper.SendCoinsFromAccountToModule(ctx, holder,
+		types.BondsMintBurnAccount, sdk.Coins{amount})
+	if err != nil {
+		return nil, err
+	}
+
+	// Burn bond tokens to be refunded
+	err = k.SupplyKeeper.BurnCoins(ctx, types.BondsMintBurnAccount,
+		sdk.Coins{amount})
+	if err != nil {
+		return nil, err
+	}
+	k.SetCurrentSupply(ctx, token, bond.CurrentSupply.Sub(amount))
+
+	// Refund holder's share of the reserve (no fees are charged). Refunds are
+	// rounded down, and the remainder is left in the expected reserve that
+	// the remaining holders are refunded a share of.
+	if !refunds.IsZero() {
+		err = k.WithdrawReserve(ctx, token, holder, refunds)
+		if err != nil {
+			return nil, err
+		}
+		k.SubtractExpectedReserve(ctx, token, sdk.NewDecCoins(refunds))
+	}
+
+	return refunds, nil
+}
@@ -0,0 +1,110 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixoworld/bonds/x/bonds/internal/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"testing"
+)
+
+func TestTapVotesAreWeightedByCurrentBalances(t *testing.T) {
+	app, ctx := createTestApp(false)
+	addr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	addr2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	// Add bond and mint 75 tokens to addr1 and 25 tokens to addr2
+	app.BondsKeeper.SetBond(ctx, token, getValidBond())
+	tokens := sdk.NewCoins(sdk.NewInt64Coin(token, 100))
+	require.Nil(t, app.SupplyKeeper.MintCoins(ctx, types.BondsMintBurnAccount, tokens))
+	require.Nil(t, app.SupplyKeeper.SendCoinsFromModuleToAccount(ctx, types.BondsMintBurnAccount,
+		addr1, sdk.NewCoins(sdk.NewInt64Coin(token, 75))))
+	require.Nil(t, app.SupplyKeeper.SendCoinsFromModuleToAccount(ctx, types.BondsMintBurnAccount,
+		addr2, sdk.NewCoins(sdk.NewInt64Coin(token, 25))))
+
+	// addr1 votes to raise and addr2 votes to dissolve
+	app.BondsKeeper.SetTapVote(ctx, types.NewTapVote(token, addr1, types.TapVoteRaise))
+	app.BondsKeeper.SetTapVote(ctx, types.NewTapVote(token, addr2, types.TapVoteDissolve))
+	require.Equal(t, sdk.NewInt(75), app.BondsKeeper.GetTapVoteTally(ctx, token, types.TapVoteRaise))
+	require.Equal(t, sdk.NewInt(25), app.BondsKeeper.GetTapVoteTally(ctx, token, types.TapVoteDissolve))
+	require.True(t, app.BondsKeeper.TapVotePasses(ctx, token, types.TapVoteRaise))
+	require.False(t, app.BondsKeeper.TapVotePasses(ctx, token, types.TapVoteDissolve))
+
+	// Once addr1 sends 30 tokens to addr2, the vote to dissolve passes instead
+	require.Nil(t, app.BankKeeper.SendCoins(ctx, addr1, addr2,
+		sdk.NewCoins(sdk.NewInt64Coin(token, 30))))
+	require.False(t, app.BondsKeeper.TapVotePasses(ctx, token, types.TapVoteRaise))
+	require.True(t, app.BondsKeeper.TapVotePasses(ctx, token, types.TapVoteDissolve))
+
+	// A vote for another option replaces the voter's previous vote
+	app.BondsKeeper.SetTapVote(ctx, types.NewTapVote(token, addr1, types.TapVoteDissolve))
+	require.Len(t, app.BondsKeeper.GetTapVotes(ctx, token), 2)
+	require.Equal(t, sdk.ZeroInt(), app.BondsKeeper.GetTapVoteTally(ctx, token, types.TapVoteRaise))
+
+	// Deleting votes for an option keeps votes for other options
+	app.BondsKeeper.SetTapVote(ctx, types.NewTapVote(token, addr1, types.TapVoteRaise))
+	app.BondsKeeper.DeleteTapVotes(ctx, token, types.TapVoteRaise)
+	require.Equal(t, []types.TapVote{
+		types.NewTapVote(token, addr2, types.TapVoteDissolve),
+	}, app.BondsKeeper.GetTapVotes(ctx, token))
+
+	// Deleting votes without an option deletes all votes
+	app.BondsKeeper.DeleteTapVotes(ctx, token, "")
+	require.Empty(t, app.BondsKeeper.GetTapVotes(ctx, token))
+}
+
+func TestTapVotesArePrunedOnceBalanceDropsToZero(t *testing.T) {
+	app, ctx := createTestApp(false)
+	addr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	addr2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	// Add bond with a tap and mint 10 tokens to each of addr1 and addr2
+	bond := getValidBond()
+	tap := types.NewTap(initCreator, sdk.NewInt(100), sdk.NewDec(50), 0)
+	bond.Tap = &tap
+	app.BondsKeeper.SetBond(ctx, token, bond)
+	tokens := sdk.NewCoins(sdk.NewInt64Coin(token, 10))
+	require.Nil(t, app.SupplyKeeper.MintCoins(ctx, types.BondsMintBurnAccount, tokens.Add(tokens)))
+	require.Nil(t, app.SupplyKeeper.SendCoinsFromModuleToAccount(ctx, types.BondsMintBurnAccount, addr1, tokens))
+	require.Nil(t, app.SupplyKeeper.SendCoinsFromModuleToAccount(ctx, types.BondsMintBurnAccount, addr2, tokens))
+	app.BondsKeeper.SetTapVote(ctx, types.NewTapVote(token, addr1, types.TapVoteDissolve))
+	app.BondsKeeper.SetTapVote(ctx, types.NewTapVote(token, addr2, types.TapVoteDissolve))
+
+	// Vote is kept while the voter still holds some tokens
+	require.Nil(t, app.BankKeeper.SendCoins(ctx, addr1, addr2,
+		sdk.NewCoins(sdk.NewInt64Coin(token, 5))))
+	require.Len(t, app.BondsKeeper.GetTapVotes(ctx, token), 2)
+
+	// Vote is deleted once the voter sends away the rest of its tokens
+	require.Nil(t, app.BankKeeper.SendCoins(ctx, addr1, addr2,
+		sdk.NewCoins(sdk.NewInt64Coin(token, 5))))
+	require.Equal(t, []types.TapVote{
+		types.NewTapVote(token, addr2, types.TapVoteDissolve),
+	}, app.BondsKeeper.GetTapVotes(ctx, token))
+}
+
+func TestRefundBurnsTokensForShareOfReserve(t *testing.T) {
+	app, ctx := createTestApp(false)
+	addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	// Add dissolved bond with 300 supply and 1000 reserve, of which addr holds 100
+	bond := getValidBond()
+	bond.CurrentSupply = sdk.NewInt64Coin(token, 300)
+	bond.Dissolved = types.TRUE
+	app.BondsKeeper.SetBond(ctx, token, bond)
+	reserveCoins, _ := sdk.ParseCoins("1000res1")
+	require.Nil(t, setReserve(app, ctx, token, reserveCoins))
+	tokens := sdk.NewCoins(sdk.NewInt64Coin(token, 100))
+	require.Nil(t, app.SupplyKeeper.MintCoins(ctx, types.BondsMintBurnAccount, tokens))
+	require.Nil(t, app.SupplyKeeper.SendCoinsFromModuleToAccount(
+		ctx, types.BondsMintBurnAccount, addr, tokens))
+
+	// Refund is a third of the reserve, rounded down
+	refunds, err := app.BondsKeeper.Refund(ctx, addr, sdk.NewInt64Coin(token, 100))
+	require.Nil(t, err)
+	require.Equal(t, "333res1", refunds.String())
+	require.Equal(t, refunds, app.BankKeeper.GetCoins(ctx, addr))
+	require.Equal(t, "667res1", app.BondsKeeper.GetReserveBalances(ctx, token).String())
+	require.Equal(t, sdk.NewInt(200), app.BondsKeeper.MustGetBond(ctx, token).CurrentSupply.Amount)
+	require.Equal(t, sdk.ZeroInt(), app.BondsKeeper.GetTotalBondTokens(ctx, token))
+}
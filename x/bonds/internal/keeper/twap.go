@@ -0,0 +1,169 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixoworld/bonds/x/bonds/internal/types"
+)
+
+func (k Keeper) GetAllPriceObservationsIterator(ctx sdk.Context) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, types.PriceObservationsKeyPrefix)
+}
+
+func (k Keeper) GetPriceObservationsIterator(ctx sdk.Context, token string) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, types.GetPriceObservationsPrefix(token))
+}
+
+func (k Keeper) MustGetPriceObservationByKey(ctx sdk.Context, key []byte) types.PriceObservation {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(key) {
+		panic("price observation not found")
+	}
+	bz := store.Get(key)
+	var observation types.PriceObservation
+	k.cdc.MustUnmarshalBinaryBare(bz, &observation)
+	return observation
+}
+
+// GetPriceObservations returns the bond's price observations, sorted by height
+func (k Keeper) GetPriceObservations(ctx sdk.Context, token string) (observations []types.PriceObservation) {
+	iterator := k.GetPriceObservationsIterator(ctx, token)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		observations = append(observations, k.MustGetPriceObservationByKey(ctx, iterator.Key()))
+	}
+	return observations
+}
+
+// GetPriceObservationAtOrBefore returns the bond's newest price observation
+// that is not after the height
+func (k Keeper) GetPriceObservationAtOrBefore(ctx sdk.Context, token string, height int64) (observation types.PriceObservation, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.ReverseIterator(types.GetPriceObservationsPrefix(token),
+		types.GetPriceObservationKey(token, height+1))
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return types.PriceObservation{}, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &observation)
+	return observation, true
+}
+
+func (k Keeper) SetPriceObservation(ctx sdk.Context, observation types.PriceObservation) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPriceObservationKey(observation.Token, observation.Height),
+		k.cdc.MustMarshalBinaryBare(observation))
+}
+
+// RecordPriceObservation adds the bond's current prices to the bond's price
+// observations if they changed since the bond's last observation
+func (k Keeper) RecordPriceObservation(ctx sdk.Context, token string) {
+	bond := k.MustGetBond(ctx, token)
+
+	// Skip if the prices cannot be calculated (e.g. swapper function bond
+	// with no liquidity), in which case the last prices remain in effect
+	reserveBalances := k.GetReserveBalances(ctx, token)
+	prices, err := bond.GetCurrentPricesPT(reserveBalances)
+	if err != nil {
+		return
+	}
+	exchangeRates := bond.GetExchangeRates(prices)
+
+	height := ctx.BlockHeight()
+	last, found := k.GetPriceObservationAtOrBefore(ctx, token, height)
+	if !found {
+		k.SetPriceObservation(ctx, types.NewPriceObservation(
+			token, height, prices, exchangeRates))
+	} else if last.Prices.IsEqual(prices) && last.ExchangeRates.IsEqual(exchangeRates) {
+		// No need for a new observation if the prices did not change
+		return
+	} else if last.Height == height {
+		last.Prices = prices
+		last.ExchangeRates = exchangeRates
+		k.SetPriceObservation(ctx, last)
+	} else {
+		k.SetPriceObservation(ctx, last.Next(height, prices, exchangeRates))
+	}
+
+	k.prunePriceObservations(ctx, token, height)
+}
+
+func (k Keeper) prunePriceObservations(ctx sdk.Context, token string, height int64) {
+	// Keep the newest observation that is not after the cutoff, since it is
+	// needed to get the cumulative prices for heights from the cutoff onwards
+	cutoff := height - int64(k.GetParams(ctx).PriceObservationBlocks.Uint64())
+	if cutoff <= 0 {
+		return
+	}
+
+	// Collect keys first, since the store cannot be modified while iterating
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.ReverseIterator(types.GetPriceObservationsPrefix(token),
+		types.GetPriceObservationKey(token, cutoff+1))
+	var oldKeys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		oldKeys = append(oldKeys, iterator.Key())
+	}
+	iterator.Close()
+	if len(oldKeys) > 1 {
+		for _, key := range oldKeys[1:] {
+			store.Delete(key)
+		}
+	}
+}
+
+// GetCumulativePrices returns the bond's cumulative prices and (for swapper
+// function bonds) exchange rates up to a height that is not after the
+// current height, using the newest price observation at or before it
+func (k Keeper) GetCumulativePrices(ctx sdk.Context, token string, height int64) (cumulativePrices, cumulativeExchangeRates sdk.DecCoins, err sdk.Error) {
+	if height > ctx.BlockHeight() {
+		return nil, nil, types.ErrInvalidTwapWindow(
+			types.DefaultCodespace, height, height, ctx.BlockHeight())
+	}
+
+	observation, found := k.GetPriceObservationAtOrBefore(ctx, token, height)
+	if !found {
+		return nil, nil, types.ErrNoPriceObservation(types.DefaultCodespace, token, height)
+	}
+
+	cumulativePrices, cumulativeExchangeRates = observation.CumulativeAt(height)
+	return cumulativePrices, cumulativeExchangeRates, nil
+}
+
+// GetTwap returns the bond's time-weighted average prices and (for swapper
+// function bonds) exchange rates over the blocks from fromHeight up to
+// toHeight, which is not after the current height. Other modules can use this
+// as a price that is hard to manipulate, since a manipulated price affects
+// the average in proportion to the number of blocks that it remains in effect.
+func (k Keeper) GetTwap(ctx sdk.Context, token string, fromHeight, toHeight int64) (twap types.Twap, err sdk.Error) {
+	if fromHeight < 0 || fromHeight >= toHeight || toHeight > ctx.BlockHeight() {
+		return types.Twap{}, types.ErrInvalidTwapWindow(
+			types.DefaultCodespace, fromHeight, toHeight, ctx.BlockHeight())
+	}
+
+	fromPrices, fromRates, err := k.GetCumulativePrices(ctx, token, fromHeight)
+	if err != nil {
+		return types.Twap{}, err
+	}
+	toPrices, toRates, err := k.GetCumulativePrices(ctx, token, toHeight)
+	if err != nil {
+		return types.Twap{}, err
+	}
+
+	return types.NewTwap(token, fromHeight, toHeight,
+		fromPrices, fromRates, toPrices, toRates), nil
+}
+
+func (k Keeper) DeletePriceObservations(ctx sdk.Context, token string) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := k.GetPriceObservationsIterator(ctx, token)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,110 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixoworld/bonds/x/bonds/internal/types"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestRecordPriceObservationAndGetTwap(t *testing.T) {
+	app, ctx := createTestApp(false)
+	bond := getValidBond()
+	app.BondsKeeper.SetBond(ctx, token, bond)
+
+	recordAt := func(height int64, supply int64) {
+		bond.CurrentSupply = sdk.NewInt64Coin(token, supply)
+		app.BondsKeeper.SetBond(ctx, token, bond)
+		ctx = ctx.WithBlockHeight(height)
+		app.BondsKeeper.RecordPriceObservation(ctx, token)
+	}
+	prices := func(price int64) sdk.DecCoins {
+		return sdk.NewDecCoins(sdk.NewCoins(sdk.NewInt64Coin(reserveToken, price)))
+	}
+
+	// First observation (y = 12x^2 + 100) has no cumulative prices
+	recordAt(10, 0)
+	o10 := types.NewPriceObservation(token, 10, prices(100), nil)
+	require.Equal(t, []types.PriceObservation{o10},
+		app.BondsKeeper.GetPriceObservations(ctx, token))
+
+	// No new observation if the prices did not change
+	recordAt(15, 0)
+	require.Equal(t, []types.PriceObservation{o10},
+		app.BondsKeeper.GetPriceObservations(ctx, token))
+
+	// New observation accumulates the previous prices
+	recordAt(20, 10)
+	o20 := o10.Next(20, prices(1300), nil)
+	require.Equal(t, prices(1000), o20.CumulativePrices)
+	require.Equal(t, []types.PriceObservation{o10, o20},
+		app.BondsKeeper.GetPriceObservations(ctx, token))
+
+	// Observation at the same height replaces the observation's prices
+	recordAt(20, 5)
+	o20.Prices = prices(400)
+	require.Equal(t, []types.PriceObservation{o10, o20},
+		app.BondsKeeper.GetPriceObservations(ctx, token))
+
+	// TWAP over all blocks: (100*10 + 400*10) / 20
+	ctx = ctx.WithBlockHeight(30)
+	twap, err := app.BondsKeeper.GetTwap(ctx, token, 10, 30)
+	require.Nil(t, err)
+	require.Equal(t, types.Twap{Token: token, FromHeight: 10, ToHeight: 30,
+		Prices: prices(250), ExchangeRates: nil}, twap)
+
+	// TWAP over blocks between observations: (100*8) / 8
+	twap, err = app.BondsKeeper.GetTwap(ctx, token, 12, 20)
+	require.Nil(t, err)
+	require.Equal(t, prices(100), twap.Prices)
+
+	// Error if window is empty or ends after the current height
+	_, err = app.BondsKeeper.GetTwap(ctx, token, 20, 20)
+	require.Equal(t, types.CodeInvalidTwapWindow, err.Code())
+	_, err = app.BondsKeeper.GetTwap(ctx, token, 20, 31)
+	require.Equal(t, types.CodeInvalidTwapWindow, err.Code())
+
+	// Error if window starts before the first observation
+	_, err = app.BondsKeeper.GetTwap(ctx, token, 5, 20)
+	require.Equal(t, types.CodeNoPriceObservation, err.Code())
+
+	// Observations older than the newest one before the cutoff are deleted
+	params := app.BondsKeeper.GetParams(ctx)
+	params.PriceObservationBlocks = sdk.NewUint(5)
+	app.BondsKeeper.SetParams(ctx, params)
+	recordAt(40, 10)
+	o40 := o20.Next(40, prices(1300), nil)
+	require.Equal(t, []types.PriceObservation{o20, o40},
+		app.BondsKeeper.GetPriceObservations(ctx, token))
+
+	// Observations deleted
+	app.BondsKeeper.DeletePriceObservations(ctx, token)
+	require.Nil(t, app.BondsKeeper.GetPriceObservations(ctx, token))
+}
+
+func TestRecordPriceObservationForSwapper(t *testing.T) {
+	app, ctx := createTestApp(false)
+	ctx = ctx.WithBlockHeight(10)
+	bond := getValidSwapperBond()
+	app.BondsKeeper.SetBond(ctx, token, bond)
+
+	// Swapper function bond with no liquidity has no prices
+	app.BondsKeeper.RecordPriceObservation(ctx, token)
+	require.Nil(t, app.BondsKeeper.GetPriceObservations(ctx, token))
+
+	// Observation includes the exchange rates between the reserve tokens
+	bond.CurrentSupply = sdk.NewInt64Coin(token, 2)
+	app.BondsKeeper.SetBond(ctx, token, bond)
+	err := setReserve(app, ctx, token, sdk.NewCoins(
+		sdk.NewInt64Coin(reserveToken, 100), sdk.NewInt64Coin(reserveToken2, 400)))
+	require.Nil(t, err)
+	app.BondsKeeper.RecordPriceObservation(ctx, token)
+
+	observations := app.BondsKeeper.GetPriceObservations(ctx, token)
+	require.Len(t, observations, 1)
+	expectedRates := sdk.DecCoins{
+		sdk.NewInt64DecCoin(reserveToken, 4),
+		sdk.NewDecCoinFromDec(reserveToken2, sdk.MustNewDecFromStr("0.25")),
+	}
+	require.Equal(t, expectedRates, observations[0].ExchangeRates)
+}
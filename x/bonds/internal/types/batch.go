@@ -19,6 +19,7 @@ type Batch struct {
 func (b Batch) MoreBuysThanSells() bool { return b.TotalSellAmount.IsLT(b.TotalBuyAmount) }
 func (b Batch) MoreSellsThanBuys() bool { return b.TotalBuyAmount.IsLT(b.TotalSellAmount) }
 func (b Batch) EqualBuysAndSells() bool { return b.TotalBuyAmount.IsEqual(b.TotalSellAmount) }
+func (b Batch) NumberOfOrders() int     { return len(b.Buys) + len(b.Sells) + len(b.Swaps) }
 
 func NewBatch(token string, blocks sdk.Uint) Batch {
 	return Batch{
@@ -51,23 +52,31 @@ func (bo BaseOrder) IsCancelled() bool {
 
 type BuyOrder struct {
 	BaseOrder
-	MaxPrices sdk.Coins `json:"max_prices" yaml:"max_prices"`
+	MaxPrices sdk.Coins      `json:"max_prices" yaml:"max_prices"`
+	Referrer  sdk.AccAddress `json:"referrer" yaml:"referrer"`
 }
 
-func NewBuyOrder(address sdk.AccAddress, amount sdk.Coin, maxPrices sdk.Coins) BuyOrder {
+func NewBuyOrder(address sdk.AccAddress, amount sdk.Coin, maxPrices sdk.Coins, referrer sdk.AccAddress) BuyOrder {
 	return BuyOrder{
 		BaseOrder: NewBaseOrder(address, amount),
 		MaxPrices: maxPrices,
+		Referrer:  referrer,
 	}
 }
 
+func (bo BuyOrder) HasReferrer() bool {
+	return !bo.Referrer.Empty()
+}
+
 type SellOrder struct {
 	BaseOrder
+	ExitFeePercentage sdk.Dec `json:"exit_fee_percentage" yaml:"exit_fee_percentage"`
 }
 
-func NewSellOrder(address sdk.AccAddress, amount sdk.Coin) SellOrder {
+func NewSellOrder(address sdk.AccAddress, amount sdk.Coin, exitFeePercentage sdk.Dec) SellOrder {
 	return SellOrder{
-		BaseOrder: NewBaseOrder(address, amount),
+		BaseOrder:         NewBaseOrder(address, amount),
+		ExitFeePercentage: exitFeePercentage,
 	}
 }
 
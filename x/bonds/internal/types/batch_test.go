@@ -82,7 +82,7 @@ func TestNewBuyOrderDefaultValues(t *testing.T) {
 	amount2 := sdk.NewInt64Coin("token2", 2000)
 	amount3 := sdk.NewInt64Coin("token3", 3000)
 	maxPrices := sdk.NewCoins(amount2, amount3)
-	order := NewBuyOrder(address, amount1, maxPrices)
+	order := NewBuyOrder(address, amount1, maxPrices, nil)
 
 	require.Equal(t, address, order.Address)
 	require.Equal(t, amount1, order.Amount)
@@ -94,9 +94,11 @@ func TestNewBuyOrderDefaultValues(t *testing.T) {
 func TestNewSellOrderDefaultValues(t *testing.T) {
 	address := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
 	amount := sdk.NewInt64Coin("token", 1000)
-	order := NewSellOrder(address, amount)
+	exitFeePercentage := sdk.MustNewDecFromStr("0.1")
+	order := NewSellOrder(address, amount, exitFeePercentage)
 
 	require.Equal(t, address, order.Address)
+	require.Equal(t, exitFeePercentage, order.ExitFeePercentage)
 	require.Equal(t, amount, order.Amount)
 	require.Equal(t, FALSE, order.Cancelled)
 	require.Empty(t, order.CancelReason)
@@ -13,6 +13,11 @@ const (
 	DoNotModifyField = "[do-not-modify]"
 
 	AnyNumberOfReserveTokens = -1
+
+	CircuitBreakerCancel = "cancel"
+	CircuitBreakerHalt   = "halt"
+
+	maxSwapInputBitLen = 128
 )
 
 var (
@@ -64,25 +69,48 @@ func (fps FunctionParams) AsMap() (paramsMap map[string]sdk.Int) {
 }
 
 type Bond struct {
-	Token                  string           `json:"token" yaml:"token"`
-	Name                   string           `json:"name" yaml:"name"`
-	Description            string           `json:"description" yaml:"description"`
-	Creator                sdk.AccAddress   `json:"creator" yaml:"creator"`
-	FunctionType           string           `json:"function_type" yaml:"function_type"`
-	FunctionParameters     FunctionParams   `json:"function_parameters" yaml:"function_parameters"`
-	ReserveTokens          []string         `json:"reserve_tokens" yaml:"reserve_tokens"`
-	ReserveAddress         sdk.AccAddress   `json:"reserve_address" yaml:"reserve_address"`
-	TxFeePercentage        sdk.Dec          `json:"tx_fee_percentage" yaml:"tx_fee_percentage"`
-	ExitFeePercentage      sdk.Dec          `json:"exit_fee_percentage" yaml:"exit_fee_percentage"`
-	FeeAddress             sdk.AccAddress   `json:"fee_address" yaml:"fee_address"`
-	MaxSupply              sdk.Coin         `json:"max_supply" yaml:"max_supply"`
-	OrderQuantityLimits    sdk.Coins        `json:"order_quantity_limits" yaml:"order_quantity_limits"`
-	SanityRate             sdk.Dec          `json:"sanity_rate" yaml:"sanity_rate"`
-	SanityMarginPercentage sdk.Dec          `json:"sanity_margin_percentage" yaml:"sanity_margin_percentage"`
-	CurrentSupply          sdk.Coin         `json:"current_supply" yaml:"current_supply"`
-	AllowSells             string           `json:"allow_sells" yaml:"allow_sells"`
-	Signers                []sdk.AccAddress `json:"signers" yaml:"signers"`
-	BatchBlocks            sdk.Uint         `json:"batch_blocks" yaml:"batch_blocks"`
+	Token                   string           `json:"token" yaml:"token"`
+	Name                    string           `json:"name" yaml:"name"`
+	Description             string           `json:"description" yaml:"description"`
+	Creator                 sdk.AccAddress   `json:"creator" yaml:"creator"`
+	FunctionType            string           `json:"function_type" yaml:"function_type"`
+	FunctionParameters      FunctionParams   `json:"function_parameters" yaml:"function_parameters"`
+	ReserveTokens           []string         `json:"reserve_tokens" yaml:"reserve_tokens"`
+	ReserveAddress          sdk.AccAddress   `json:"reserve_address" yaml:"reserve_address"`
+	TxFeePercentage         sdk.Dec          `json:"tx_fee_percentage" yaml:"tx_fee_percentage"`
+	ExitFeePercentage       sdk.Dec          `json:"exit_fee_percentage" yaml:"exit_fee_percentage"`
+	MinExitFeePercentage    sdk.Dec          `json:"min_exit_fee_percentage" yaml:"min_exit_fee_percentage"`
+	ExitFeeHoldingPeriod    sdk.Uint         `json:"exit_fee_holding_period" yaml:"exit_fee_holding_period"`
+	FeeAddress              sdk.AccAddress   `json:"fee_address" yaml:"fee_address"`
+	FeeRecipients           FeeRecipients    `json:"fee_recipients" yaml:"fee_recipients"`
+	HolderRewardsPercentage sdk.Dec          `json:"holder_rewards_percentage" yaml:"holder_rewards_percentage"`
+	RewardPerToken          sdk.DecCoins     `json:"reward_per_token" yaml:"reward_per_token"`
+	RewardsPool             sdk.Coins        `json:"rewards_pool" yaml:"rewards_pool"`
+	LiquidityFeePercentage  sdk.Dec          `json:"liquidity_fee_percentage" yaml:"liquidity_fee_percentage"`
+	ReferralFeePercentage   sdk.Dec          `json:"referral_fee_percentage" yaml:"referral_fee_percentage"`
+	FeeSchedule             FeeSchedule      `json:"fee_schedule" yaml:"fee_schedule"`
+	RecentPrices            []sdk.DecCoins   `json:"recent_prices" yaml:"recent_prices"`
+	MaxSupply               sdk.Coin         `json:"max_supply" yaml:"max_supply"`
+	OrderQuantityLimits     sdk.Coins        `json:"order_quantity_limits" yaml:"order_quantity_limits"`
+	SanityRate              sdk.Dec          `json:"sanity_rate" yaml:"sanity_rate"`
+	SanityMarginPercentage  sdk.Dec          `json:"sanity_margin_percentage" yaml:"sanity_margin_percentage"`
+	CurrentSupply           sdk.Coin         `json:"current_supply" yaml:"current_supply"`
+	CurrentReserve          sdk.Coins        `json:"current_reserve" yaml:"current_reserve"`
+	ExpectedReserve         sdk.DecCoins     `json:"expected_reserve" yaml:"expected_reserve"`
+	RoundingSurplus         sdk.DecCoins     `json:"rounding_surplus" yaml:"rounding_surplus"`
+	AllowSells              string           `json:"allow_sells" yaml:"allow_sells"`
+	Signers                 []sdk.AccAddress `json:"signers" yaml:"signers"`
+	BatchBlocks             sdk.Uint         `json:"batch_blocks" yaml:"batch_blocks"`
+	Deposit                 sdk.Coins        `json:"deposit" yaml:"deposit"`
+	Paused                  string           `json:"paused" yaml:"paused"`
+	MaxPriceMovePercentage  sdk.Dec          `json:"max_price_move_percentage" yaml:"max_price_move_percentage"`
+	CircuitBreakerMode      string           `json:"circuit_breaker_mode" yaml:"circuit_breaker_mode"`
+	CircuitBreakerCooldown  sdk.Uint         `json:"circuit_breaker_cooldown" yaml:"circuit_breaker_cooldown"`
+	HaltBlocksRemaining     sdk.Uint         `json:"halt_blocks_remaining" yaml:"halt_blocks_remaining"`
+	Roles                   BondRoles        `json:"roles" yaml:"roles"`
+	Tap                     *Tap             `json:"tap" yaml:"tap"`
+	Dissolved               string           `json:"dissolved" yaml:"dissolved"`
+	Staking                 *ReserveStaking  `json:"staking" yaml:"staking"`
 }
 
 func NewBond(token, name, description string, creator sdk.AccAddress,
@@ -98,26 +126,105 @@ func NewBond(token, name, description string, creator sdk.AccAddress,
 	orderQuantityLimits = orderQuantityLimits.Sort()
 
 	return Bond{
-		Token:                  token,
-		Name:                   name,
-		Description:            description,
-		Creator:                creator,
-		FunctionType:           functionType,
-		FunctionParameters:     functionParameters,
-		ReserveTokens:          reserveTokens,
-		ReserveAddress:         reserveAdddress,
-		TxFeePercentage:        txFeePercentage,
-		ExitFeePercentage:      exitFeePercentage,
-		FeeAddress:             feeAddress,
-		MaxSupply:              maxSupply,
-		OrderQuantityLimits:    orderQuantityLimits,
-		SanityRate:             sanityRate,
-		SanityMarginPercentage: sanityMarginPercentage,
-		CurrentSupply:          sdk.NewCoin(token, sdk.ZeroInt()),
-		AllowSells:             allowSells,
-		Signers:                signers,
-		BatchBlocks:            batchBlocks,
+		Token:                   token,
+		Name:                    name,
+		Description:             description,
+		Creator:                 creator,
+		FunctionType:            functionType,
+		FunctionParameters:      functionParameters,
+		ReserveTokens:           reserveTokens,
+		ReserveAddress:          reserveAdddress,
+		TxFeePercentage:         txFeePercentage,
+		ExitFeePercentage:       exitFeePercentage,
+		MinExitFeePercentage:    exitFeePercentage,
+		ExitFeeHoldingPeriod:    sdk.ZeroUint(),
+		FeeAddress:              feeAddress,
+		FeeRecipients:           NewDefaultFeeRecipients(feeAddress),
+		HolderRewardsPercentage: sdk.ZeroDec(),
+		LiquidityFeePercentage:  sdk.ZeroDec(),
+		ReferralFeePercentage:   sdk.ZeroDec(),
+		FeeSchedule:             NewDefaultFeeSchedule(),
+		MaxSupply:               maxSupply,
+		OrderQuantityLimits:     orderQuantityLimits,
+		SanityRate:              sanityRate,
+		SanityMarginPercentage:  sanityMarginPercentage,
+		CurrentSupply:           sdk.NewCoin(token, sdk.ZeroInt()),
+		ExpectedReserve:         nil,
+		RoundingSurplus:         nil,
+		AllowSells:              allowSells,
+		Signers:                 signers,
+		BatchBlocks:             batchBlocks,
+		Paused:                  FALSE,
+		MaxPriceMovePercentage:  sdk.ZeroDec(),
+		CircuitBreakerMode:      CircuitBreakerCancel,
+		CircuitBreakerCooldown:  sdk.ZeroUint(),
+		HaltBlocksRemaining:     sdk.ZeroUint(),
+		Roles:                   NewDefaultBondRoles(signers),
+		Tap:                     nil,
+		Dissolved:               FALSE,
+		Staking:                 nil,
+	}
+}
+
+func (bond Bond) IsPaused() bool {
+	return bond.Paused == TRUE
+}
+
+func (bond Bond) IsHalted() bool {
+	return !bond.HaltBlocksRemaining.IsZero()
+}
+
+func (bond Bond) IsDissolved() bool {
+	return bond.Dissolved == TRUE
+}
+
+func (bond Bond) HasTap() bool {
+	// By default, a bond has no tap
+	return bond.Tap != nil
+}
+
+func (bond Bond) HasReserveStaking() bool {
+	// By default, a bond's reserve cannot be staked
+	return bond.Staking != nil
+}
+
+func (bond Bond) ReserveCanDivergeFromCurve() bool {
+	// Only a tap (withdrawals) or reserve staking (rewards and losses) can make
+	// the reserve differ from the curve integral, other than rounding surplus
+	return bond.HasTap() || bond.HasReserveStaking()
+}
+
+func (bond Bond) GetStakingCap() sdk.Int {
+	// Staking requires the reserve to consist of the staking denom only, so
+	// the cap is a percentage of the bond's only reserve balance
+	if !bond.HasReserveStaking() || bond.CurrentReserve.Empty() {
+		return sdk.ZeroInt()
+	}
+	return bond.Staking.CapPercentage.QuoInt64(100).MulInt(
+		bond.CurrentReserve[0].Amount).TruncateInt()
+}
+
+func (bond Bond) HasHolderRewards() bool {
+	// A zero (or missing) holder rewards percentage disables holder rewards
+	return !bond.HolderRewardsPercentage.IsNil() && bond.HolderRewardsPercentage.IsPositive()
+}
+
+func (bond Bond) HasReferralFee() bool {
+	// A zero (or missing) referral fee percentage disables referral fees
+	return !bond.ReferralFeePercentage.IsNil() && bond.ReferralFeePercentage.IsPositive()
+}
+
+func (bond Bond) HasMaxPriceMove() bool {
+	// A zero (or missing) max price move disables the circuit breaker
+	return !bond.MaxPriceMovePercentage.IsNil() && bond.MaxPriceMovePercentage.IsPositive()
+}
+
+//noinspection GoNilness
+func (bond Bond) GetNewReserveCoins(amount sdk.Int) (coins sdk.Coins) {
+	for _, r := range bond.ReserveTokens {
+		coins = coins.Add(sdk.Coins{sdk.NewCoin(r, amount)})
 	}
+	return coins
 }
 
 //noinspection GoNilness
@@ -226,6 +333,69 @@ func (bond Bond) CurveIntegral(supply sdk.Int) (result sdk.Dec) {
 	return result
 }
 
+func (bond Bond) GetReserveWithdrawn() sdk.Int {
+	// Funds withdrawn through the bond's tap and losses of staked reserve
+	// both leave the reserve below the curve integral
+	withdrawn := sdk.ZeroInt()
+	if bond.HasTap() {
+		withdrawn = withdrawn.Add(bond.Tap.Withdrawn)
+	}
+	if bond.HasReserveStaking() {
+		withdrawn = withdrawn.Add(bond.Staking.Losses)
+	}
+	return withdrawn
+}
+
+func (bond Bond) GetReserveExcludingSurplus(reserve sdk.Coin) sdk.Dec {
+	// The rounding surplus is excluded from the reserve that prices, tap
+	// withdrawals and refunds are based on, so that rounding remainders
+	// cannot make the reserve drift away from the curve integral
+	excluding := reserve.Amount.ToDec().Sub(bond.RoundingSurplus.AmountOf(reserve.Denom))
+	if excluding.IsNegative() {
+		return sdk.ZeroDec()
+	}
+	return excluding
+}
+
+func (bond Bond) GetTapFloor() sdk.Int {
+	// The floor is the share of the reserve required by the bonding curve
+	// at the current supply that the tap can never withdraw from
+	if !bond.HasTap() {
+		return sdk.ZeroInt()
+	}
+	floor := bond.Tap.FloorPercentage.QuoInt64(100).Mul(
+		bond.CurveIntegral(bond.CurrentSupply.Amount))
+	return floor.Ceil().TruncateInt()
+}
+
+func (bond Bond) GetTapAvailable(reserveBalances sdk.Coins, height int64) sdk.Int {
+	// Reserve balances should all be equal given that we are always
+	// applying the same additions/subtractions to all reserve balances
+	if !bond.HasTap() || bond.IsDissolved() || reserveBalances.Empty() {
+		return sdk.ZeroInt()
+	}
+	aboveFloor := bond.GetReserveExcludingSurplus(reserveBalances[0]).TruncateInt().Sub(bond.GetTapFloor())
+	if !aboveFloor.IsPositive() {
+		return sdk.ZeroInt()
+	}
+	return sdk.MinInt(bond.Tap.GetAccrued(height), aboveFloor)
+}
+
+func (bond Bond) GetRefundsForBurn(burn sdk.Int, reserveBalances sdk.Coins) sdk.Coins {
+	// Once a bond is dissolved, holders are refunded a pro-rata share of
+	// the remaining reserve, i.e. reserve * burn / supply
+	if bond.CurrentSupply.Amount.IsZero() {
+		return nil
+	}
+	refunds := sdk.Coins{}
+	for _, r := range reserveBalances {
+		reserve := bond.GetReserveExcludingSurplus(r)
+		refunds = refunds.Add(sdk.Coins{sdk.NewCoin(r.Denom,
+			reserve.MulInt(burn).QuoInt(bond.CurrentSupply.Amount).TruncateInt())})
+	}
+	return refunds
+}
+
 func (bond Bond) GetReserveDeltaForLiquidityDelta(mintOrBurn sdk.Int, reserveBalances sdk.Coins) sdk.DecCoins {
 	if mintOrBurn.IsNegative() {
 		panic(fmt.Sprintf("negative liquidity delta for bond %s", bond))
@@ -249,11 +419,16 @@ func (bond Bond) GetReserveDeltaForLiquidityDelta(mintOrBurn sdk.Int, reserveBal
 		// Where x is any of the two reserve balances or the current supply
 		// and x' is any of the updated reserve balances or the updated supply
 		// By making Δx subject of the formula: Δx = αx
-		alpha := mintOrBurnDec.Quo(sdk.NewDecFromInt(bond.CurrentSupply.Amount))
+		//
+		// Since the reserve balances include any swap fees kept in the reserve
+		// (i.e. liquidity fees), the reserve per token grows with every swap,
+		// so αx is calculated as (Δs*x)/s rather than (Δs/s)*x to avoid losing
+		// the precision of α when the reserve per token is large
+		supplyDec := sdk.NewDecFromInt(bond.CurrentSupply.Amount)
 
 		result := sdk.DecCoins{
-			sdk.NewDecCoinFromDec(resToken1, alpha.Mul(resBalance1)),
-			sdk.NewDecCoinFromDec(resToken2, alpha.Mul(resBalance2)),
+			sdk.NewDecCoinFromDec(resToken1, mintOrBurnDec.Mul(resBalance1).Quo(supplyDec)),
+			sdk.NewDecCoinFromDec(resToken2, mintOrBurnDec.Mul(resBalance2).Quo(supplyDec)),
 		}
 		if result.IsAnyNegative() {
 			panic(fmt.Sprintf("negative reserve delta result for bond %s", bond))
@@ -282,8 +457,25 @@ func (bond Bond) GetPricesToMint(mint sdk.Int, reserveBalances sdk.Coins) (sdk.D
 		} else {
 			// Reserve balances should all be equal given that we are always
 			// applying the same additions/subtractions to all reserve balances
-			commonReserveBalance := sdk.NewDecFromInt(reserveBalances[0].Amount)
-			priceToMint = result.Sub(commonReserveBalance)
+			commonReserveBalance := bond.GetReserveExcludingSurplus(reserveBalances[0])
+
+			// If funds were withdrawn through the bond's tap, the reserve can
+			// be below the curve integral, in which case buyers only pay for
+			// their share of the curve rather than replenishing the reserve.
+			// If staking rewards were added to the reserve, the reserve can be
+			// above the curve integral, in which case buyers pay a pro-rata
+			// share of the reserve so that they do not dilute existing holders
+			currentIntegral := bond.CurveIntegral(bond.CurrentSupply.Amount)
+			if !bond.ReserveCanDivergeFromCurve() {
+				priceToMint = result.Sub(commonReserveBalance)
+			} else if commonReserveBalance.LT(currentIntegral) {
+				priceToMint = result.Sub(currentIntegral)
+			} else if currentIntegral.IsPositive() {
+				priceToMint = result.Sub(currentIntegral).Mul(
+					commonReserveBalance).Quo(currentIntegral)
+			} else {
+				priceToMint = result.Sub(commonReserveBalance)
+			}
 		}
 		if priceToMint.IsNegative() {
 			// Negative priceToMint means that the previous buyer overpaid
@@ -321,8 +513,20 @@ func (bond Bond) GetReturnsForBurn(burn sdk.Int, reserveBalances sdk.Coins) sdk.
 		} else {
 			// Reserve balances should all be equal given that we are always
 			// applying the same additions/subtractions to all reserve balances
-			commonReserveBalance := sdk.NewDecFromInt(reserveBalances[0].Amount)
-			returnForBurn = commonReserveBalance.Sub(result)
+			commonReserveBalance := bond.GetReserveExcludingSurplus(reserveBalances[0])
+
+			// If the reserve is not equal to the curve integral (e.g. due to
+			// the bond's tap or staking rewards), sellers get a pro-rata share
+			// of the reserve so that the reserve remains solvent
+			currentIntegral := bond.CurveIntegral(bond.CurrentSupply.Amount)
+			if !bond.ReserveCanDivergeFromCurve() {
+				returnForBurn = commonReserveBalance.Sub(result)
+			} else if currentIntegral.IsPositive() {
+				returnForBurn = currentIntegral.Sub(result).Mul(
+					commonReserveBalance).Quo(currentIntegral)
+			} else {
+				returnForBurn = commonReserveBalance.Sub(result)
+			}
 		}
 		// TODO: investigate possibility of negative returnForBurn
 		return bond.GetNewReserveDecCoins(returnForBurn)
@@ -385,16 +589,177 @@ func (bond Bond) GetReturnsForSwap(from sdk.Coin, toToken string, reserveBalance
 	}
 }
 
+func (bond Bond) GetInputForSwap(fromToken string, to sdk.Coin, reserveBalances sdk.Coins) (from sdk.Coin, err sdk.Error) {
+	if to.IsNegative() {
+		panic(fmt.Sprintf("negative to amount for bond %s", bond))
+	} else if reserveBalances.IsAnyNegative() {
+		panic(fmt.Sprintf("negative reserve balance for bond %s", bond))
+	}
+
+	switch bond.FunctionType {
+	case PowerFunction:
+		fallthrough
+	case SigmoidFunction:
+		return sdk.Coin{}, ErrFunctionNotAvailableForFunctionType(DefaultCodespace)
+	case SwapperFunction:
+		// Check that from and to are reserve tokens
+		if fromToken != bond.ReserveTokens[0] && fromToken != bond.ReserveTokens[1] {
+			return sdk.Coin{}, ErrTokenIsNotAValidReserveToken(DefaultCodespace, fromToken)
+		} else if to.Denom != bond.ReserveTokens[0] && to.Denom != bond.ReserveTokens[1] {
+			return sdk.Coin{}, ErrTokenIsNotAValidReserveToken(DefaultCodespace, to.Denom)
+		}
+
+		// Check that at least 1 token is wanted and that it is possible to
+		// get the wanted amount without giving out all of the available outRes
+		if to.IsZero() {
+			return sdk.Coin{}, ErrSwapAmountTooSmallToGiveAnyReturn(DefaultCodespace, fromToken, to.Denom)
+		} else if to.Amount.GTE(reserveBalances.AmountOf(to.Denom)) {
+			return sdk.Coin{}, ErrSwapAmountCausesReserveDepletion(DefaultCodespace, fromToken, to.Denom)
+		}
+
+		// The input is searched for using the same calculation as for swaps
+		// (rather than by inverting it), so that rounding and fees (which can
+		// depend on the input amount) are accounted for exactly
+		givesWantedReturns := func(inAmt sdk.Int) bool {
+			returns, _, err := bond.GetReturnsForSwap(
+				sdk.NewCoin(fromToken, inAmt), to.Denom, reserveBalances)
+			return err == nil && returns.AmountOf(to.Denom).GTE(to.Amount)
+		}
+
+		// Double the upper bound until it gives the wanted returns, and then
+		// binary search for the smallest input between the two bounds. The
+		// upper bound is capped (e.g. for fees that take up the whole input)
+		// well below the sizes at which the fee calculations would overflow.
+		low, high := sdk.ZeroInt(), sdk.OneInt()
+		for !givesWantedReturns(high) {
+			if high.BigInt().BitLen() > maxSwapInputBitLen {
+				return sdk.Coin{}, ErrSwapAmountCausesReserveDepletion(DefaultCodespace, fromToken, to.Denom)
+			}
+			low, high = high, high.MulRaw(2)
+		}
+		for low.AddRaw(1).LT(high) {
+			mid := low.Add(high).QuoRaw(2)
+			if givesWantedReturns(mid) {
+				high = mid
+			} else {
+				low = mid
+			}
+		}
+
+		return sdk.NewCoin(fromToken, high), nil
+	default:
+		panic("unrecognized function type")
+	}
+}
+
+func (bond Bond) GetVolatilityPercentage() sdk.Dec {
+	// Volatility is the largest price range (max-min) across the recorded
+	// batches, as a percentage of the minimum price, for any reserve token
+	volatility := sdk.ZeroDec()
+	if len(bond.RecentPrices) < 2 {
+		return volatility
+	}
+	for _, price := range bond.RecentPrices[0] {
+		minPrice := price.Amount
+		maxPrice := price.Amount
+		for _, prices := range bond.RecentPrices[1:] {
+			p := prices.AmountOf(price.Denom)
+			minPrice = sdk.MinDec(minPrice, p)
+			maxPrice = sdk.MaxDec(maxPrice, p)
+		}
+		if !minPrice.IsPositive() {
+			continue
+		}
+		volatility = sdk.MaxDec(volatility,
+			maxPrice.Sub(minPrice).Quo(minPrice).MulInt64(100))
+	}
+	return volatility
+}
+
+func (bond Bond) AddRecentPrices(prices sdk.DecCoins) Bond {
+	// Only the prices of the last N batches (if any) are kept
+	bond.RecentPrices = append(bond.RecentPrices, prices)
+	return bond.TrimRecentPrices()
+}
+
+func (bond Bond) TrimRecentPrices() Bond {
+	maxRecords := 0
+	if bond.FeeSchedule.HasVolatilityTiers() {
+		maxRecords = int(bond.FeeSchedule.VolatilityBatches.Uint64())
+	}
+	if len(bond.RecentPrices) > maxRecords {
+		bond.RecentPrices = bond.RecentPrices[len(bond.RecentPrices)-maxRecords:]
+	}
+	if len(bond.RecentPrices) == 0 {
+		bond.RecentPrices = nil
+	}
+	return bond
+}
+
+func (bond Bond) GetFeeMultiplier(reserveAmount sdk.DecCoin) sdk.Dec {
+	// The order size is measured by the reserve amount that the fee is
+	// charged on, so size tier thresholds are in reserve token units
+	return bond.FeeSchedule.GetMultiplier(
+		reserveAmount.Amount, bond.GetVolatilityPercentage())
+}
+
 func (bond Bond) GetTxFee(reserveAmount sdk.DecCoin) sdk.Coin {
-	feeAmount := bond.TxFeePercentage.QuoInt64(100).Mul(reserveAmount.Amount)
+	feeAmount := bond.TxFeePercentage.QuoInt64(100).Mul(
+		bond.GetFeeMultiplier(reserveAmount)).Mul(reserveAmount.Amount)
 	return RoundFee(sdk.NewDecCoinFromDec(reserveAmount.Denom, feeAmount))
 }
 
 func (bond Bond) GetExitFee(reserveAmount sdk.DecCoin) sdk.Coin {
-	feeAmount := bond.ExitFeePercentage.QuoInt64(100).Mul(reserveAmount.Amount)
+	return bond.GetExitFeeAtPercentage(reserveAmount, bond.ExitFeePercentage)
+}
+
+func (bond Bond) GetExitFeeAtPercentage(reserveAmount sdk.DecCoin, exitFeePercentage sdk.Dec) sdk.Coin {
+	feeAmount := exitFeePercentage.QuoInt64(100).Mul(
+		bond.GetFeeMultiplier(reserveAmount)).Mul(reserveAmount.Amount)
 	return RoundFee(sdk.NewDecCoinFromDec(reserveAmount.Denom, feeAmount))
 }
 
+func (bond Bond) HasHoldingPeriodExitFee() bool {
+	return !bond.ExitFeeHoldingPeriod.IsZero()
+}
+
+func (bond Bond) GetHoldingPeriodExitFeePercentage(heldBlocks int64) sdk.Dec {
+	// The exit fee decays linearly from the exit fee percentage (for tokens
+	// that were just acquired) to the min exit fee percentage (for tokens
+	// held for at least the holding period)
+	if !bond.HasHoldingPeriodExitFee() {
+		return bond.ExitFeePercentage
+	} else if heldBlocks <= 0 {
+		return bond.ExitFeePercentage
+	} else if sdk.NewUint(uint64(heldBlocks)).GTE(bond.ExitFeeHoldingPeriod) {
+		return bond.MinExitFeePercentage
+	}
+	decay := bond.ExitFeePercentage.Sub(bond.MinExitFeePercentage).MulInt64(
+		heldBlocks).QuoInt(sdk.NewIntFromBigInt(bond.ExitFeeHoldingPeriod.BigInt()))
+	return bond.ExitFeePercentage.Sub(decay)
+}
+
+func (bond Bond) GetExitFeePercentageForLots(lots []Lot, amount sdk.Int, height int64) sdk.Dec {
+	if !bond.HasHoldingPeriodExitFee() || !amount.IsPositive() {
+		return bond.ExitFeePercentage
+	}
+
+	// The exit fee percentage is the average of the lots' exit fee percentages
+	// weighted by the lots' amounts, and any part of the amount not covered
+	// by the lots is treated as having just been acquired
+	weightedTotal := sdk.ZeroDec()
+	covered := sdk.ZeroInt()
+	for _, lot := range lots {
+		lotPercentage := bond.GetHoldingPeriodExitFeePercentage(height - lot.Height)
+		weightedTotal = weightedTotal.Add(lotPercentage.MulInt(lot.Amount))
+		covered = covered.Add(lot.Amount)
+	}
+	if covered.LT(amount) {
+		weightedTotal = weightedTotal.Add(bond.ExitFeePercentage.MulInt(amount.Sub(covered)))
+	}
+	return weightedTotal.QuoInt(amount)
+}
+
 //noinspection GoNilness
 func (bond Bond) GetTxFees(reserveAmounts sdk.DecCoins) (fees sdk.Coins) {
 	for _, r := range reserveAmounts {
@@ -411,19 +776,61 @@ func (bond Bond) GetExitFees(reserveAmounts sdk.DecCoins) (fees sdk.Coins) {
 	return fees
 }
 
-func (bond Bond) SignersEqualTo(signers []sdk.AccAddress) bool {
-	if len(bond.Signers) != len(signers) {
-		return false
+//noinspection GoNilness
+func (bond Bond) GetExitFeesAtPercentage(reserveAmounts sdk.DecCoins, exitFeePercentage sdk.Dec) (fees sdk.Coins) {
+	for _, r := range reserveAmounts {
+		fees = fees.Add(sdk.Coins{bond.GetExitFeeAtPercentage(r, exitFeePercentage)})
 	}
+	return fees
+}
 
-	// Note: this also enforces ORDER of signatures to be the same
-	for i := range signers {
-		if !bond.Signers[i].Equals(signers[i]) {
-			return false
-		}
+//noinspection GoNilness
+func (bond Bond) GetHolderRewards(fees sdk.Coins) (rewards sdk.Coins) {
+	if !bond.HasHolderRewards() {
+		return nil
+	}
+	for _, fee := range fees {
+		rewardAmount := sdk.NewDecFromInt(fee.Amount).Mul(
+			bond.HolderRewardsPercentage).QuoInt64(100).TruncateInt()
+		rewards = rewards.Add(sdk.Coins{sdk.NewCoin(fee.Denom, rewardAmount)})
 	}
+	return rewards
+}
 
-	return true
+//noinspection GoNilness
+func (bond Bond) GetReferralFees(txFees sdk.Coins) (referralFees sdk.Coins) {
+	if !bond.HasReferralFee() {
+		return nil
+	}
+	for _, fee := range txFees {
+		referralAmount := sdk.NewDecFromInt(fee.Amount).Mul(
+			bond.ReferralFeePercentage).QuoInt64(100).TruncateInt()
+		referralFees = referralFees.Add(sdk.Coins{sdk.NewCoin(fee.Denom, referralAmount)})
+	}
+	return referralFees
+}
+
+func (bond Bond) GetLiquidityFee(txFee sdk.Coin) sdk.Coin {
+	// A zero (or missing) liquidity fee percentage sends all swap fees to the
+	// fee recipients rather than keeping any of them in the reserve
+	if bond.LiquidityFeePercentage.IsNil() || !bond.LiquidityFeePercentage.IsPositive() {
+		return sdk.NewCoin(txFee.Denom, sdk.ZeroInt())
+	}
+	liquidityFeeAmount := sdk.NewDecFromInt(txFee.Amount).Mul(
+		bond.LiquidityFeePercentage).QuoInt64(100).TruncateInt()
+	return sdk.NewCoin(txFee.Denom, liquidityFeeAmount)
+}
+
+func (bond Bond) GetFeePayouts(fees sdk.Coins) []FeePayout {
+	return bond.FeeRecipients.GetPayouts(fees, bond.FeeAddress)
+}
+
+func (bond Bond) RoleAuthorizes(role string, signers []sdk.AccAddress) bool {
+	bondRole, found := bond.Roles.Get(role)
+	if !found {
+		return false
+	}
+	return bondRole.Authorizes(signers)
 }
 
 func (bond Bond) ReserveDenomsEqualTo(coins sdk.Coins) bool {
@@ -440,6 +847,15 @@ func (bond Bond) ReserveDenomsEqualTo(coins sdk.Coins) bool {
 	return true
 }
 
+func (bond Bond) ReserveDenomsContain(denom string) bool {
+	for _, d := range bond.ReserveTokens {
+		if d == denom {
+			return true
+		}
+	}
+	return false
+}
+
 func (bond Bond) AnyOrderQuantityLimitsExceeded(amounts sdk.Coins) bool {
 	return amounts.IsAnyGT(bond.OrderQuantityLimits)
 }
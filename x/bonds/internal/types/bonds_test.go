@@ -203,6 +203,28 @@ func TestGetReserveDeltaForLiquidityDelta(t *testing.T) {
 	}
 }
 
+func TestGetReserveDeltaForLiquidityDeltaKeepsPrecision(t *testing.T) {
+	bond := getValidBond()
+	bond.FunctionType = SwapperFunction
+	bond.ReserveTokens = swapperReserves
+	bond.CurrentSupply = sdk.NewCoin(bond.Token, sdk.NewInt(3))
+
+	// Reserves that have grown from liquidity fees kept in the reserve
+	reserveBalances := sdk.NewCoins(
+		sdk.NewInt64Coin(reserveToken, 10000),
+		sdk.NewInt64Coin(reserveToken2, 20000),
+	)
+
+	// One third of the reserves, without losing precision by calculating
+	// one third first (i.e. 3333.333333333333330000)
+	expectedResult := sdk.DecCoins{
+		sdk.NewDecCoinFromDec(reserveToken, sdk.MustNewDecFromStr("3333.333333333333333333")),
+		sdk.NewDecCoinFromDec(reserveToken2, sdk.MustNewDecFromStr("6666.666666666666666667")),
+	}
+	actualResult := bond.GetReserveDeltaForLiquidityDelta(sdk.OneInt(), reserveBalances)
+	require.Equal(t, expectedResult, actualResult)
+}
+
 func TestGetPricesToMint(t *testing.T) {
 	bond := getValidBond()
 	// TODO: add more test cases
@@ -363,6 +385,73 @@ func TestGetReturnsForSwapNonSwapperFunctionFails(t *testing.T) {
 	}
 }
 
+func TestGetInputForSwap(t *testing.T) {
+	bond := getValidBond()
+	bond.FunctionType = SwapperFunction
+	bond.FunctionParameters = nil
+	bond.ReserveTokens = swapperReserves
+	bond.TxFeePercentage = sdk.MustNewDecFromStr("0.1")
+
+	reserveBalances := sdk.NewCoins(
+		sdk.NewInt64Coin(reserveToken, 10000),
+		sdk.NewInt64Coin(reserveToken2, 10000),
+	)
+
+	testCases := []struct {
+		to                  string
+		amount              int64
+		expectedInput       int64
+		amountInvalid       bool // too large or too small
+		invalidReserveToken bool
+	}{
+		{reserveToken2, 1, 3, false, false},       // same as smallest swap in TestGetReturnsForSwap
+		{reserveToken2, 1000, 1114, false, false}, // (1114-2)*10000/(10000+1112) = 1000
+		{reserveToken2, 9999, 0, false, false},    // large input, checked below
+		{reserveToken2, 10000, 0, true, false},    // would deplete reserve
+		{reserveToken2, 0, 0, true, false},        // no returns wanted
+		{"dummytoken", 1, 0, false, true},
+	}
+	for _, tc := range testCases {
+		to := sdk.NewInt64Coin(tc.to, tc.amount)
+		input, err := bond.GetInputForSwap(reserveToken, to, reserveBalances)
+		if tc.amountInvalid {
+			require.Error(t, err)
+			require.Equal(t, CodeSwapAmountInvalid, err.Code())
+			continue
+		} else if tc.invalidReserveToken {
+			require.Error(t, err)
+			require.Equal(t, CodeReserveTokenInvalid, err.Code())
+			continue
+		}
+		require.Nil(t, err)
+		if tc.expectedInput != 0 {
+			require.Equal(t, sdk.NewInt64Coin(reserveToken, tc.expectedInput), input)
+		}
+
+		// Input gives the wanted returns, but one token less would not
+		returns, _, err := bond.GetReturnsForSwap(input, tc.to, reserveBalances)
+		require.Nil(t, err)
+		require.True(t, returns.AmountOf(tc.to).GTE(to.Amount))
+		returns, _, err = bond.GetReturnsForSwap(
+			sdk.NewCoin(reserveToken, input.Amount.SubRaw(1)), tc.to, reserveBalances)
+		require.True(t, err != nil || returns.AmountOf(tc.to).LT(to.Amount))
+	}
+
+	// Error if fee takes up the whole input
+	bond.TxFeePercentage = sdk.NewDec(100)
+	_, err := bond.GetInputForSwap(reserveToken,
+		sdk.NewInt64Coin(reserveToken2, 1), reserveBalances)
+	require.Error(t, err)
+	require.Equal(t, CodeSwapAmountInvalid, err.Code())
+
+	// Error if not a swapper function bond
+	bond.FunctionType = PowerFunction
+	_, err = bond.GetInputForSwap(reserveToken,
+		sdk.NewInt64Coin(reserveToken2, 1), reserveBalances)
+	require.Error(t, err)
+	require.Equal(t, CodeFunctionNotAvailableForFunctionType, err.Code())
+}
+
 func TestBondGetTxFee(t *testing.T) {
 	bond := Bond{}
 	zeroPointOne := sdk.MustNewDecFromStr("0.1")
@@ -472,26 +561,96 @@ func TestBondGetExitFees(t *testing.T) {
 	require.Equal(t, expected, bond.GetExitFees(inputTokens))
 }
 
-func TestSignersEqualTo(t *testing.T) {
+func TestBondGetHolderRewards(t *testing.T) {
+	bond := Bond{}
+	bond.HolderRewardsPercentage = sdk.NewDec(25)
+
+	// Rewards are truncated, so that they never exceed the fees
+
+	fees, err := sdk.ParseCoins("" +
+		"1000aaa," +
+		"10bbb," +
+		"3ccc")
+	require.Nil(t, err)
+
+	expected, err := sdk.ParseCoins("" +
+		"250aaa," +
+		"2bbb")
+	require.Nil(t, err)
+
+	require.Equal(t, expected, bond.GetHolderRewards(fees))
+}
+
+func TestBondGetLiquidityFee(t *testing.T) {
+	bond := Bond{}
+	txFee := sdk.NewInt64Coin(reserveToken, 15)
+
+	// No liquidity fee if the percentage is missing or zero
+	require.Equal(t, sdk.NewInt64Coin(reserveToken, 0), bond.GetLiquidityFee(txFee))
+	bond.LiquidityFeePercentage = sdk.ZeroDec()
+	require.Equal(t, sdk.NewInt64Coin(reserveToken, 0), bond.GetLiquidityFee(txFee))
+
+	// Liquidity fee is truncated, so that it never exceeds the tx fee
+	bond.LiquidityFeePercentage = sdk.NewDec(50)
+	require.Equal(t, sdk.NewInt64Coin(reserveToken, 7), bond.GetLiquidityFee(txFee))
+	bond.LiquidityFeePercentage = sdk.NewDec(100)
+	require.Equal(t, txFee, bond.GetLiquidityFee(txFee))
+}
+
+func TestBondGetReferralFees(t *testing.T) {
+	bond := Bond{}
+	fees := sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 15))
+
+	// No referral fees if the percentage is missing or zero
+	require.Nil(t, bond.GetReferralFees(fees))
+	bond.ReferralFeePercentage = sdk.ZeroDec()
+	require.Nil(t, bond.GetReferralFees(fees))
+
+	// Referral fees are truncated, so that they never exceed the fees
+	bond.ReferralFeePercentage = sdk.NewDec(50)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 7)), bond.GetReferralFees(fees))
+	bond.ReferralFeePercentage = sdk.NewDec(100)
+	require.Equal(t, fees, bond.GetReferralFees(fees))
+}
+
+func TestBondHasHolderRewards(t *testing.T) {
+	bond := Bond{}
+	require.False(t, bond.HasHolderRewards())
+
+	bond.HolderRewardsPercentage = sdk.ZeroDec()
+	require.False(t, bond.HasHolderRewards())
+
+	bond.HolderRewardsPercentage = sdk.MustNewDecFromStr("0.5")
+	require.True(t, bond.HasHolderRewards())
+}
+
+func TestRoleAuthorizes(t *testing.T) {
 	bond := getValidBond()
 
 	addr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
 	addr2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
 	addr3 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
-	bond.Signers = []sdk.AccAddress{addr1, addr2}
+	bond.Roles = NewDefaultBondRoles([]sdk.AccAddress{addr1, addr2})
+	bond.Roles = bond.Roles.Set(NewBondRole(RolePauser,
+		[]sdk.AccAddress{addr1, addr2, addr3}, sdk.OneUint()))
 
 	testCases := []struct {
-		toCompareTo   []sdk.AccAddress
-		expectedEqual bool
+		role             string
+		signers          []sdk.AccAddress
+		expectAuthorized bool
 	}{
-		{[]sdk.AccAddress{addr1}, false},               // One missing
-		{[]sdk.AccAddress{addr1, addr2, addr3}, false}, // One extra
-		{[]sdk.AccAddress{addr1, addr3}, false},        // One different
-		{[]sdk.AccAddress{addr2, addr1}, false},        // Incorrect order
-		{[]sdk.AccAddress{addr1, addr2}, true},         // Equal
+		{RoleAdmin, []sdk.AccAddress{addr1}, false},               // Below threshold
+		{RoleAdmin, []sdk.AccAddress{addr1, addr2, addr3}, false}, // One extra
+		{RoleAdmin, []sdk.AccAddress{addr1, addr3}, false},        // One different
+		{RoleAdmin, []sdk.AccAddress{addr1, addr1}, false},        // Duplicate
+		{RoleAdmin, []sdk.AccAddress{addr2, addr1}, true},         // Any order
+		{RoleAdmin, []sdk.AccAddress{addr1, addr2}, true},         // Equal
+		{RolePauser, []sdk.AccAddress{addr3}, true},               // Reaches threshold
+		{RolePauser, []sdk.AccAddress{addr1, addr3}, true},        // Above threshold
+		{"unknown_role", []sdk.AccAddress{addr1, addr2}, false},   // Unknown role
 	}
 	for _, tc := range testCases {
-		require.Equal(t, tc.expectedEqual, bond.SignersEqualTo(tc.toCompareTo))
+		require.Equal(t, tc.expectAuthorized, bond.RoleAuthorizes(tc.role, tc.signers))
 	}
 }
 
@@ -580,3 +739,268 @@ func TestReservesViolateSanityRateReturnsFalseWhenSanityRateIsZero(t *testing.T)
 		require.Equal(t, tc.violates, actualResult)
 	}
 }
+
+func TestBondGetVolatilityPercentage(t *testing.T) {
+	bond := Bond{}
+
+	// No volatility without at least two batches of prices
+	require.Equal(t, sdk.ZeroDec(), bond.GetVolatilityPercentage())
+	bond.RecentPrices = []sdk.DecCoins{sdk.NewDecCoins(sdk.NewCoins(
+		sdk.NewInt64Coin(reserveToken, 100)))}
+	require.Equal(t, sdk.ZeroDec(), bond.GetVolatilityPercentage())
+
+	// Prices of 100, 80 and 120 give a range of 40 over a minimum of 80
+	bond.RecentPrices = []sdk.DecCoins{
+		sdk.NewDecCoins(sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 100), sdk.NewInt64Coin(reserveToken2, 10))),
+		sdk.NewDecCoins(sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 80), sdk.NewInt64Coin(reserveToken2, 10))),
+		sdk.NewDecCoins(sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 120), sdk.NewInt64Coin(reserveToken2, 11))),
+	}
+	require.Equal(t, sdk.NewDec(50), bond.GetVolatilityPercentage())
+}
+
+func TestBondAddRecentPricesKeepsVolatilityBatches(t *testing.T) {
+	bond := Bond{}
+	prices := func(amount int64) sdk.DecCoins {
+		return sdk.NewDecCoins(sdk.NewCoins(sdk.NewInt64Coin(reserveToken, amount)))
+	}
+
+	// Nothing is kept without volatility tiers
+	bond.FeeSchedule = NewDefaultFeeSchedule()
+	bond = bond.AddRecentPrices(prices(1))
+	require.Nil(t, bond.RecentPrices)
+
+	// Only the prices of the last N batches are kept
+	bond.FeeSchedule = NewFeeSchedule(nil,
+		FeeTiers{NewFeeTier(sdk.NewDec(5), sdk.NewDec(2))}, sdk.NewUint(2))
+	bond = bond.AddRecentPrices(prices(1))
+	bond = bond.AddRecentPrices(prices(2))
+	bond = bond.AddRecentPrices(prices(3))
+	require.Equal(t, []sdk.DecCoins{prices(2), prices(3)}, bond.RecentPrices)
+}
+
+func TestBondGetTxAndExitFeeApplyFeeSchedule(t *testing.T) {
+	bond := Bond{}
+	bond.TxFeePercentage = sdk.NewDec(1)
+	bond.ExitFeePercentage = sdk.NewDec(2)
+	bond.FeeSchedule = NewFeeSchedule(
+		FeeTiers{NewFeeTier(sdk.NewDec(1000), sdk.MustNewDecFromStr("0.5"))},
+		FeeTiers{NewFeeTier(sdk.NewDec(10), sdk.NewDec(3))},
+		sdk.NewUint(2))
+
+	small := sdk.NewInt64DecCoin(reserveToken, 500)
+	large := sdk.NewInt64DecCoin(reserveToken, 2000)
+
+	// Without volatility, only the size tiers apply
+	require.Equal(t, sdk.NewInt64Coin(reserveToken, 5), bond.GetTxFee(small))
+	require.Equal(t, sdk.NewInt64Coin(reserveToken, 10), bond.GetTxFee(large))
+	require.Equal(t, sdk.NewInt64Coin(reserveToken, 10), bond.GetExitFee(small))
+	require.Equal(t, sdk.NewInt64Coin(reserveToken, 20), bond.GetExitFee(large))
+
+	// With volatility of 10 percent, the volatility tier also applies
+	bond.RecentPrices = []sdk.DecCoins{
+		sdk.NewDecCoins(sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 100))),
+		sdk.NewDecCoins(sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 110))),
+	}
+	require.Equal(t, sdk.NewInt64Coin(reserveToken, 15), bond.GetTxFee(small))
+	require.Equal(t, sdk.NewInt64Coin(reserveToken, 30), bond.GetTxFee(large))
+	require.Equal(t, sdk.NewInt64Coin(reserveToken, 30), bond.GetExitFee(small))
+	require.Equal(t, sdk.NewInt64Coin(reserveToken, 60), bond.GetExitFee(large))
+}
+
+func TestBondGetHoldingPeriodExitFeePercentage(t *testing.T) {
+	bond := Bond{}
+	bond.ExitFeePercentage = sdk.NewDec(10)
+	bond.MinExitFeePercentage = sdk.NewDec(2)
+	bond.ExitFeeHoldingPeriod = sdk.NewUint(100)
+
+	testCases := []struct {
+		heldBlocks int64
+		expected   sdk.Dec
+	}{
+		{-1, sdk.NewDec(10)},
+		{0, sdk.NewDec(10)},
+		{25, sdk.NewDec(8)},
+		{50, sdk.NewDec(6)},
+		{99, sdk.MustNewDecFromStr("2.08")},
+		{100, sdk.NewDec(2)},
+		{1000, sdk.NewDec(2)},
+	}
+	for _, tc := range testCases {
+		require.Equal(t, tc.expected, bond.GetHoldingPeriodExitFeePercentage(tc.heldBlocks))
+	}
+
+	// Without a holding period, the exit fee percentage always applies
+	bond.ExitFeeHoldingPeriod = sdk.ZeroUint()
+	require.False(t, bond.HasHoldingPeriodExitFee())
+	require.Equal(t, sdk.NewDec(10), bond.GetHoldingPeriodExitFeePercentage(1000))
+}
+
+func TestBondGetExitFeePercentageForLots(t *testing.T) {
+	bond := Bond{}
+	bond.ExitFeePercentage = sdk.NewDec(10)
+	bond.MinExitFeePercentage = sdk.NewDec(2)
+	bond.ExitFeeHoldingPeriod = sdk.NewUint(100)
+
+	// At height 200, lot at height 50 is past the holding period (2%) and
+	// lot at height 150 has been held for half of the holding period (6%)
+	lots := []Lot{NewLot(50, sdk.NewInt(30)), NewLot(150, sdk.NewInt(10))}
+	height := int64(200)
+
+	testCases := []struct {
+		lots     []Lot
+		amount   sdk.Int
+		expected sdk.Dec
+	}{
+		{lots[:1], sdk.NewInt(30), sdk.NewDec(2)},
+		{lots, sdk.NewInt(40), sdk.NewDec(3)},                // (30*2 + 10*6) / 40
+		{lots, sdk.NewInt(50), sdk.MustNewDecFromStr("4.4")}, // (30*2 + 10*6 + 10*10) / 50
+		{nil, sdk.NewInt(50), sdk.NewDec(10)},                // no lots
+		{lots, sdk.ZeroInt(), sdk.NewDec(10)},                // no amount
+	}
+	for _, tc := range testCases {
+		require.Equal(t, tc.expected, bond.GetExitFeePercentageForLots(tc.lots, tc.amount, height))
+	}
+}
+
+func TestBondGetTapAvailable(t *testing.T) {
+	bond := getValidBond()
+	bond.CurrentSupply = sdk.NewInt64Coin(bond.Token, 10)
+	reserveBalances := sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 5000))
+
+	// Without a tap, nothing is available
+	require.False(t, bond.HasTap())
+	require.Equal(t, sdk.ZeroInt(), bond.GetTapFloor())
+	require.Equal(t, sdk.ZeroInt(), bond.GetTapAvailable(reserveBalances, 10))
+
+	// Floor is 50% of the curve integral at supply 10 (4*10^3 + 100*10)
+	tap := NewTap(initCreator, sdk.NewInt(100), sdk.NewDec(50), 0)
+	bond.Tap = &tap
+	require.Equal(t, sdk.NewInt(2500), bond.GetTapFloor())
+
+	testCases := []struct {
+		reserve  int64
+		height   int64
+		expected sdk.Int
+	}{
+		{5000, 0, sdk.ZeroInt()},
+		{5000, 10, sdk.NewInt(1000)},  // limited by accrued
+		{5000, 100, sdk.NewInt(2500)}, // limited by floor
+		{3000, 100, sdk.NewInt(500)},  // limited by floor
+		{2000, 100, sdk.ZeroInt()},    // below floor
+	}
+	for _, tc := range testCases {
+		reserveBalances := sdk.NewCoins(sdk.NewInt64Coin(reserveToken, tc.reserve))
+		require.Equal(t, tc.expected, bond.GetTapAvailable(reserveBalances, tc.height))
+	}
+
+	// Once dissolved, nothing is available
+	bond.Dissolved = TRUE
+	require.Equal(t, sdk.ZeroInt(), bond.GetTapAvailable(reserveBalances, 100))
+}
+
+func TestBondPlainPricesMatchBaseline(t *testing.T) {
+	bond := getValidBond()
+	bond.CurrentSupply = sdk.NewInt64Coin(bond.Token, 10)
+	prices := func(amount int64) sdk.DecCoins {
+		return sdk.NewDecCoins(sdk.NewCoins(sdk.NewInt64Coin(reserveToken, amount)))
+	}
+
+	// Without a tap or reserve staking, prices are the curve integral after the
+	// mint minus the reserve (15000 - reserve), and returns are the reserve
+	// minus the curve integral after the burn (reserve - 1000), whether the
+	// reserve is below or above the curve integral (5000)
+	testCases := []struct {
+		reserve        int64
+		expectedPrice  int64
+		expectedReturn int64
+	}{
+		{2000, 13000, 1000},
+		{5000, 10000, 4000},
+		{6000, 9000, 5000},
+	}
+	for _, tc := range testCases {
+		reserveBalances := sdk.NewCoins(sdk.NewInt64Coin(reserveToken, tc.reserve))
+		price, err := bond.GetPricesToMint(sdk.NewInt(5), reserveBalances)
+		require.Nil(t, err)
+		require.Equal(t, prices(tc.expectedPrice), price)
+		require.Equal(t, prices(tc.expectedReturn),
+			bond.GetReturnsForBurn(sdk.NewInt(5), reserveBalances))
+	}
+
+	// With a tap, the reserve of 1000 prices against the curve instead
+	tap := NewTap(initCreator, sdk.NewInt(100), sdk.NewDec(50), 0)
+	bond.Tap = &tap
+	reserveBalances := sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 1000))
+	price, err := bond.GetPricesToMint(sdk.NewInt(5), reserveBalances)
+	require.Nil(t, err)
+	require.Equal(t, prices(10000), price)
+	require.Equal(t, prices(800), bond.GetReturnsForBurn(sdk.NewInt(5), reserveBalances))
+}
+
+func TestBondGetReturnsForBurnBelowCurveIntegral(t *testing.T) {
+	bond := getValidBond()
+	bond.CurrentSupply = sdk.NewInt64Coin(bond.Token, 10)
+	tap := NewTap(initCreator, sdk.NewInt(100), sdk.NewDec(50), 0)
+	bond.Tap = &tap
+
+	// Curve return for burning 5 of 10 is 5000 - 1000 = 4000, but with a
+	// reserve of 2500 (half of the curve integral), half of it is returned
+	reserveBalances := sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 2500))
+	expected := sdk.NewDecCoins(sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 2000)))
+	require.Equal(t, expected, bond.GetReturnsForBurn(sdk.NewInt(5), reserveBalances))
+}
+
+func TestBondGetReturnsForBurnExcludesRoundingSurplus(t *testing.T) {
+	bond := getValidBond()
+	bond.CurrentSupply = sdk.NewInt64Coin(bond.Token, 10)
+
+	// A reserve of 5001 of which 1 is rounding surplus prices the same as a
+	// reserve of exactly the curve integral (5000)
+	bond.RoundingSurplus = sdk.NewDecCoins(sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 1)))
+	reserve := sdk.NewInt64Coin(reserveToken, 5001)
+	require.Equal(t, sdk.NewDec(5000), bond.GetReserveExcludingSurplus(reserve))
+	expected := sdk.NewDecCoins(sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 4000)))
+	require.Equal(t, expected, bond.GetReturnsForBurn(sdk.NewInt(5), sdk.NewCoins(reserve)))
+
+	// A surplus above the reserve is clamped at zero
+	bond.RoundingSurplus = sdk.NewDecCoins(sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 6000)))
+	require.Equal(t, sdk.ZeroDec(), bond.GetReserveExcludingSurplus(reserve))
+}
+
+func TestBondGetRefundsForBurn(t *testing.T) {
+	bond := getValidBond()
+	reserveBalances := sdk.NewCoins(
+		sdk.NewInt64Coin(reserveToken, 1000),
+		sdk.NewInt64Coin(reserveToken2, 1000),
+	)
+
+	// Without supply, there is nothing to refund
+	bond.CurrentSupply = sdk.NewInt64Coin(bond.Token, 0)
+	require.Nil(t, bond.GetRefundsForBurn(sdk.NewInt(1), reserveBalances))
+
+	// Refunds are a pro-rata share of the reserve, rounded down
+	bond.CurrentSupply = sdk.NewInt64Coin(bond.Token, 3)
+	require.Equal(t, sdk.NewCoins(
+		sdk.NewInt64Coin(reserveToken, 333),
+		sdk.NewInt64Coin(reserveToken2, 333),
+	), bond.GetRefundsForBurn(sdk.OneInt(), reserveBalances))
+	require.Equal(t, reserveBalances, bond.GetRefundsForBurn(sdk.NewInt(3), reserveBalances))
+}
+
+func TestBondGetStakingCap(t *testing.T) {
+	bond := getValidBond()
+	bond.CurrentReserve = sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 5001))
+
+	// Without reserve staking, nothing can be staked
+	require.False(t, bond.HasReserveStaking())
+	require.Equal(t, sdk.ZeroInt(), bond.GetStakingCap())
+
+	// Cap is a percentage of the current reserve, rounded down
+	staking := NewReserveStaking(sdk.NewDec(50), FALSE)
+	bond.Staking = &staking
+	require.Equal(t, sdk.NewInt(2500), bond.GetStakingCap())
+
+	// Without a reserve, nothing can be staked
+	bond.CurrentReserve = nil
+	require.Equal(t, sdk.ZeroInt(), bond.GetStakingCap())
+}
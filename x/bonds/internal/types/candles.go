@@ -0,0 +1,140 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Candle holds the open, high, low, and close prices (per bond token) and the
+// volume of a bond's batches that were performed within an interval of blocks
+type Candle struct {
+	Token       string       `json:"token" yaml:"token"`
+	StartHeight int64        `json:"start_height" yaml:"start_height"`
+	EndHeight   int64        `json:"end_height" yaml:"end_height"`
+	Open        sdk.DecCoins `json:"open" yaml:"open"`
+	High        sdk.DecCoins `json:"high" yaml:"high"`
+	Low         sdk.DecCoins `json:"low" yaml:"low"`
+	Close       sdk.DecCoins `json:"close" yaml:"close"`
+	BuyVolume   sdk.Int      `json:"buy_volume" yaml:"buy_volume"`
+	SellVolume  sdk.Int      `json:"sell_volume" yaml:"sell_volume"`
+	SwapVolume  sdk.Coins    `json:"swap_volume" yaml:"swap_volume"`
+	Buys        sdk.Uint     `json:"buys" yaml:"buys"`
+	Sells       sdk.Uint     `json:"sells" yaml:"sells"`
+	Swaps       sdk.Uint     `json:"swaps" yaml:"swaps"`
+}
+
+// NewBatchCandle returns the candle of a performed batch, given the bond's
+// prices before and after the batch was performed. Cancelled orders are not
+// counted, and the prices at which buys and sells were performed are
+// included in the high and low prices.
+func NewBatchCandle(startHeight, height int64, openPrices, closePrices sdk.DecCoins, batch Batch) Candle {
+	candle := Candle{
+		Token:       batch.Token,
+		StartHeight: startHeight,
+		EndHeight:   height,
+		Open:        openPrices,
+		High:        maxDecCoins(openPrices, closePrices),
+		Low:         minDecCoins(openPrices, closePrices),
+		Close:       closePrices,
+		BuyVolume:   sdk.ZeroInt(),
+		SellVolume:  sdk.ZeroInt(),
+		SwapVolume:  nil,
+		Buys:        sdk.ZeroUint(),
+		Sells:       sdk.ZeroUint(),
+		Swaps:       sdk.ZeroUint(),
+	}
+
+	for _, b := range batch.Buys {
+		if !b.IsCancelled() {
+			candle.BuyVolume = candle.BuyVolume.Add(b.Amount.Amount)
+			candle.Buys = candle.Buys.Add(sdk.OneUint())
+		}
+	}
+	for _, s := range batch.Sells {
+		if !s.IsCancelled() {
+			candle.SellVolume = candle.SellVolume.Add(s.Amount.Amount)
+			candle.Sells = candle.Sells.Add(sdk.OneUint())
+		}
+	}
+	for _, s := range batch.Swaps {
+		if !s.IsCancelled() {
+			candle.SwapVolume = candle.SwapVolume.Add(sdk.Coins{s.Amount})
+			candle.Swaps = candle.Swaps.Add(sdk.OneUint())
+		}
+	}
+
+	if !candle.Buys.IsZero() {
+		candle.High = maxDecCoins(candle.High, batch.BuyPrices)
+		candle.Low = minDecCoins(candle.Low, batch.BuyPrices)
+	}
+	if !candle.Sells.IsZero() {
+		candle.High = maxDecCoins(candle.High, batch.SellPrices)
+		candle.Low = minDecCoins(candle.Low, batch.SellPrices)
+	}
+
+	return candle
+}
+
+// Merge returns the candle extended by a later candle, keeping the open
+// prices and start height of the candle and the close prices and end
+// height of the later candle
+func (c Candle) Merge(later Candle) Candle {
+	c.EndHeight = later.EndHeight
+	c.High = maxDecCoins(c.High, later.High)
+	c.Low = minDecCoins(c.Low, later.Low)
+	c.Close = later.Close
+	c.BuyVolume = c.BuyVolume.Add(later.BuyVolume)
+	c.SellVolume = c.SellVolume.Add(later.SellVolume)
+	c.SwapVolume = c.SwapVolume.Add(later.SwapVolume)
+	c.Buys = c.Buys.Add(later.Buys)
+	c.Sells = c.Sells.Add(later.Sells)
+	c.Swaps = c.Swaps.Add(later.Swaps)
+	return c
+}
+
+// GetCandleStartHeight returns the start height of the interval that the
+// height falls into, with intervals starting at multiples of intervalBlocks
+func GetCandleStartHeight(height int64, intervalBlocks sdk.Uint) int64 {
+	interval := int64(intervalBlocks.Uint64())
+	return height - height%interval
+}
+
+// RollUpCandles merges consecutive candles that fall into the same interval
+// of intervalBlocks blocks. Candles are expected to be sorted by height and
+// are never split, so intervals shorter than the candles' have no effect.
+func RollUpCandles(candles []Candle, intervalBlocks sdk.Uint) (rolledUp []Candle) {
+	for _, c := range candles {
+		startHeight := GetCandleStartHeight(c.StartHeight, intervalBlocks)
+		last := len(rolledUp) - 1
+		if last >= 0 && GetCandleStartHeight(
+			rolledUp[last].StartHeight, intervalBlocks) == startHeight {
+			rolledUp[last] = rolledUp[last].Merge(c)
+		} else {
+			rolledUp = append(rolledUp, c)
+		}
+	}
+	return rolledUp
+}
+
+func maxDecCoins(a, b sdk.DecCoins) (max sdk.DecCoins) {
+	// Adding a and b gives (sorted) coins with all of the denoms in a and b
+	for _, coin := range a.Add(b) {
+		max = append(max, sdk.NewDecCoinFromDec(coin.Denom,
+			sdk.MaxDec(a.AmountOf(coin.Denom), b.AmountOf(coin.Denom))))
+	}
+	return max
+}
+
+func minDecCoins(a, b sdk.DecCoins) (min sdk.DecCoins) {
+	// A denom missing from either a or b is taken from the other
+	for _, coin := range a.Add(b) {
+		amountA, amountB := a.AmountOf(coin.Denom), b.AmountOf(coin.Denom)
+		if amountA.IsZero() {
+			min = append(min, sdk.NewDecCoinFromDec(coin.Denom, amountB))
+		} else if amountB.IsZero() {
+			min = append(min, sdk.NewDecCoinFromDec(coin.Denom, amountA))
+		} else {
+			min = append(min, sdk.NewDecCoinFromDec(coin.Denom, sdk.MinDec(amountA, amountB)))
+		}
+	}
+	return min
+}
@@ -0,0 +1,110 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func decCoins(amounts ...int64) (coins sdk.DecCoins) {
+	// Amounts are of reserveToken and (optionally) reserveToken2
+	denoms := []string{reserveToken, reserveToken2}
+	for i, a := range amounts {
+		coins = coins.Add(sdk.DecCoins{sdk.NewInt64DecCoin(denoms[i], a)})
+	}
+	return coins
+}
+
+func TestNewBatchCandle(t *testing.T) {
+	buyer := initCreator
+	batch := NewBatch(token, sdk.OneUint())
+	batch.BuyPrices = decCoins(130)
+	batch.SellPrices = decCoins(90)
+	batch.Buys = []BuyOrder{
+		NewBuyOrder(buyer, sdk.NewInt64Coin(token, 5), nil, nil),
+		NewBuyOrder(buyer, sdk.NewInt64Coin(token, 7), nil, nil),
+	}
+	batch.Sells = []SellOrder{
+		NewSellOrder(buyer, sdk.NewInt64Coin(token, 3), sdk.ZeroDec()),
+	}
+	batch.Swaps = []SwapOrder{
+		NewSwapOrder(buyer, sdk.NewInt64Coin(reserveToken, 10), reserveToken2),
+	}
+
+	// Cancelled orders are not counted
+	batch.Buys[1].Cancelled = TRUE
+
+	candle := NewBatchCandle(100, 105, decCoins(100), decCoins(110), batch)
+	require.Equal(t, token, candle.Token)
+	require.Equal(t, int64(100), candle.StartHeight)
+	require.Equal(t, int64(105), candle.EndHeight)
+	require.Equal(t, decCoins(100), candle.Open)
+	require.Equal(t, decCoins(130), candle.High)
+	require.Equal(t, decCoins(90), candle.Low)
+	require.Equal(t, decCoins(110), candle.Close)
+	require.Equal(t, sdk.NewInt(5), candle.BuyVolume)
+	require.Equal(t, sdk.NewInt(3), candle.SellVolume)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 10)), candle.SwapVolume)
+	require.Equal(t, sdk.OneUint(), candle.Buys)
+	require.Equal(t, sdk.OneUint(), candle.Sells)
+	require.Equal(t, sdk.OneUint(), candle.Swaps)
+}
+
+func TestNewBatchCandleIgnoresPricesOfNoOrders(t *testing.T) {
+	// Batch prices are ignored if there are no (uncancelled) orders
+	batch := NewBatch(token, sdk.OneUint())
+	batch.BuyPrices = decCoins(200)
+	batch.SellPrices = decCoins(50)
+
+	candle := NewBatchCandle(100, 105, decCoins(100), decCoins(100), batch)
+	require.Equal(t, decCoins(100), candle.High)
+	require.Equal(t, decCoins(100), candle.Low)
+	require.True(t, candle.BuyVolume.IsZero())
+	require.True(t, candle.Buys.IsZero())
+}
+
+func TestCandleMerge(t *testing.T) {
+	batch := NewBatch(token, sdk.OneUint())
+	batch.Buys = []BuyOrder{
+		NewBuyOrder(initCreator, sdk.NewInt64Coin(token, 5), nil, nil)}
+
+	first := NewBatchCandle(100, 105, decCoins(100, 50), decCoins(120, 40), batch)
+	second := NewBatchCandle(100, 110, decCoins(120, 40), decCoins(80, 60), batch)
+
+	merged := first.Merge(second)
+	require.Equal(t, int64(100), merged.StartHeight)
+	require.Equal(t, int64(110), merged.EndHeight)
+	require.Equal(t, decCoins(100, 50), merged.Open)
+	require.Equal(t, decCoins(120, 60), merged.High)
+	require.Equal(t, decCoins(80, 40), merged.Low)
+	require.Equal(t, decCoins(80, 60), merged.Close)
+	require.Equal(t, sdk.NewInt(10), merged.BuyVolume)
+	require.Equal(t, sdk.NewUint(2), merged.Buys)
+}
+
+func TestGetCandleStartHeight(t *testing.T) {
+	require.Equal(t, int64(0), GetCandleStartHeight(0, sdk.NewUint(10)))
+	require.Equal(t, int64(0), GetCandleStartHeight(9, sdk.NewUint(10)))
+	require.Equal(t, int64(10), GetCandleStartHeight(10, sdk.NewUint(10)))
+	require.Equal(t, int64(25), GetCandleStartHeight(25, sdk.OneUint()))
+}
+
+func TestRollUpCandles(t *testing.T) {
+	batch := NewBatch(token, sdk.OneUint())
+	candles := []Candle{
+		NewBatchCandle(0, 5, decCoins(1), decCoins(2), batch),
+		NewBatchCandle(10, 15, decCoins(2), decCoins(3), batch),
+		NewBatchCandle(20, 25, decCoins(3), decCoins(4), batch),
+		NewBatchCandle(40, 45, decCoins(4), decCoins(5), batch),
+	}
+
+	// Rolled up into 20-block intervals (0-19, 20-39, 40-59)
+	rolledUp := RollUpCandles(candles, sdk.NewUint(20))
+	require.Len(t, rolledUp, 3)
+	require.Equal(t, candles[0].Merge(candles[1]), rolledUp[0])
+	require.Equal(t, candles[2], rolledUp[1])
+	require.Equal(t, candles[3], rolledUp[2])
+
+	// Candles are not split by shorter intervals
+	require.Equal(t, candles, RollUpCandles(candles, sdk.OneUint()))
+}
@@ -20,8 +20,31 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(&BuyOrder{}, "cosmos-sdk/BuyOrder", nil)
 	cdc.RegisterConcrete(&SellOrder{}, "cosmos-sdk/SellOrder", nil)
 	cdc.RegisterConcrete(&SwapOrder{}, "cosmos-sdk/SwapOrder", nil)
+	cdc.RegisterConcrete(&BondRole{}, "cosmos-sdk/BondRole", nil)
+	cdc.RegisterConcrete(&FeeRecipient{}, "cosmos-sdk/FeeRecipient", nil)
+	cdc.RegisterConcrete(&FeeTier{}, "cosmos-sdk/FeeTier", nil)
+	cdc.RegisterConcrete(&HolderRewards{}, "cosmos-sdk/HolderRewards", nil)
+	cdc.RegisterConcrete(&Tap{}, "cosmos-sdk/Tap", nil)
+	cdc.RegisterConcrete(&TapVote{}, "cosmos-sdk/TapVote", nil)
+	cdc.RegisterConcrete(&ReserveStaking{}, "cosmos-sdk/ReserveStaking", nil)
+	cdc.RegisterConcrete(&QueuedSell{}, "cosmos-sdk/QueuedSell", nil)
 	cdc.RegisterConcrete(MsgCreateBond{}, "cosmos-sdk/MsgCreateBond", nil)
 	cdc.RegisterConcrete(MsgEditBond{}, "cosmos-sdk/MsgEditBond", nil)
+	cdc.RegisterConcrete(MsgCloseBond{}, "cosmos-sdk/MsgCloseBond", nil)
+	cdc.RegisterConcrete(MsgSetBondPaused{}, "cosmos-sdk/MsgSetBondPaused", nil)
+	cdc.RegisterConcrete(MsgSetCircuitBreaker{}, "cosmos-sdk/MsgSetCircuitBreaker", nil)
+	cdc.RegisterConcrete(MsgUpdateBondRole{}, "cosmos-sdk/MsgUpdateBondRole", nil)
+	cdc.RegisterConcrete(MsgSetFeeRecipients{}, "cosmos-sdk/MsgSetFeeRecipients", nil)
+	cdc.RegisterConcrete(MsgSetFeeSchedule{}, "cosmos-sdk/MsgSetFeeSchedule", nil)
+	cdc.RegisterConcrete(MsgClaimBondRewards{}, "cosmos-sdk/MsgClaimBondRewards", nil)
+	cdc.RegisterConcrete(MsgSetTap{}, "cosmos-sdk/MsgSetTap", nil)
+	cdc.RegisterConcrete(MsgWithdrawTap{}, "cosmos-sdk/MsgWithdrawTap", nil)
+	cdc.RegisterConcrete(MsgVoteTap{}, "cosmos-sdk/MsgVoteTap", nil)
+	cdc.RegisterConcrete(MsgRefund{}, "cosmos-sdk/MsgRefund", nil)
+	cdc.RegisterConcrete(MsgSetReserveStaking{}, "cosmos-sdk/MsgSetReserveStaking", nil)
+	cdc.RegisterConcrete(MsgDelegateReserve{}, "cosmos-sdk/MsgDelegateReserve", nil)
+	cdc.RegisterConcrete(MsgUndelegateReserve{}, "cosmos-sdk/MsgUndelegateReserve", nil)
+	cdc.RegisterConcrete(MsgSweepRoundingSurplus{}, "cosmos-sdk/MsgSweepRoundingSurplus", nil)
 	cdc.RegisterConcrete(MsgBuy{}, "cosmos-sdk/MsgBuy", nil)
 	cdc.RegisterConcrete(MsgSell{}, "cosmos-sdk/MsgSell", nil)
 	cdc.RegisterConcrete(MsgSwap{}, "cosmos-sdk/MsgSwap", nil)
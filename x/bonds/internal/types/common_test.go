@@ -96,10 +96,18 @@ func NewValidMsgCreateBond() MsgCreateBond {
 	return NewMsgCreateBond(initToken, initName, initDescription,
 		initCreator, functionType, functionParams,
 		reserveTokens, initTxFeePercentage, initExitFeePercentage,
-		initFeeAddress, initMaxSupply, initOrderQuantityLimits, initSanityRate,
+		initFeeAddress, nil, sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec(), sdk.Dec{}, sdk.ZeroUint(), initMaxSupply, initOrderQuantityLimits, initSanityRate,
 		initSanityMarginPercentage, initAllowSell, initSigners, initBatchBlocks)
 }
 
+func NewValidMsgCreateSwapperBond() MsgCreateBond {
+	message := NewValidMsgCreateBond()
+	message.FunctionType = SwapperFunction
+	message.FunctionParameters = nil
+	message.ReserveTokens = swapperReserves
+	return message
+}
+
 func NewEmptyStringsMsgEditBond() MsgEditBond {
 	return NewMsgEditBond(initToken, "", "", "", "", "",
 		initCreator, initSigners)
@@ -110,11 +118,30 @@ func NewValidMsgEditBond() MsgEditBond {
 		initCreator, initSigners)
 }
 
+func NewValidMsgCloseBond() MsgCloseBond {
+	return NewMsgCloseBond(initToken, initCreator, initSigners)
+}
+
+func NewValidMsgSetBondPaused() MsgSetBondPaused {
+	return NewMsgSetBondPaused(initToken, TRUE, initCreator, initSigners)
+}
+
+func NewValidMsgSetCircuitBreaker() MsgSetCircuitBreaker {
+	return NewMsgSetCircuitBreaker(initToken, sdk.NewDec(10),
+		CircuitBreakerHalt, sdk.NewUint(5), initCreator, initSigners)
+}
+
+func NewValidMsgUpdateBondRole() MsgUpdateBondRole {
+	addresses := []sdk.AccAddress{initCreator, initFeeAddress}
+	return NewMsgUpdateBondRole(initToken, RolePauser, addresses,
+		sdk.OneUint(), initCreator, initSigners)
+}
+
 func NewValidMsgBuy() MsgBuy {
 	buyer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
 	amount, _ := sdk.ParseCoin("10" + initToken)
 	maxPrices, _ := sdk.ParseCoins("50" + initToken)
-	return NewMsgBuy(buyer, amount, maxPrices)
+	return NewMsgBuy(buyer, amount, maxPrices, nil)
 }
 
 func NewValidMsgSell() MsgSell {
@@ -128,3 +155,56 @@ func NewValidMsgSwap() MsgSwap {
 	from := sdk.NewInt64Coin(reserveToken, 10)
 	return NewMsgSwap(swapper, initToken, from, reserveToken2)
 }
+
+func NewValidMsgSetFeeRecipients() MsgSetFeeRecipients {
+	recipients := FeeRecipients{
+		NewFeeRecipient(initCreator, sdk.NewDec(40)),
+		NewFeeRecipient(initFeeAddress, sdk.NewDec(60)),
+	}
+	return NewMsgSetFeeRecipients(initToken, recipients, initFeeAddress,
+		initCreator, initSigners)
+}
+
+func NewValidMsgSetFeeSchedule() MsgSetFeeSchedule {
+	feeSchedule := NewFeeSchedule(
+		FeeTiers{NewFeeTier(sdk.NewDec(1000), sdk.MustNewDecFromStr("0.5"))},
+		FeeTiers{NewFeeTier(sdk.NewDec(10), sdk.NewDec(2))},
+		sdk.NewUint(5))
+	return NewMsgSetFeeSchedule(initToken, feeSchedule, initCreator, initSigners)
+}
+
+func NewValidMsgClaimBondRewards() MsgClaimBondRewards {
+	claimer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	return NewMsgClaimBondRewards(initToken, claimer)
+}
+
+func NewValidMsgSetTap() MsgSetTap {
+	beneficiary := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	return NewMsgSetTap(initToken, beneficiary, sdk.NewInt(100),
+		sdk.NewDec(50), initCreator, initSigners)
+}
+
+func NewValidMsgVoteTap() MsgVoteTap {
+	voter := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	return NewMsgVoteTap(initToken, TapVoteRaise, voter)
+}
+
+func NewValidMsgSetReserveStaking() MsgSetReserveStaking {
+	return NewMsgSetReserveStaking(initToken, sdk.NewDec(50), TRUE, initCreator, initSigners)
+}
+
+func NewValidMsgDelegateReserve() MsgDelegateReserve {
+	validator := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	return NewMsgDelegateReserve(initToken, validator,
+		sdk.NewInt64Coin(reserveToken, 100), initCreator, initSigners)
+}
+
+func NewValidMsgUndelegateReserve() MsgUndelegateReserve {
+	validator := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	return NewMsgUndelegateReserve(initToken, validator,
+		sdk.NewInt64Coin(reserveToken, 100), initCreator, initSigners)
+}
+
+func NewValidMsgSweepRoundingSurplus() MsgSweepRoundingSurplus {
+	return NewMsgSweepRoundingSurplus(initToken, initCreator, initSigners)
+}
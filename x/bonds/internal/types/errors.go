@@ -45,6 +45,65 @@ const (
 	CodeOrderLimitExceeded     CodeType = 322
 	CodeSanityRateViolated     CodeType = 323
 	CodeFeeTooLarge            CodeType = 324
+
+	// Module parameters
+	CodeBatchBlocksOutOfRange CodeType = 325
+	CodeMaxOrdersReached      CodeType = 326
+
+	// Closing bonds
+	CodeBondCannotBeClosed CodeType = 327
+
+	// Pausing, halting and circuit breakers
+	CodeBondPaused           CodeType = 328
+	CodeBondHalted           CodeType = 329
+	CodeMaxPriceMoveExceeded CodeType = 330
+
+	// Roles
+	CodeInvalidRole          CodeType = 331
+	CodeSignersNotAuthorized CodeType = 332
+
+	// Fee recipients
+	CodeInvalidFeeRecipients CodeType = 333
+
+	// Holder rewards
+	CodeNoRewardsToClaim CodeType = 334
+
+	// Fee schedules
+	CodeInvalidFeeSchedule CodeType = 335
+
+	// Taps and dissolution
+	CodeInvalidTap        CodeType = 336
+	CodeNoTapFundsToDraw  CodeType = 337
+	CodeBondDissolved     CodeType = 338
+	CodeBondNotDissolved  CodeType = 339
+	CodeNoTapRateProposed CodeType = 340
+
+	// Reserve staking
+	CodeInvalidReserveStaking     CodeType = 341
+	CodeStakingCapExceeded        CodeType = 342
+	CodeInsufficientLiquidReserve CodeType = 343
+
+	// Rounding surplus
+	CodeNoRoundingSurplusToSweep CodeType = 344
+
+	// Referrals
+	CodeInvalidReferrer CodeType = 345
+
+	// Price curve
+	CodeInvalidPriceCurve CodeType = 346
+
+	// TWAP
+	CodeInvalidTwapWindow  CodeType = 347
+	CodeNoPriceObservation CodeType = 348
+
+	// Params
+	CodeInvalidParams CodeType = 349
+
+	// Tap votes
+	CodeNoBondTokensToVoteWith CodeType = 350
+
+	// Swap quotes
+	CodeSwapQuoteUnavailable CodeType = 351
 )
 
 func ErrArgumentCannotBeEmpty(codespace sdk.CodespaceType, argument string) sdk.Error {
@@ -62,6 +121,11 @@ func ErrArgumentMustBePositive(codespace sdk.CodespaceType, arg string) sdk.Erro
 	return sdk.NewError(codespace, CodeArgumentInvalid, errMsg)
 }
 
+func ErrArgumentExceedsMaximum(codespace sdk.CodespaceType, arg string, max uint64) sdk.Error {
+	errMsg := fmt.Sprintf("%s argument cannot be greater than %d", arg, max)
+	return sdk.NewError(codespace, CodeArgumentInvalid, errMsg)
+}
+
 func ErrFunctionParameterMissingOrNonInteger(codespace sdk.CodespaceType, param string) sdk.Error {
 	errMsg := fmt.Sprintf("%s parameter is missing or is not an integer", param)
 	return sdk.NewError(codespace, CodeArgumentMissingOrIncorrectType, errMsg)
@@ -167,6 +231,16 @@ func ErrBondTokenCannotBeStakingToken(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeBondTokenInvalid, errMsg)
 }
 
+func ErrBondTokenIsDenylisted(codespace sdk.CodespaceType, denom string) sdk.Error {
+	errMsg := fmt.Sprintf("Token '%s' cannot be used as a bond token", denom)
+	return sdk.NewError(codespace, CodeBondTokenInvalid, errMsg)
+}
+
+func ErrReserveTokenIsDenylisted(codespace sdk.CodespaceType, denom string) sdk.Error {
+	errMsg := fmt.Sprintf("Token '%s' cannot be used as a reserve token", denom)
+	return sdk.NewError(codespace, CodeReserveTokenInvalid, errMsg)
+}
+
 func ErrReserveDenomsMismatch(codespace sdk.CodespaceType, inputDenoms string, actualDenoms []string) sdk.Error {
 	errMsg := fmt.Sprintf("Denoms in %s do not match reserve denoms; expected: %s", inputDenoms, strings.Join(actualDenoms, ","))
 	return sdk.NewError(codespace, CodeReserveDenomsMismatch, errMsg)
@@ -216,3 +290,225 @@ func ErrFeesCannotBeOrExceed100Percent(codespace sdk.CodespaceType) sdk.Error {
 	errMsg := "Sum of fees is or exceeds 100 percent"
 	return sdk.NewError(codespace, CodeFeeTooLarge, errMsg)
 }
+
+func ErrFeeExceedsMaxFee(codespace sdk.CodespaceType, fee string, maxFee sdk.Dec) sdk.Error {
+	errMsg := fmt.Sprintf("%s exceeds the maximum of %s percent", fee, maxFee.String())
+	return sdk.NewError(codespace, CodeFeeTooLarge, errMsg)
+}
+
+func ErrBatchBlocksOutOfRange(codespace sdk.CodespaceType, min, max sdk.Uint) sdk.Error {
+	errMsg := fmt.Sprintf("Batch blocks must be between %s and %s", min.String(), max.String())
+	return sdk.NewError(codespace, CodeBatchBlocksOutOfRange, errMsg)
+}
+
+func ErrMaxOrdersPerBatchReached(codespace sdk.CodespaceType, max uint64) sdk.Error {
+	errMsg := fmt.Sprintf("Batch has reached the maximum of %d orders", max)
+	return sdk.NewError(codespace, CodeMaxOrdersReached, errMsg)
+}
+
+func ErrBondHasNonZeroSupply(codespace sdk.CodespaceType, supply sdk.Coin) sdk.Error {
+	errMsg := fmt.Sprintf("Bond cannot be closed since its current supply is %s", supply.String())
+	return sdk.NewError(codespace, CodeBondCannotBeClosed, errMsg)
+}
+
+func ErrBondHasStakedReserve(codespace sdk.CodespaceType, staked sdk.Coins) sdk.Error {
+	errMsg := fmt.Sprintf("Bond cannot be closed since %s of its reserve is still staked", staked.String())
+	return sdk.NewError(codespace, CodeBondCannotBeClosed, errMsg)
+}
+
+func ErrBondHasQueuedSells(codespace sdk.CodespaceType) sdk.Error {
+	errMsg := "Bond cannot be closed since it has sells waiting to be paid out"
+	return sdk.NewError(codespace, CodeBondCannotBeClosed, errMsg)
+}
+
+func ErrBondHasPendingOrders(codespace sdk.CodespaceType) sdk.Error {
+	errMsg := "Bond cannot be closed since its current batch has pending orders"
+	return sdk.NewError(codespace, CodeBondCannotBeClosed, errMsg)
+}
+
+func ErrBondIsPaused(codespace sdk.CodespaceType, bondToken string) sdk.Error {
+	errMsg := fmt.Sprintf("Bond '%s' is paused", bondToken)
+	return sdk.NewError(codespace, CodeBondPaused, errMsg)
+}
+
+func ErrBondIsHalted(codespace sdk.CodespaceType, bondToken string, blocksRemaining sdk.Uint) sdk.Error {
+	errMsg := fmt.Sprintf("Bond '%s' is halted for %s more blocks", bondToken, blocksRemaining.String())
+	return sdk.NewError(codespace, CodeBondHalted, errMsg)
+}
+
+func ErrMaxPriceMoveExceeded(codespace sdk.CodespaceType, priceMove, maxPriceMove sdk.Dec) sdk.Error {
+	errMsg := fmt.Sprintf("Batch price move of %s percent exceeds the maximum of %s percent", priceMove.String(), maxPriceMove.String())
+	return sdk.NewError(codespace, CodeMaxPriceMoveExceeded, errMsg)
+}
+
+func ErrUnrecognizedCircuitBreakerMode(codespace sdk.CodespaceType, mode string) sdk.Error {
+	errMsg := fmt.Sprintf("Unrecognized circuit breaker mode '%s'; expected one of: %s, %s", mode, CircuitBreakerCancel, CircuitBreakerHalt)
+	return sdk.NewError(codespace, CodeArgumentInvalid, errMsg)
+}
+
+func ErrUnrecognizedRole(codespace sdk.CodespaceType, role string) sdk.Error {
+	errMsg := fmt.Sprintf("Unrecognized role '%s'", role)
+	return sdk.NewError(codespace, CodeInvalidRole, errMsg)
+}
+
+func ErrDuplicateRoleAddress(codespace sdk.CodespaceType, address sdk.AccAddress) sdk.Error {
+	errMsg := fmt.Sprintf("Address %s appears more than once in role", address.String())
+	return sdk.NewError(codespace, CodeInvalidRole, errMsg)
+}
+
+func ErrInvalidRoleThreshold(codespace sdk.CodespaceType, threshold, numberOfAddresses sdk.Uint) sdk.Error {
+	errMsg := fmt.Sprintf("Role threshold %s must be between 1 and the number of addresses (%s)", threshold.String(), numberOfAddresses.String())
+	return sdk.NewError(codespace, CodeInvalidRole, errMsg)
+}
+
+func ErrSignersNotAuthorizedForRole(codespace sdk.CodespaceType, role string) sdk.Error {
+	errMsg := fmt.Sprintf("Signers are not authorized for the bond's '%s' role", role)
+	return sdk.NewError(codespace, CodeSignersNotAuthorized, errMsg)
+}
+
+func ErrDuplicateFeeRecipient(codespace sdk.CodespaceType, address sdk.AccAddress) sdk.Error {
+	errMsg := fmt.Sprintf("Address %s is listed as a fee recipient more than once", address.String())
+	return sdk.NewError(codespace, CodeInvalidFeeRecipients, errMsg)
+}
+
+func ErrFeeRecipientSharesDoNotSumTo100(codespace sdk.CodespaceType, totalShares sdk.Dec) sdk.Error {
+	errMsg := fmt.Sprintf("Fee recipient shares add up to %s instead of 100", totalShares.String())
+	return sdk.NewError(codespace, CodeInvalidFeeRecipients, errMsg)
+}
+
+func ErrFeeAddressNotAFeeRecipient(codespace sdk.CodespaceType, feeAddress sdk.AccAddress) sdk.Error {
+	errMsg := fmt.Sprintf("Fee address %s has to be one of the fee recipients", feeAddress.String())
+	return sdk.NewError(codespace, CodeInvalidFeeRecipients, errMsg)
+}
+
+func ErrNoRewardsToClaim(codespace sdk.CodespaceType, token string, address sdk.AccAddress) sdk.Error {
+	errMsg := fmt.Sprintf("Address %s has no %s holder rewards to claim", address.String(), token)
+	return sdk.NewError(codespace, CodeNoRewardsToClaim, errMsg)
+}
+
+func ErrFeeTierThresholdsNotAscending(codespace sdk.CodespaceType, name string) sdk.Error {
+	errMsg := fmt.Sprintf("%s thresholds must be in strictly ascending order", name)
+	return sdk.NewError(codespace, CodeInvalidFeeSchedule, errMsg)
+}
+
+func ErrVolatilityBatchesOutOfRange(codespace sdk.CodespaceType, min, max uint64) sdk.Error {
+	errMsg := fmt.Sprintf("Volatility batches must be between %d and %d", min, max)
+	return sdk.NewError(codespace, CodeInvalidFeeSchedule, errMsg)
+}
+
+func ErrTapFloorPercentageOutOfRange(codespace sdk.CodespaceType, floorPercentage sdk.Dec) sdk.Error {
+	errMsg := fmt.Sprintf("Tap floor percentage %s must be between 0 and 100", floorPercentage.String())
+	return sdk.NewError(codespace, CodeInvalidTap, errMsg)
+}
+
+func ErrTapFloorCannotBeLowered(codespace sdk.CodespaceType, floorPercentage, currentFloorPercentage sdk.Dec) sdk.Error {
+	errMsg := fmt.Sprintf("Tap floor percentage %s cannot be lower than the current %s", floorPercentage.String(), currentFloorPercentage.String())
+	return sdk.NewError(codespace, CodeInvalidTap, errMsg)
+}
+
+func ErrBondDoesNotHaveTap(codespace sdk.CodespaceType, bondToken string) sdk.Error {
+	errMsg := fmt.Sprintf("Bond '%s' does not have a tap", bondToken)
+	return sdk.NewError(codespace, CodeInvalidTap, errMsg)
+}
+
+func ErrNoTapFundsToDraw(codespace sdk.CodespaceType, bondToken string) sdk.Error {
+	errMsg := fmt.Sprintf("Bond '%s' has no tap funds available to draw", bondToken)
+	return sdk.NewError(codespace, CodeNoTapFundsToDraw, errMsg)
+}
+
+func ErrNoTapRateProposed(codespace sdk.CodespaceType, bondToken string) sdk.Error {
+	errMsg := fmt.Sprintf("Bond '%s' does not have a proposed tap rate to vote on", bondToken)
+	return sdk.NewError(codespace, CodeNoTapRateProposed, errMsg)
+}
+
+func ErrNoBondTokensToVoteWith(codespace sdk.CodespaceType, bondToken string) sdk.Error {
+	errMsg := fmt.Sprintf("Voter does not hold any '%s' bond tokens to vote with", bondToken)
+	return sdk.NewError(codespace, CodeNoBondTokensToVoteWith, errMsg)
+}
+
+func ErrUnrecognizedTapVoteOption(codespace sdk.CodespaceType, option string) sdk.Error {
+	errMsg := fmt.Sprintf("Unrecognized tap vote option '%s'; expected one of: %s, %s", option, TapVoteRaise, TapVoteDissolve)
+	return sdk.NewError(codespace, CodeArgumentInvalid, errMsg)
+}
+
+func ErrBondIsDissolved(codespace sdk.CodespaceType, bondToken string) sdk.Error {
+	errMsg := fmt.Sprintf("Bond '%s' is dissolved", bondToken)
+	return sdk.NewError(codespace, CodeBondDissolved, errMsg)
+}
+
+func ErrBondIsNotDissolved(codespace sdk.CodespaceType, bondToken string) sdk.Error {
+	errMsg := fmt.Sprintf("Bond '%s' is not dissolved", bondToken)
+	return sdk.NewError(codespace, CodeBondNotDissolved, errMsg)
+}
+
+func ErrStakingCapPercentageOutOfRange(codespace sdk.CodespaceType, capPercentage sdk.Dec) sdk.Error {
+	errMsg := fmt.Sprintf("Staking cap percentage %s must be between 0 and 100", capPercentage.String())
+	return sdk.NewError(codespace, CodeInvalidReserveStaking, errMsg)
+}
+
+func ErrReserveIsNotStakingDenom(codespace sdk.CodespaceType, bondToken, stakingDenom string) sdk.Error {
+	errMsg := fmt.Sprintf("Bond '%s' reserve can only be staked if its only reserve token is %s", bondToken, stakingDenom)
+	return sdk.NewError(codespace, CodeInvalidReserveStaking, errMsg)
+}
+
+func ErrBondDoesNotHaveReserveStaking(codespace sdk.CodespaceType, bondToken string) sdk.Error {
+	errMsg := fmt.Sprintf("Bond '%s' does not have reserve staking enabled", bondToken)
+	return sdk.NewError(codespace, CodeInvalidReserveStaking, errMsg)
+}
+
+func ErrStakingCapExceeded(codespace sdk.CodespaceType, staked, stakingCap sdk.Int) sdk.Error {
+	errMsg := fmt.Sprintf("Staked reserve %s would exceed the staking cap %s", staked.String(), stakingCap.String())
+	return sdk.NewError(codespace, CodeStakingCapExceeded, errMsg)
+}
+
+func ErrInsufficientLiquidReserve(codespace sdk.CodespaceType, liquid, amount sdk.Int) sdk.Error {
+	errMsg := fmt.Sprintf("Liquid reserve %s is less than %s", liquid.String(), amount.String())
+	return sdk.NewError(codespace, CodeInsufficientLiquidReserve, errMsg)
+}
+
+func ErrNoRoundingSurplusToSweep(codespace sdk.CodespaceType, bondToken string) sdk.Error {
+	errMsg := fmt.Sprintf("Bond '%s' has no rounding surplus that can be swept", bondToken)
+	return sdk.NewError(codespace, CodeNoRoundingSurplusToSweep, errMsg)
+}
+
+func ErrReferrerIsBuyer(codespace sdk.CodespaceType) sdk.Error {
+	errMsg := "Buyer cannot be their own referrer"
+	return sdk.NewError(codespace, CodeInvalidReferrer, errMsg)
+}
+
+func ErrInvalidPriceCurveRange(codespace sdk.CodespaceType, from, to, maxSupply sdk.Int) sdk.Error {
+	errMsg := fmt.Sprintf("Supply range %s to %s is not within 0 and the max supply %s",
+		from.String(), to.String(), maxSupply.String())
+	return sdk.NewError(codespace, CodeInvalidPriceCurve, errMsg)
+}
+
+func ErrPriceCurveSamplesOutOfRange(codespace sdk.CodespaceType, samples, max int) sdk.Error {
+	errMsg := fmt.Sprintf("Number of samples %d is not between 1 and %d", samples, max)
+	return sdk.NewError(codespace, CodeInvalidPriceCurve, errMsg)
+}
+
+func ErrInvalidTwapWindow(codespace sdk.CodespaceType, from, to, height int64) sdk.Error {
+	errMsg := fmt.Sprintf("TWAP window from height %d to %d must be non-empty and not end after the current height %d",
+		from, to, height)
+	return sdk.NewError(codespace, CodeInvalidTwapWindow, errMsg)
+}
+
+func ErrNoPriceObservation(codespace sdk.CodespaceType, bondToken string, height int64) sdk.Error {
+	errMsg := fmt.Sprintf("Bond '%s' has no price observation at or before height %d", bondToken, height)
+	return sdk.NewError(codespace, CodeNoPriceObservation, errMsg)
+}
+
+func ErrInvalidParams(codespace sdk.CodespaceType, reason string) sdk.Error {
+	errMsg := fmt.Sprintf("Invalid bonds params: %s", reason)
+	return sdk.NewError(codespace, CodeInvalidParams, errMsg)
+}
+
+func ErrSwapReserveIsEmpty(codespace sdk.CodespaceType, bondToken, reserveToken string) sdk.Error {
+	errMsg := fmt.Sprintf("Bond '%s' has no %s in its reserve to quote a swap with", bondToken, reserveToken)
+	return sdk.NewError(codespace, CodeSwapQuoteUnavailable, errMsg)
+}
+
+func ErrSwapSpotRateIsZero(codespace sdk.CodespaceType, fromToken, toToken string) sdk.Error {
+	errMsg := fmt.Sprintf("Spot rate from %s to %s is zero", fromToken, toToken)
+	return sdk.NewError(codespace, CodeSwapQuoteUnavailable, errMsg)
+}
@@ -1,44 +1,105 @@
 package types
 
 const (
-	EventTypeCreateBond   = "create_bond"
-	EventTypeEditBond     = "edit_bond"
-	EventTypeInitSwapper  = "init_swapper"
-	EventTypeBuy          = "buy"
-	EventTypeSell         = "sell"
-	EventTypeSwap         = "swap"
-	EventTypeOrderCancel  = "order_cancel"
-	EventTypeOrderFulfill = "order_fulfill"
+	EventTypeCreateBond              = "create_bond"
+	EventTypeEditBond                = "edit_bond"
+	EventTypeCloseBond               = "close_bond"
+	EventTypeSetPaused               = "set_paused"
+	EventTypeSetCircuitBreaker       = "set_circuit_breaker"
+	EventTypeCircuitBreaker          = "circuit_breaker"
+	EventTypeUpdateRole              = "update_role"
+	EventTypeSetFeeRecipients        = "set_fee_recipients"
+	EventTypeSetFeeSchedule          = "set_fee_schedule"
+	EventTypeClaimRewards            = "claim_rewards"
+	EventTypeSetTap                  = "set_tap"
+	EventTypeWithdrawTap             = "withdraw_tap"
+	EventTypeVoteTap                 = "vote_tap"
+	EventTypeRaiseTap                = "raise_tap"
+	EventTypeDissolveBond            = "dissolve_bond"
+	EventTypeRefund                  = "refund"
+	EventTypeSetReserveStaking       = "set_reserve_staking"
+	EventTypeDelegateReserve         = "delegate_reserve"
+	EventTypeUndelegateReserve       = "undelegate_reserve"
+	EventTypeUndelegateReserveFailed = "undelegate_reserve_failed"
+	EventTypeStakingRewards          = "staking_rewards"
+	EventTypeStakingRewardsFailed    = "staking_rewards_failed"
+	EventTypeStakingLoss             = "staking_loss"
+	EventTypeQueueSell               = "queue_sell"
+	EventTypePayQueuedSell           = "pay_queued_sell"
+	EventTypeSweepRoundingSurplus    = "sweep_rounding_surplus"
+	EventTypeInitSwapper             = "init_swapper"
+	EventTypeBuy                     = "buy"
+	EventTypeSell                    = "sell"
+	EventTypeSwap                    = "swap"
+	EventTypeOrderCancel             = "order_cancel"
+	EventTypeOrderFulfill            = "order_fulfill"
+	EventTypeFeePayout               = "fee_payout"
 
-	AttributeKeyBond                   = "bond"
-	AttributeKeyName                   = "name"
-	AttributeKeyDescription            = "description"
-	AttributeKeyFunctionType           = "function_type"
-	AttributeKeyFunctionParameters     = "function_parameters"
-	AttributeKeyReserveTokens          = "reserve_tokens"
-	AttributeKeyReserveAddress         = "reserve_address"
-	AttributeKeyTxFeePercentage        = "tx_fee_percentage"
-	AttributeKeyExitFeePercentage      = "exit_fee_percentage"
-	AttributeKeyFeeAddress             = "fee_address"
-	AttributeKeyMaxSupply              = "max_supply"
-	AttributeKeyOrderQuantityLimits    = "order_quantity_limits"
-	AttributeKeySanityRate             = "sanity_rate"
-	AttributeKeySanityMarginPercentage = "sanity_margin_percentage"
-	AttributeKeyAllowSells             = "allow_sells"
-	AttributeKeySigners                = "signers"
-	AttributeKeyBatchBlocks            = "batch_blocks"
-	AttributeKeyMaxPrices              = "max_prices"
-	AttributeKeySwapFromToken          = "from_token"
-	AttributeKeySwapToToken            = "to_token"
-	AttributeKeyOrderType              = "order_type"
-	AttributeKeyAddress                = "address"
-	AttributeKeyCancelReason           = "cancel_reason"
-	AttributeKeyTokensMinted           = "tokens_minted"
-	AttributeKeyTokensBurned           = "tokens_burned"
-	AttributeKeyTokensSwapped          = "tokens_swapped"
-	AttributeKeyChargedPrices          = "charged_prices"
-	AttributeKeyChargedFees            = "charged_fees"
-	AttributeKeyReturnedToAddress      = "returned_to_address"
+	AttributeKeyBond                    = "bond"
+	AttributeKeyName                    = "name"
+	AttributeKeyDescription             = "description"
+	AttributeKeyFunctionType            = "function_type"
+	AttributeKeyFunctionParameters      = "function_parameters"
+	AttributeKeyReserveTokens           = "reserve_tokens"
+	AttributeKeyReserveAddress          = "reserve_address"
+	AttributeKeyTxFeePercentage         = "tx_fee_percentage"
+	AttributeKeyExitFeePercentage       = "exit_fee_percentage"
+	AttributeKeyFeeAddress              = "fee_address"
+	AttributeKeyFeeRecipients           = "fee_recipients"
+	AttributeKeyFeeSchedule             = "fee_schedule"
+	AttributeKeyHolderRewardsPercentage = "holder_rewards_percentage"
+	AttributeKeyLiquidityFeePercentage  = "liquidity_fee_percentage"
+	AttributeKeyReferralFeePercentage   = "referral_fee_percentage"
+	AttributeKeyMinExitFeePercentage    = "min_exit_fee_percentage"
+	AttributeKeyExitFeeHoldingPeriod    = "exit_fee_holding_period"
+	AttributeKeyMaxSupply               = "max_supply"
+	AttributeKeyOrderQuantityLimits     = "order_quantity_limits"
+	AttributeKeySanityRate              = "sanity_rate"
+	AttributeKeySanityMarginPercentage  = "sanity_margin_percentage"
+	AttributeKeyAllowSells              = "allow_sells"
+	AttributeKeySigners                 = "signers"
+	AttributeKeyBatchBlocks             = "batch_blocks"
+	AttributeKeyCreationFee             = "creation_fee"
+	AttributeKeyDeposit                 = "deposit"
+	AttributeKeySweptReserve            = "swept_reserve"
+	AttributeKeySweptRewards            = "swept_rewards"
+	AttributeKeyPaused                  = "paused"
+	AttributeKeyMaxPriceMovePercentage  = "max_price_move_percentage"
+	AttributeKeyCircuitBreakerMode      = "circuit_breaker_mode"
+	AttributeKeyCircuitBreakerCooldown  = "circuit_breaker_cooldown"
+	AttributeKeyPriceMovePercentage     = "price_move_percentage"
+	AttributeKeyCancelledOrders         = "cancelled_orders"
+	AttributeKeyHaltBlocks              = "halt_blocks"
+	AttributeKeyRole                    = "role"
+	AttributeKeyAddresses               = "addresses"
+	AttributeKeyThreshold               = "threshold"
+	AttributeKeyMaxPrices               = "max_prices"
+	AttributeKeySwapFromToken           = "from_token"
+	AttributeKeySwapToToken             = "to_token"
+	AttributeKeyOrderType               = "order_type"
+	AttributeKeyAddress                 = "address"
+	AttributeKeyCancelReason            = "cancel_reason"
+	AttributeKeyTokensMinted            = "tokens_minted"
+	AttributeKeyTokensBurned            = "tokens_burned"
+	AttributeKeyTokensSwapped           = "tokens_swapped"
+	AttributeKeyChargedPrices           = "charged_prices"
+	AttributeKeyChargedFees             = "charged_fees"
+	AttributeKeyLiquidityFee            = "liquidity_fee"
+	AttributeKeyReferrer                = "referrer"
+	AttributeKeyReferralFees            = "referral_fees"
+	AttributeKeyReturnedToAddress       = "returned_to_address"
+	AttributeKeyAmount                  = "amount"
+	AttributeKeyBeneficiary             = "beneficiary"
+	AttributeKeyTapRate                 = "tap_rate"
+	AttributeKeyProposedTapRate         = "proposed_tap_rate"
+	AttributeKeyTapFloorPercentage      = "tap_floor_percentage"
+	AttributeKeyOption                  = "option"
+	AttributeKeyRefunds                 = "refunds"
+	AttributeKeyValidator               = "validator"
+	AttributeKeyStakingCapPercentage    = "staking_cap_percentage"
+	AttributeKeyRewardsToReserve        = "rewards_to_reserve"
+	AttributeKeyCompletionTime          = "completion_time"
+	AttributeKeyError                   = "error"
 
 	AttributeValueBuyOrder  = "buy"
 	AttributeValueSellOrder = "sell"
@@ -0,0 +1,120 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type FeeRecipient struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	Share   sdk.Dec        `json:"share" yaml:"share"`
+}
+
+func NewFeeRecipient(address sdk.AccAddress, share sdk.Dec) FeeRecipient {
+	return FeeRecipient{
+		Address: address,
+		Share:   share,
+	}
+}
+
+type FeePayout struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	Amount  sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+func NewFeePayout(address sdk.AccAddress, amount sdk.Coins) FeePayout {
+	return FeePayout{
+		Address: address,
+		Amount:  amount,
+	}
+}
+
+type FeeRecipients []FeeRecipient
+
+func NewDefaultFeeRecipients(feeAddress sdk.AccAddress) FeeRecipients {
+	// By default, the fee address receives all fees
+	return FeeRecipients{NewFeeRecipient(feeAddress, sdk.NewDec(100))}
+}
+
+func (frs FeeRecipients) String() (result string) {
+	result = "{"
+	for _, fr := range frs {
+		result += fr.Address.String() + ":" + fr.Share.String() + ","
+	}
+	if len(frs) > 0 {
+		// Remove last comma
+		result = result[:len(result)-1]
+	}
+	return result + "}"
+}
+
+func (frs FeeRecipients) HasAddress(address sdk.AccAddress) bool {
+	for _, fr := range frs {
+		if fr.Address.Equals(address) {
+			return true
+		}
+	}
+	return false
+}
+
+func (frs FeeRecipients) Validate(feeAddress sdk.AccAddress) sdk.Error {
+	if len(frs) == 0 {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "FeeRecipients")
+	}
+
+	// Check that addresses are not empty or duplicated, and shares are positive
+	totalShares := sdk.ZeroDec()
+	for i, fr := range frs {
+		if fr.Address.Empty() {
+			return ErrArgumentCannotBeEmpty(DefaultCodespace, "Fee recipient address")
+		} else if fr.Share.IsNil() || !fr.Share.IsPositive() {
+			return ErrArgumentMustBePositive(DefaultCodespace, "Fee recipient share")
+		}
+		for _, other := range frs[i+1:] {
+			if fr.Address.Equals(other.Address) {
+				return ErrDuplicateFeeRecipient(DefaultCodespace, fr.Address)
+			}
+		}
+		totalShares = totalShares.Add(fr.Share)
+	}
+
+	// Check that shares sum up to 100
+	if !totalShares.Equal(sdk.NewDec(100)) {
+		return ErrFeeRecipientSharesDoNotSumTo100(DefaultCodespace, totalShares)
+	}
+
+	// Check that the fee address (which receives remainders) is a recipient
+	if !frs.HasAddress(feeAddress) {
+		return ErrFeeAddressNotAFeeRecipient(DefaultCodespace, feeAddress)
+	}
+
+	return nil
+}
+
+//noinspection GoNilness
+func (frs FeeRecipients) GetPayouts(fees sdk.Coins, feeAddress sdk.AccAddress) (payouts []FeePayout) {
+	// If there are no recipients, the fee address receives all fees
+	if len(frs) == 0 {
+		return []FeePayout{NewFeePayout(feeAddress, fees)}
+	}
+
+	// Each recipient receives its share of the fees (rounded down), and any
+	// rounding remainder is added to the payout to the fee address
+	var totalPaid sdk.Coins
+	for _, fr := range frs {
+		var amount sdk.Coins
+		for _, fee := range fees {
+			shareAmount := sdk.NewDecFromInt(fee.Amount).Mul(fr.Share).QuoInt64(100).TruncateInt()
+			amount = amount.Add(sdk.Coins{sdk.NewCoin(fee.Denom, shareAmount)})
+		}
+		totalPaid = totalPaid.Add(amount)
+		payouts = append(payouts, NewFeePayout(fr.Address, amount))
+	}
+	remainder := fees.Sub(totalPaid)
+	for i := range payouts {
+		if payouts[i].Address.Equals(feeAddress) {
+			payouts[i].Amount = payouts[i].Amount.Add(remainder)
+			return payouts
+		}
+	}
+	return append(payouts, NewFeePayout(feeAddress, remainder))
+}
@@ -0,0 +1,86 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"testing"
+)
+
+func TestFeeRecipientsValidate(t *testing.T) {
+	addr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	addr2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	addr3 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	testCases := []struct {
+		recipients FeeRecipients
+		feeAddress sdk.AccAddress
+		expected   sdk.CodeType
+	}{
+		{nil, addr1, CodeArgumentInvalid},
+		{FeeRecipients{NewFeeRecipient(sdk.AccAddress{}, sdk.NewDec(100))}, addr1, CodeArgumentInvalid},
+		{FeeRecipients{NewFeeRecipient(addr1, sdk.ZeroDec()), NewFeeRecipient(addr2, sdk.NewDec(100))}, addr1, CodeArgumentInvalid},
+		{FeeRecipients{NewFeeRecipient(addr1, sdk.NewDec(50)), NewFeeRecipient(addr1, sdk.NewDec(50))}, addr1, CodeInvalidFeeRecipients},
+		{FeeRecipients{NewFeeRecipient(addr1, sdk.NewDec(50)), NewFeeRecipient(addr2, sdk.NewDec(49))}, addr1, CodeInvalidFeeRecipients},
+		{FeeRecipients{NewFeeRecipient(addr1, sdk.NewDec(50)), NewFeeRecipient(addr2, sdk.NewDec(51))}, addr1, CodeInvalidFeeRecipients},
+		{FeeRecipients{NewFeeRecipient(addr1, sdk.NewDec(50)), NewFeeRecipient(addr2, sdk.NewDec(50))}, addr3, CodeInvalidFeeRecipients},
+	}
+	for _, tc := range testCases {
+		err := tc.recipients.Validate(tc.feeAddress)
+		require.NotNil(t, err)
+		require.Equal(t, tc.expected, err.Code())
+	}
+
+	valid := FeeRecipients{
+		NewFeeRecipient(addr1, sdk.MustNewDecFromStr("33.3")),
+		NewFeeRecipient(addr2, sdk.MustNewDecFromStr("33.3")),
+		NewFeeRecipient(addr3, sdk.MustNewDecFromStr("33.4")),
+	}
+	require.Nil(t, valid.Validate(addr3))
+	require.Nil(t, NewDefaultFeeRecipients(addr1).Validate(addr1))
+}
+
+func TestFeeRecipientsGetPayoutsWithNoRecipientsPaysFeeAddress(t *testing.T) {
+	feeAddress := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	fees := sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 10))
+
+	payouts := FeeRecipients(nil).GetPayouts(fees, feeAddress)
+
+	require.Equal(t, []FeePayout{NewFeePayout(feeAddress, fees)}, payouts)
+}
+
+func TestFeeRecipientsGetPayoutsSendsRemainderToFeeAddress(t *testing.T) {
+	addr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	addr2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	addr3 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	recipients := FeeRecipients{
+		NewFeeRecipient(addr1, sdk.NewDec(50)),
+		NewFeeRecipient(addr2, sdk.NewDec(30)),
+		NewFeeRecipient(addr3, sdk.NewDec(20)),
+	}
+	fees := sdk.NewCoins(
+		sdk.NewInt64Coin(reserveToken, 11),
+		sdk.NewInt64Coin(reserveToken2, 3),
+	)
+
+	// Fee address addr2 receives the remainders: 11res -> 5,3,2 (+1 to
+	// addr2) and 3rez -> 1,0,0 (+2 to addr2)
+	payouts := recipients.GetPayouts(fees, addr2)
+
+	require.Len(t, payouts, 3)
+	require.Equal(t, NewFeePayout(addr1, sdk.NewCoins(
+		sdk.NewInt64Coin(reserveToken, 5),
+		sdk.NewInt64Coin(reserveToken2, 1))), payouts[0])
+	require.Equal(t, NewFeePayout(addr2, sdk.NewCoins(
+		sdk.NewInt64Coin(reserveToken, 4),
+		sdk.NewInt64Coin(reserveToken2, 2))), payouts[1])
+	require.Equal(t, NewFeePayout(addr3, sdk.NewCoins(
+		sdk.NewInt64Coin(reserveToken, 2))), payouts[2])
+
+	// Payouts add up to the total fees
+	var total sdk.Coins
+	for _, p := range payouts {
+		total = total.Add(p.Amount)
+	}
+	require.Equal(t, fees, total)
+}
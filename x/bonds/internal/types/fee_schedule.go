@@ -0,0 +1,131 @@
+package types
+
+import (
+	"fmt"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	MinVolatilityBatches = 2
+	MaxVolatilityBatches = 100
+)
+
+type FeeTier struct {
+	Threshold  sdk.Dec `json:"threshold" yaml:"threshold"`
+	Multiplier sdk.Dec `json:"multiplier" yaml:"multiplier"`
+}
+
+func NewFeeTier(threshold, multiplier sdk.Dec) FeeTier {
+	return FeeTier{
+		Threshold:  threshold,
+		Multiplier: multiplier,
+	}
+}
+
+type FeeTiers []FeeTier
+
+func (fts FeeTiers) String() (result string) {
+	result = "{"
+	for _, ft := range fts {
+		result += ft.Threshold.String() + ":" + ft.Multiplier.String() + ","
+	}
+	if len(fts) > 0 {
+		// Remove last comma
+		result = result[:len(result)-1]
+	}
+	return result + "}"
+}
+
+func (fts FeeTiers) Validate(name string) sdk.Error {
+	// Check that values are not negative and thresholds are ascending
+	for i, ft := range fts {
+		if ft.Threshold.IsNil() || ft.Threshold.IsNegative() {
+			return ErrArgumentCannotBeNegative(DefaultCodespace, name+" threshold")
+		} else if ft.Multiplier.IsNil() || ft.Multiplier.IsNegative() {
+			return ErrArgumentCannotBeNegative(DefaultCodespace, name+" multiplier")
+		} else if i > 0 && !ft.Threshold.GT(fts[i-1].Threshold) {
+			return ErrFeeTierThresholdsNotAscending(DefaultCodespace, name)
+		}
+	}
+	return nil
+}
+
+func (fts FeeTiers) GetMultiplier(value sdk.Dec) sdk.Dec {
+	// The multiplier of the tier with the largest threshold that the value
+	// reaches applies, and values below all thresholds are not multiplied
+	multiplier := sdk.OneDec()
+	for _, ft := range fts {
+		if value.LT(ft.Threshold) {
+			break
+		}
+		multiplier = ft.Multiplier
+	}
+	return multiplier
+}
+
+func (fts FeeTiers) GetMaxMultiplier() sdk.Dec {
+	maxMultiplier := sdk.OneDec()
+	for _, ft := range fts {
+		maxMultiplier = sdk.MaxDec(maxMultiplier, ft.Multiplier)
+	}
+	return maxMultiplier
+}
+
+type FeeSchedule struct {
+	SizeTiers         FeeTiers `json:"size_tiers" yaml:"size_tiers"`
+	VolatilityTiers   FeeTiers `json:"volatility_tiers" yaml:"volatility_tiers"`
+	VolatilityBatches sdk.Uint `json:"volatility_batches" yaml:"volatility_batches"`
+}
+
+func NewFeeSchedule(sizeTiers, volatilityTiers FeeTiers, volatilityBatches sdk.Uint) FeeSchedule {
+	return FeeSchedule{
+		SizeTiers:         sizeTiers,
+		VolatilityTiers:   volatilityTiers,
+		VolatilityBatches: volatilityBatches,
+	}
+}
+
+func NewDefaultFeeSchedule() FeeSchedule {
+	// By default, the flat fee percentages apply to all orders
+	return NewFeeSchedule(nil, nil, sdk.ZeroUint())
+}
+
+func (fs FeeSchedule) String() string {
+	return fmt.Sprintf("{size_tiers:%s,volatility_tiers:%s,volatility_batches:%s}",
+		fs.SizeTiers.String(), fs.VolatilityTiers.String(), fs.VolatilityBatches.String())
+}
+
+func (fs FeeSchedule) HasVolatilityTiers() bool {
+	return len(fs.VolatilityTiers) != 0
+}
+
+func (fs FeeSchedule) Validate() sdk.Error {
+	if err := fs.SizeTiers.Validate("Size tier"); err != nil {
+		return err
+	} else if err := fs.VolatilityTiers.Validate("Volatility tier"); err != nil {
+		return err
+	}
+
+	// Volatility is measured across the last N batches, so N is only
+	// relevant (and required) if there are volatility tiers
+	if fs.HasVolatilityTiers() {
+		if fs.VolatilityBatches.LT(sdk.NewUint(MinVolatilityBatches)) ||
+			fs.VolatilityBatches.GT(sdk.NewUint(MaxVolatilityBatches)) {
+			return ErrVolatilityBatchesOutOfRange(DefaultCodespace,
+				MinVolatilityBatches, MaxVolatilityBatches)
+		}
+	} else if !fs.VolatilityBatches.IsZero() {
+		return ErrVolatilityBatchesOutOfRange(DefaultCodespace, 0, 0)
+	}
+
+	return nil
+}
+
+func (fs FeeSchedule) GetMultiplier(orderSize, volatilityPercentage sdk.Dec) sdk.Dec {
+	return fs.SizeTiers.GetMultiplier(orderSize).Mul(
+		fs.VolatilityTiers.GetMultiplier(volatilityPercentage))
+}
+
+func (fs FeeSchedule) GetMaxMultiplier() sdk.Dec {
+	return fs.SizeTiers.GetMaxMultiplier().Mul(fs.VolatilityTiers.GetMaxMultiplier())
+}
@@ -0,0 +1,102 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestFeeTiersValidate(t *testing.T) {
+	testCases := []struct {
+		tiers    FeeTiers
+		expected sdk.CodeType
+	}{
+		{FeeTiers{NewFeeTier(sdk.NewDec(-1), sdk.OneDec())}, CodeArgumentInvalid},
+		{FeeTiers{NewFeeTier(sdk.NewDec(10), sdk.NewDec(-1))}, CodeArgumentInvalid},
+		{FeeTiers{NewFeeTier(sdk.NewDec(10), sdk.OneDec()), NewFeeTier(sdk.NewDec(10), sdk.OneDec())}, CodeInvalidFeeSchedule},
+		{FeeTiers{NewFeeTier(sdk.NewDec(10), sdk.OneDec()), NewFeeTier(sdk.NewDec(5), sdk.OneDec())}, CodeInvalidFeeSchedule},
+	}
+	for _, tc := range testCases {
+		err := tc.tiers.Validate("Tier")
+		require.NotNil(t, err)
+		require.Equal(t, tc.expected, err.Code())
+	}
+
+	valid := FeeTiers{
+		NewFeeTier(sdk.ZeroDec(), sdk.NewDec(2)),
+		NewFeeTier(sdk.NewDec(10), sdk.ZeroDec()),
+	}
+	require.Nil(t, valid.Validate("Tier"))
+	require.Nil(t, FeeTiers(nil).Validate("Tier"))
+}
+
+func TestFeeTiersGetMultiplier(t *testing.T) {
+	tiers := FeeTiers{
+		NewFeeTier(sdk.NewDec(100), sdk.MustNewDecFromStr("0.75")),
+		NewFeeTier(sdk.NewDec(1000), sdk.MustNewDecFromStr("0.5")),
+	}
+
+	testCases := []struct {
+		value    sdk.Dec
+		expected sdk.Dec
+	}{
+		{sdk.ZeroDec(), sdk.OneDec()},
+		{sdk.MustNewDecFromStr("99.9"), sdk.OneDec()},
+		{sdk.NewDec(100), sdk.MustNewDecFromStr("0.75")},
+		{sdk.NewDec(999), sdk.MustNewDecFromStr("0.75")},
+		{sdk.NewDec(1000), sdk.MustNewDecFromStr("0.5")},
+		{sdk.NewDec(1000000), sdk.MustNewDecFromStr("0.5")},
+	}
+	for _, tc := range testCases {
+		require.Equal(t, tc.expected, tiers.GetMultiplier(tc.value))
+	}
+
+	// No tiers means no multiplier
+	require.Equal(t, sdk.OneDec(), FeeTiers(nil).GetMultiplier(sdk.NewDec(100)))
+}
+
+func TestFeeScheduleValidate(t *testing.T) {
+	tiers := FeeTiers{NewFeeTier(sdk.NewDec(5), sdk.NewDec(2))}
+
+	testCases := []struct {
+		schedule FeeSchedule
+		valid    bool
+	}{
+		{NewDefaultFeeSchedule(), true},
+		{NewFeeSchedule(tiers, nil, sdk.ZeroUint()), true},
+		{NewFeeSchedule(nil, tiers, sdk.NewUint(MinVolatilityBatches)), true},
+		{NewFeeSchedule(nil, tiers, sdk.NewUint(MaxVolatilityBatches)), true},
+		{NewFeeSchedule(nil, tiers, sdk.NewUint(MinVolatilityBatches-1)), false},
+		{NewFeeSchedule(nil, tiers, sdk.NewUint(MaxVolatilityBatches+1)), false},
+		{NewFeeSchedule(tiers, nil, sdk.NewUint(MinVolatilityBatches)), false},
+	}
+	for _, tc := range testCases {
+		err := tc.schedule.Validate()
+		if tc.valid {
+			require.Nil(t, err)
+		} else {
+			require.NotNil(t, err)
+			require.Equal(t, CodeInvalidFeeSchedule, err.Code())
+		}
+	}
+}
+
+func TestFeeScheduleGetMultiplierAndMaxMultiplier(t *testing.T) {
+	schedule := NewFeeSchedule(
+		FeeTiers{NewFeeTier(sdk.NewDec(1000), sdk.MustNewDecFromStr("0.5"))},
+		FeeTiers{
+			NewFeeTier(sdk.NewDec(5), sdk.MustNewDecFromStr("1.5")),
+			NewFeeTier(sdk.NewDec(20), sdk.NewDec(3)),
+		},
+		sdk.NewUint(10))
+
+	// Size and volatility multipliers are combined
+	require.Equal(t, sdk.OneDec(), schedule.GetMultiplier(sdk.NewDec(10), sdk.ZeroDec()))
+	require.Equal(t, sdk.MustNewDecFromStr("0.5"), schedule.GetMultiplier(sdk.NewDec(1000), sdk.ZeroDec()))
+	require.Equal(t, sdk.MustNewDecFromStr("1.5"), schedule.GetMultiplier(sdk.NewDec(10), sdk.NewDec(5)))
+	require.Equal(t, sdk.MustNewDecFromStr("1.5"), schedule.GetMultiplier(sdk.NewDec(1000), sdk.NewDec(20)))
+
+	// Size multipliers below 1 do not reduce the max multiplier
+	require.Equal(t, sdk.NewDec(3), schedule.GetMaxMultiplier())
+	require.Equal(t, sdk.OneDec(), NewDefaultFeeSchedule().GetMaxMultiplier())
+}
@@ -1,25 +1,55 @@
 package types
 
 type GenesisState struct {
-	Bonds   []Bond  `json:"bonds" yaml:"bonds"`
-	Batches []Batch `json:"batches" yaml:"batches"`
+	Bonds             []Bond             `json:"bonds" yaml:"bonds"`
+	Batches           []Batch            `json:"batches" yaml:"batches"`
+	HolderRewards     []HolderRewards    `json:"holder_rewards" yaml:"holder_rewards"`
+	HolderLots        []HolderLots       `json:"holder_lots" yaml:"holder_lots"`
+	TapVotes          []TapVote          `json:"tap_votes" yaml:"tap_votes"`
+	ReferrerTotals    []ReferrerTotal    `json:"referrer_totals" yaml:"referrer_totals"`
+	Candles           []Candle           `json:"candles" yaml:"candles"`
+	PriceObservations []PriceObservation `json:"price_observations" yaml:"price_observations"`
+	BondStats         []BondStats        `json:"bond_stats" yaml:"bond_stats"`
+	BondBuyers        []BondBuyer        `json:"bond_buyers" yaml:"bond_buyers"`
+	Params            Params             `json:"params" yaml:"params"`
 }
 
-func NewGenesisState(bonds []Bond, batches []Batch) GenesisState {
+func NewGenesisState(bonds []Bond, batches []Batch,
+	holderRewards []HolderRewards, holderLots []HolderLots,
+	tapVotes []TapVote, referrerTotals []ReferrerTotal, candles []Candle,
+	priceObservations []PriceObservation, bondStats []BondStats,
+	bondBuyers []BondBuyer, params Params) GenesisState {
 	return GenesisState{
-		Bonds:   bonds,
-		Batches: batches,
+		Bonds:             bonds,
+		Batches:           batches,
+		HolderRewards:     holderRewards,
+		HolderLots:        holderLots,
+		TapVotes:          tapVotes,
+		ReferrerTotals:    referrerTotals,
+		Candles:           candles,
+		PriceObservations: priceObservations,
+		BondStats:         bondStats,
+		BondBuyers:        bondBuyers,
+		Params:            params,
 	}
 }
 
-//noinspection GoUnusedParameter
 func ValidateGenesis(data GenesisState) error {
-	return nil
+	return data.Params.Validate()
 }
 
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		Bonds:   nil,
-		Batches: nil,
+		Bonds:             nil,
+		Batches:           nil,
+		HolderRewards:     nil,
+		HolderLots:        nil,
+		TapVotes:          nil,
+		ReferrerTotals:    nil,
+		Candles:           nil,
+		PriceObservations: nil,
+		BondStats:         nil,
+		BondBuyers:        nil,
+		Params:            DefaultParams(),
 	}
 }
@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BankHooks are called by the bank keeper wrapper before and after an
+// account's balance of any of the specified coins is changed
+type BankHooks interface {
+	BeforeCoinsChange(ctx sdk.Context, addr sdk.AccAddress, coins sdk.Coins)
+	AfterCoinsChange(ctx sdk.Context, addr sdk.AccAddress, coins sdk.Coins)
+}
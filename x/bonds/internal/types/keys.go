@@ -1,5 +1,10 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+)
+
 const (
 	// ModuleName is the name of this module
 	ModuleName = "bonds"
@@ -7,12 +12,25 @@ const (
 	// StoreKey is the default store key for this module
 	StoreKey = ModuleName
 
+	// DefaultParamspace is the default param space for this module
+	DefaultParamspace = ModuleName
+
 	// BondsMintBurnAccount the root string for the bonds mint burn account address
 	BondsMintBurnAccount = "bonds_mint_burn_account"
 
 	// BatchesIntermediaryAccount the root string for the batches account address
 	BatchesIntermediaryAccount = "batches_intermediary_account"
 
+	// BondsDepositAccount the root string for the bond creation deposits account address
+	BondsDepositAccount = "bonds_deposit_account"
+
+	// BondsReserveAccount the root string for bond reserve account addresses,
+	// each of which is derived from this root string and the bond's token
+	BondsReserveAccount = "bonds_reserve_account"
+
+	// BondsRewardsAccount the root string for the bond holder rewards account address
+	BondsRewardsAccount = "bonds_rewards_account"
+
 	// QuerierRoute is the querier route for this module's store.
 	QuerierRoute = ModuleName
 
@@ -25,10 +43,49 @@ const (
 // - Bonds: 0x00<bond_token_bytes>
 // - Batches: 0x01<bond_token_bytes>
 // - Last batches: 0x02<bond_token_bytes>
+// - Holder rewards: 0x03<bond_token_bytes>/<holder_address_bytes>
+// - Holder lots: 0x04<bond_token_bytes>/<holder_address_bytes>
+// - Tap votes: 0x05<bond_token_bytes>/<voter_address_bytes>
+// - Referrer totals: 0x07<bond_token_bytes>/<referrer_address_bytes>
+//
+// Bonds are also indexed as follows, with the bond's token as the value:
+//
+// - With staked (delegated or unbonding) reserve: 0x06<bond_token_bytes>
+// - By creator: 0x08<creator_address_bytes><bond_token_bytes>
+// - By signer: 0x09<signer_address_bytes><bond_token_bytes>
+// - By reserve denom: 0x0A<reserve_denom_bytes>/<bond_token_bytes>
+// - By order address (in current or last batch): 0x0D<order_address_bytes><bond_token_bytes>
+//
+// Each bond's most recent candles and price observations are stored as follows:
+//
+// - Candles: 0x0B<bond_token_bytes>/<big_endian_sequence_bytes>
+// - Price observations: 0x0C<bond_token_bytes>/<big_endian_height_bytes>
+//
+// Each bond's cumulative statistics and buyers are stored as follows:
+//
+// - Bond stats: 0x0E<bond_token_bytes>
+// - Bond buyers: 0x0F<bond_token_bytes>/<buyer_address_bytes>
 var (
-	BondsKeyPrefix       = []byte{0x00} // key for bonds
-	BatchesKeyPrefix     = []byte{0x01} // key for batches
-	LastBatchesKeyPrefix = []byte{0x02} // key for last batches
+	BondsKeyPrefix          = []byte{0x00} // key for bonds
+	BatchesKeyPrefix        = []byte{0x01} // key for batches
+	LastBatchesKeyPrefix    = []byte{0x02} // key for last batches
+	HolderRewardsKeyPrefix  = []byte{0x03} // key for holder rewards
+	HolderLotsKeyPrefix     = []byte{0x04} // key for holder lots
+	TapVotesKeyPrefix       = []byte{0x05} // key for tap votes
+	ReferrerTotalsKeyPrefix = []byte{0x07} // key for referrer totals
+
+	StakedReserveIndexKeyPrefix = []byte{0x06} // key for bonds with staked reserve
+	CreatorIndexKeyPrefix       = []byte{0x08} // key for bonds by creator
+	SignerIndexKeyPrefix        = []byte{0x09} // key for bonds by signer
+	ReserveDenomIndexKeyPrefix  = []byte{0x0A} // key for bonds by reserve denom
+
+	CandlesKeyPrefix           = []byte{0x0B} // key for candles
+	PriceObservationsKeyPrefix = []byte{0x0C} // key for price observations
+
+	OrderAddressIndexKeyPrefix = []byte{0x0D} // key for bonds by order address
+
+	BondStatsKeyPrefix  = []byte{0x0E} // key for bond stats
+	BondBuyersKeyPrefix = []byte{0x0F} // key for bond buyers
 )
 
 func GetBondKey(token string) []byte {
@@ -42,3 +99,121 @@ func GetBatchKey(token string) []byte {
 func GetLastBatchKey(token string) []byte {
 	return append(LastBatchesKeyPrefix, []byte(token)...)
 }
+
+func GetHolderRewardsPrefix(token string) []byte {
+	// Tokens cannot contain a '/', so a token's prefix is never a prefix of another token's
+	return append(HolderRewardsKeyPrefix, []byte(token+"/")...)
+}
+
+func GetHolderRewardsKey(token string, address sdk.AccAddress) []byte {
+	return append(GetHolderRewardsPrefix(token), address.Bytes()...)
+}
+
+func GetHolderLotsPrefix(token string) []byte {
+	return append(HolderLotsKeyPrefix, []byte(token+"/")...)
+}
+
+func GetHolderLotsKey(token string, address sdk.AccAddress) []byte {
+	return append(GetHolderLotsPrefix(token), address.Bytes()...)
+}
+
+func GetTapVotesPrefix(token string) []byte {
+	return append(TapVotesKeyPrefix, []byte(token+"/")...)
+}
+
+func GetTapVotesKey(token string, address sdk.AccAddress) []byte {
+	return append(GetTapVotesPrefix(token), address.Bytes()...)
+}
+
+func GetReferrerTotalsPrefix(token string) []byte {
+	return append(ReferrerTotalsKeyPrefix, []byte(token+"/")...)
+}
+
+func GetReferrerTotalsKey(token string, referrer sdk.AccAddress) []byte {
+	return append(GetReferrerTotalsPrefix(token), referrer.Bytes()...)
+}
+
+func GetStakedReserveIndexKey(token string) []byte {
+	return append(StakedReserveIndexKeyPrefix, []byte(token)...)
+}
+
+func GetCandlesPrefix(token string) []byte {
+	return append(CandlesKeyPrefix, []byte(token+"/")...)
+}
+
+func GetCandleKey(token string, sequence uint64) []byte {
+	// Big endian sequences keep a bond's candles sorted from oldest to newest
+	return append(GetCandlesPrefix(token), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+func GetPriceObservationsPrefix(token string) []byte {
+	return append(PriceObservationsKeyPrefix, []byte(token+"/")...)
+}
+
+func GetPriceObservationKey(token string, height int64) []byte {
+	// Big endian heights keep a bond's observations sorted by height
+	return append(GetPriceObservationsPrefix(token), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+func GetBondStatsKey(token string) []byte {
+	return append(BondStatsKeyPrefix, []byte(token)...)
+}
+
+func GetBondBuyersPrefix(token string) []byte {
+	return append(BondBuyersKeyPrefix, []byte(token+"/")...)
+}
+
+func GetBondBuyerKey(token string, buyer sdk.AccAddress) []byte {
+	return append(GetBondBuyersPrefix(token), buyer.Bytes()...)
+}
+
+func GetCreatorIndexPrefix(creator sdk.AccAddress) []byte {
+	// Addresses have a fixed length, so an address' prefix is never a prefix of another address'
+	return append(CreatorIndexKeyPrefix, creator.Bytes()...)
+}
+
+func GetCreatorIndexKey(creator sdk.AccAddress, token string) []byte {
+	return append(GetCreatorIndexPrefix(creator), []byte(token)...)
+}
+
+func GetSignerIndexPrefix(signer sdk.AccAddress) []byte {
+	return append(SignerIndexKeyPrefix, signer.Bytes()...)
+}
+
+func GetSignerIndexKey(signer sdk.AccAddress, token string) []byte {
+	return append(GetSignerIndexPrefix(signer), []byte(token)...)
+}
+
+func GetReserveDenomIndexPrefix(denom string) []byte {
+	return append(ReserveDenomIndexKeyPrefix, []byte(denom+"/")...)
+}
+
+func GetReserveDenomIndexKey(denom string, token string) []byte {
+	return append(GetReserveDenomIndexPrefix(denom), []byte(token)...)
+}
+
+func GetOrderAddressIndexPrefix(address sdk.AccAddress) []byte {
+	return append(OrderAddressIndexKeyPrefix, address.Bytes()...)
+}
+
+func GetOrderAddressIndexKey(address sdk.AccAddress, token string) []byte {
+	return append(GetOrderAddressIndexPrefix(address), []byte(token)...)
+}
+
+// GetBondIndexKeys returns the keys of all of the bond's index entries. Since
+// a bond's signers only hold the bond's roles until roles are updated, the
+// bond is indexed by every address that currently holds any of its roles.
+func GetBondIndexKeys(bond Bond) (keys [][]byte) {
+	keys = append(keys, GetCreatorIndexKey(bond.Creator, bond.Token))
+	for _, s := range bond.Roles.GetAllAddresses() {
+		keys = append(keys, GetSignerIndexKey(s, bond.Token))
+	}
+	for _, r := range bond.ReserveTokens {
+		keys = append(keys, GetReserveDenomIndexKey(r, bond.Token))
+	}
+	return keys
+}
+
+func GetReserveAddress(token string) sdk.AccAddress {
+	return supply.NewModuleAddress(BondsReserveAccount + "/" + token)
+}
@@ -0,0 +1,85 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type Lot struct {
+	Height int64   `json:"height" yaml:"height"`
+	Amount sdk.Int `json:"amount" yaml:"amount"`
+}
+
+func NewLot(height int64, amount sdk.Int) Lot {
+	return Lot{
+		Height: height,
+		Amount: amount,
+	}
+}
+
+type HolderLots struct {
+	Token   string         `json:"token" yaml:"token"`
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	Lots    []Lot          `json:"lots" yaml:"lots"`
+}
+
+func NewHolderLots(token string, address sdk.AccAddress) HolderLots {
+	return HolderLots{
+		Token:   token,
+		Address: address,
+		Lots:    nil,
+	}
+}
+
+func (hl HolderLots) GetTotal() sdk.Int {
+	total := sdk.ZeroInt()
+	for _, lot := range hl.Lots {
+		total = total.Add(lot.Amount)
+	}
+	return total
+}
+
+func (hl HolderLots) Add(height int64, amount sdk.Int) HolderLots {
+	// Lots are kept oldest first, and tokens acquired at the same height as
+	// the newest lot are merged into that lot
+	lots := make([]Lot, len(hl.Lots), len(hl.Lots)+1)
+	copy(lots, hl.Lots)
+	if n := len(lots); n != 0 && lots[n-1].Height == height {
+		lots[n-1].Amount = lots[n-1].Amount.Add(amount)
+	} else {
+		lots = append(lots, NewLot(height, amount))
+	}
+	hl.Lots = lots
+	return hl
+}
+
+func (hl HolderLots) GetOldest(amount sdk.Int) (lots []Lot) {
+	// The oldest lots that make up the amount (first in, first out), with
+	// the last of these lots reduced to the remaining part of the amount
+	remaining := amount
+	for _, lot := range hl.Lots {
+		if !remaining.IsPositive() {
+			break
+		}
+		lots = append(lots, NewLot(lot.Height, sdk.MinInt(lot.Amount, remaining)))
+		remaining = remaining.Sub(lot.Amount)
+	}
+	return lots
+}
+
+func (hl HolderLots) Remove(amount sdk.Int) HolderLots {
+	// Lots are removed oldest first (first in, first out)
+	var lots []Lot
+	remaining := amount
+	for _, lot := range hl.Lots {
+		if remaining.GTE(lot.Amount) {
+			remaining = remaining.Sub(lot.Amount)
+			continue
+		} else if remaining.IsPositive() {
+			lot = NewLot(lot.Height, lot.Amount.Sub(remaining))
+			remaining = sdk.ZeroInt()
+		}
+		lots = append(lots, lot)
+	}
+	hl.Lots = lots
+	return hl
+}
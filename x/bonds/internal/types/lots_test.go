@@ -0,0 +1,72 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"testing"
+)
+
+func getValidHolderLots() HolderLots {
+	address := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	return NewHolderLots(initToken, address).
+		Add(10, sdk.NewInt(100)).
+		Add(20, sdk.NewInt(50)).
+		Add(30, sdk.NewInt(25))
+}
+
+func TestHolderLotsAdd(t *testing.T) {
+	holderLots := getValidHolderLots()
+	require.Equal(t, []Lot{
+		NewLot(10, sdk.NewInt(100)),
+		NewLot(20, sdk.NewInt(50)),
+		NewLot(30, sdk.NewInt(25)),
+	}, holderLots.Lots)
+	require.Equal(t, sdk.NewInt(175), holderLots.GetTotal())
+
+	// Adding at the same height as the newest lot merges the amounts
+	merged := holderLots.Add(30, sdk.NewInt(5))
+	require.Len(t, merged.Lots, 3)
+	require.Equal(t, NewLot(30, sdk.NewInt(30)), merged.Lots[2])
+
+	// Original lots are not modified
+	require.Equal(t, NewLot(30, sdk.NewInt(25)), holderLots.Lots[2])
+}
+
+func TestHolderLotsGetOldest(t *testing.T) {
+	holderLots := getValidHolderLots()
+
+	testCases := []struct {
+		amount   sdk.Int
+		expected []Lot
+	}{
+		{sdk.ZeroInt(), nil},
+		{sdk.NewInt(60), []Lot{NewLot(10, sdk.NewInt(60))}},
+		{sdk.NewInt(100), []Lot{NewLot(10, sdk.NewInt(100))}},
+		{sdk.NewInt(120), []Lot{NewLot(10, sdk.NewInt(100)), NewLot(20, sdk.NewInt(20))}},
+		{sdk.NewInt(175), holderLots.Lots},
+		{sdk.NewInt(200), holderLots.Lots}, // more than total
+	}
+	for _, tc := range testCases {
+		require.Equal(t, tc.expected, holderLots.GetOldest(tc.amount))
+	}
+}
+
+func TestHolderLotsRemove(t *testing.T) {
+	holderLots := getValidHolderLots()
+
+	testCases := []struct {
+		amount   sdk.Int
+		expected []Lot
+	}{
+		{sdk.ZeroInt(), holderLots.Lots},
+		{sdk.NewInt(60), []Lot{NewLot(10, sdk.NewInt(40)), NewLot(20, sdk.NewInt(50)), NewLot(30, sdk.NewInt(25))}},
+		{sdk.NewInt(100), []Lot{NewLot(20, sdk.NewInt(50)), NewLot(30, sdk.NewInt(25))}},
+		{sdk.NewInt(120), []Lot{NewLot(20, sdk.NewInt(30)), NewLot(30, sdk.NewInt(25))}},
+		{sdk.NewInt(175), nil},
+		{sdk.NewInt(200), nil}, // more than total
+	}
+	for _, tc := range testCases {
+		require.Equal(t, tc.expected, holderLots.Remove(tc.amount).Lots)
+	}
+}
@@ -6,48 +6,61 @@ import (
 )
 
 type MsgCreateBond struct {
-	Token                  string           `json:"token" yaml:"token"`
-	Name                   string           `json:"name" yaml:"name"`
-	Description            string           `json:"description" yaml:"description"`
-	FunctionType           string           `json:"function_type" yaml:"function_type"`
-	FunctionParameters     FunctionParams   `json:"function_parameters" yaml:"function_parameters"`
-	Creator                sdk.AccAddress   `json:"creator" yaml:"creator"`
-	ReserveTokens          []string         `json:"reserve_tokens" yaml:"reserve_tokens"`
-	TxFeePercentage        sdk.Dec          `json:"tx_fee_percentage" yaml:"tx_fee_percentage"`
-	ExitFeePercentage      sdk.Dec          `json:"exit_fee_percentage" yaml:"exit_fee_percentage"`
-	FeeAddress             sdk.AccAddress   `json:"fee_address" yaml:"fee_address"`
-	MaxSupply              sdk.Coin         `json:"max_supply" yaml:"max_supply"`
-	OrderQuantityLimits    sdk.Coins        `json:"order_quantity_limits" yaml:"order_quantity_limits"`
-	SanityRate             sdk.Dec          `json:"sanity_rate" yaml:"sanity_rate"`
-	SanityMarginPercentage sdk.Dec          `json:"sanity_margin_percentage" yaml:"sanity_margin_percentage"`
-	AllowSells             string           `json:"allow_sells" yaml:"allow_sells"`
-	Signers                []sdk.AccAddress `json:"signers" yaml:"signers"`
-	BatchBlocks            sdk.Uint         `json:"batch_blocks" yaml:"batch_blocks"`
+	Token                   string           `json:"token" yaml:"token"`
+	Name                    string           `json:"name" yaml:"name"`
+	Description             string           `json:"description" yaml:"description"`
+	FunctionType            string           `json:"function_type" yaml:"function_type"`
+	FunctionParameters      FunctionParams   `json:"function_parameters" yaml:"function_parameters"`
+	Creator                 sdk.AccAddress   `json:"creator" yaml:"creator"`
+	ReserveTokens           []string         `json:"reserve_tokens" yaml:"reserve_tokens"`
+	TxFeePercentage         sdk.Dec          `json:"tx_fee_percentage" yaml:"tx_fee_percentage"`
+	ExitFeePercentage       sdk.Dec          `json:"exit_fee_percentage" yaml:"exit_fee_percentage"`
+	FeeAddress              sdk.AccAddress   `json:"fee_address" yaml:"fee_address"`
+	FeeRecipients           FeeRecipients    `json:"fee_recipients" yaml:"fee_recipients"`
+	HolderRewardsPercentage sdk.Dec          `json:"holder_rewards_percentage" yaml:"holder_rewards_percentage"`
+	LiquidityFeePercentage  sdk.Dec          `json:"liquidity_fee_percentage" yaml:"liquidity_fee_percentage"`
+	ReferralFeePercentage   sdk.Dec          `json:"referral_fee_percentage" yaml:"referral_fee_percentage"`
+	MinExitFeePercentage    sdk.Dec          `json:"min_exit_fee_percentage" yaml:"min_exit_fee_percentage"`
+	ExitFeeHoldingPeriod    sdk.Uint         `json:"exit_fee_holding_period" yaml:"exit_fee_holding_period"`
+	MaxSupply               sdk.Coin         `json:"max_supply" yaml:"max_supply"`
+	OrderQuantityLimits     sdk.Coins        `json:"order_quantity_limits" yaml:"order_quantity_limits"`
+	SanityRate              sdk.Dec          `json:"sanity_rate" yaml:"sanity_rate"`
+	SanityMarginPercentage  sdk.Dec          `json:"sanity_margin_percentage" yaml:"sanity_margin_percentage"`
+	AllowSells              string           `json:"allow_sells" yaml:"allow_sells"`
+	Signers                 []sdk.AccAddress `json:"signers" yaml:"signers"`
+	BatchBlocks             sdk.Uint         `json:"batch_blocks" yaml:"batch_blocks"`
 }
 
 func NewMsgCreateBond(token, name, description string, creator sdk.AccAddress,
 	functionType string, functionParameters FunctionParams, reserveTokens []string,
-	txFeePercentage, exitFeePercentage sdk.Dec, feeAddress sdk.AccAddress, maxSupply sdk.Coin,
-	orderQuantityLimits sdk.Coins, sanityRate, sanityMarginPercentage sdk.Dec,
+	txFeePercentage, exitFeePercentage sdk.Dec, feeAddress sdk.AccAddress,
+	feeRecipients FeeRecipients, holderRewardsPercentage, liquidityFeePercentage,
+	referralFeePercentage, minExitFeePercentage sdk.Dec, exitFeeHoldingPeriod sdk.Uint, maxSupply sdk.Coin, orderQuantityLimits sdk.Coins, sanityRate, sanityMarginPercentage sdk.Dec,
 	allowSell string, signers []sdk.AccAddress, batchBlocks sdk.Uint) MsgCreateBond {
 	return MsgCreateBond{
-		Token:                  token,
-		Name:                   name,
-		Description:            description,
-		Creator:                creator,
-		FunctionType:           functionType,
-		FunctionParameters:     functionParameters,
-		ReserveTokens:          reserveTokens,
-		TxFeePercentage:        txFeePercentage,
-		ExitFeePercentage:      exitFeePercentage,
-		FeeAddress:             feeAddress,
-		MaxSupply:              maxSupply,
-		OrderQuantityLimits:    orderQuantityLimits,
-		SanityRate:             sanityRate,
-		SanityMarginPercentage: sanityMarginPercentage,
-		AllowSells:             strings.ToLower(allowSell),
-		Signers:                signers,
-		BatchBlocks:            batchBlocks,
+		Token:                   token,
+		Name:                    name,
+		Description:             description,
+		Creator:                 creator,
+		FunctionType:            functionType,
+		FunctionParameters:      functionParameters,
+		ReserveTokens:           reserveTokens,
+		TxFeePercentage:         txFeePercentage,
+		ExitFeePercentage:       exitFeePercentage,
+		FeeAddress:              feeAddress,
+		FeeRecipients:           feeRecipients,
+		HolderRewardsPercentage: holderRewardsPercentage,
+		LiquidityFeePercentage:  liquidityFeePercentage,
+		ReferralFeePercentage:   referralFeePercentage,
+		MinExitFeePercentage:    minExitFeePercentage,
+		ExitFeeHoldingPeriod:    exitFeeHoldingPeriod,
+		MaxSupply:               maxSupply,
+		OrderQuantityLimits:     orderQuantityLimits,
+		SanityRate:              sanityRate,
+		SanityMarginPercentage:  sanityMarginPercentage,
+		AllowSells:              strings.ToLower(allowSell),
+		Signers:                 signers,
+		BatchBlocks:             batchBlocks,
 	}
 }
 
@@ -98,6 +111,53 @@ func (msg MsgCreateBond) ValidateBasic() sdk.Error {
 		}
 	}
 
+	// Check holder rewards percentage (if any; otherwise holder rewards disabled)
+	if !msg.HolderRewardsPercentage.IsNil() {
+		if msg.HolderRewardsPercentage.IsNegative() {
+			return ErrArgumentCannotBeNegative(DefaultCodespace, "HolderRewardsPercentage")
+		} else if msg.HolderRewardsPercentage.GT(sdk.NewDec(100)) {
+			return ErrFeeExceedsMaxFee(DefaultCodespace, "HolderRewardsPercentage", sdk.NewDec(100))
+		}
+	}
+
+	// Check liquidity fee percentage (if any; otherwise swap fees not kept in reserve)
+	if !msg.LiquidityFeePercentage.IsNil() {
+		if msg.LiquidityFeePercentage.IsNegative() {
+			return ErrArgumentCannotBeNegative(DefaultCodespace, "LiquidityFeePercentage")
+		} else if msg.LiquidityFeePercentage.GT(sdk.NewDec(100)) {
+			return ErrFeeExceedsMaxFee(DefaultCodespace, "LiquidityFeePercentage", sdk.NewDec(100))
+		} else if msg.LiquidityFeePercentage.IsPositive() && msg.FunctionType != SwapperFunction {
+			return ErrFunctionNotAvailableForFunctionType(DefaultCodespace)
+		}
+	}
+
+	// Check referral fee percentage (if any; otherwise referrers not paid)
+	if !msg.ReferralFeePercentage.IsNil() {
+		if msg.ReferralFeePercentage.IsNegative() {
+			return ErrArgumentCannotBeNegative(DefaultCodespace, "ReferralFeePercentage")
+		} else if msg.ReferralFeePercentage.GT(sdk.NewDec(100)) {
+			return ErrFeeExceedsMaxFee(DefaultCodespace, "ReferralFeePercentage", sdk.NewDec(100))
+		}
+	}
+
+	// Check min exit fee percentage (if any; otherwise exit fee not reduced)
+	if !msg.MinExitFeePercentage.IsNil() {
+		if msg.MinExitFeePercentage.IsNegative() {
+			return ErrArgumentCannotBeNegative(DefaultCodespace, "MinExitFeePercentage")
+		} else if msg.MinExitFeePercentage.GT(msg.ExitFeePercentage) {
+			return ErrFeeExceedsMaxFee(DefaultCodespace, "MinExitFeePercentage", msg.ExitFeePercentage)
+		} else if msg.MinExitFeePercentage.LT(msg.ExitFeePercentage) && msg.ExitFeeHoldingPeriod.IsZero() {
+			return ErrArgumentMustBePositive(DefaultCodespace, "ExitFeeHoldingPeriod")
+		}
+	}
+
+	// Check fee recipients (if any; otherwise fee address receives all fees)
+	if len(msg.FeeRecipients) != 0 {
+		if err := msg.FeeRecipients.Validate(msg.FeeAddress); err != nil {
+			return err
+		}
+	}
+
 	// Note: uniqueness of reserve tokens checked when parsing
 
 	return nil
@@ -188,17 +248,726 @@ func (msg MsgEditBond) Route() string { return RouterKey }
 
 func (msg MsgEditBond) Type() string { return "edit_bond" }
 
+type MsgCloseBond struct {
+	Token   string           `json:"token" yaml:"token"`
+	Closer  sdk.AccAddress   `json:"closer" yaml:"closer"`
+	Signers []sdk.AccAddress `json:"signers" yaml:"signers"`
+}
+
+func NewMsgCloseBond(token string, closer sdk.AccAddress,
+	signers []sdk.AccAddress) MsgCloseBond {
+	return MsgCloseBond{
+		Token:   token,
+		Closer:  closer,
+		Signers: signers,
+	}
+}
+
+func (msg MsgCloseBond) ValidateBasic() sdk.Error {
+	// Check if empty
+	if strings.TrimSpace(msg.Token) == "" {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "Token")
+	} else if msg.Closer.Empty() {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "Closer")
+	} else if len(msg.Signers) == 0 {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "Signers")
+	}
+
+	return nil
+}
+
+func (msg MsgCloseBond) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgCloseBond) GetSigners() []sdk.AccAddress {
+	return msg.Signers
+}
+
+func (msg MsgCloseBond) Route() string { return RouterKey }
+
+func (msg MsgCloseBond) Type() string { return "close_bond" }
+
+type MsgSetBondPaused struct {
+	Token   string           `json:"token" yaml:"token"`
+	Paused  string           `json:"paused" yaml:"paused"`
+	Editor  sdk.AccAddress   `json:"editor" yaml:"editor"`
+	Signers []sdk.AccAddress `json:"signers" yaml:"signers"`
+}
+
+func NewMsgSetBondPaused(token, paused string, editor sdk.AccAddress,
+	signers []sdk.AccAddress) MsgSetBondPaused {
+	return MsgSetBondPaused{
+		Token:   token,
+		Paused:  strings.ToLower(paused),
+		Editor:  editor,
+		Signers: signers,
+	}
+}
+
+func (msg MsgSetBondPaused) ValidateBasic() sdk.Error {
+	// Check if empty
+	if strings.TrimSpace(msg.Token) == "" {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "Token")
+	} else if strings.TrimSpace(msg.Paused) == "" {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "Paused")
+	} else if msg.Editor.Empty() {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "Editor")
+	} else if len(msg.Signers) == 0 {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "Signers")
+	}
+
+	// Check that true or false
+	if msg.Paused != TRUE && msg.Paused != FALSE {
+		return ErrArgumentMissingOrNonBoolean(DefaultCodespace, "Paused")
+	}
+
+	return nil
+}
+
+func (msg MsgSetBondPaused) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgSetBondPaused) GetSigners() []sdk.AccAddress {
+	return msg.Signers
+}
+
+func (msg MsgSetBondPaused) Route() string { return RouterKey }
+
+func (msg MsgSetBondPaused) Type() string { return "set_bond_paused" }
+
+type MsgSetCircuitBreaker struct {
+	Token                  string           `json:"token" yaml:"token"`
+	MaxPriceMovePercentage sdk.Dec          `json:"max_price_move_percentage" yaml:"max_price_move_percentage"`
+	CircuitBreakerMode     string           `json:"circuit_breaker_mode" yaml:"circuit_breaker_mode"`
+	CircuitBreakerCooldown sdk.Uint         `json:"circuit_breaker_cooldown" yaml:"circuit_breaker_cooldown"`
+	Editor                 sdk.AccAddress   `json:"editor" yaml:"editor"`
+	Signers                []sdk.AccAddress `json:"signers" yaml:"signers"`
+}
+
+func NewMsgSetCircuitBreaker(token string, maxPriceMovePercentage sdk.Dec,
+	circuitBreakerMode string, circuitBreakerCooldown sdk.Uint,
+	editor sdk.AccAddress, signers []sdk.AccAddress) MsgSetCircuitBreaker {
+	return MsgSetCircuitBreaker{
+		Token:                  token,
+		MaxPriceMovePercentage: maxPriceMovePercentage,
+		CircuitBreakerMode:     strings.ToLower(circuitBreakerMode),
+		CircuitBreakerCooldown: circuitBreakerCooldown,
+		Editor:                 editor,
+		Signers:                signers,
+	}
+}
+
+func (msg MsgSetCircuitBreaker) ValidateBasic() sdk.Error {
+	// Check if empty
+	if strings.TrimSpace(msg.Token) == "" {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "Token")
+	} else if strings.TrimSpace(msg.CircuitBreakerMode) == "" {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "CircuitBreakerMode")
+	} else if msg.Editor.Empty() {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "Editor")
+	} else if len(msg.Signers) == 0 {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "Signers")
+	}
+
+	// Check that not negative (zero disables the circuit breaker)
+	if msg.MaxPriceMovePercentage.IsNegative() {
+		return ErrArgumentCannotBeNegative(DefaultCodespace, "MaxPriceMovePercentage")
+	}
+
+	// Check mode, and that halting is for a non-zero number of blocks
+	if msg.CircuitBreakerMode != CircuitBreakerCancel &&
+		msg.CircuitBreakerMode != CircuitBreakerHalt {
+		return ErrUnrecognizedCircuitBreakerMode(DefaultCodespace, msg.CircuitBreakerMode)
+	} else if msg.CircuitBreakerMode == CircuitBreakerHalt &&
+		msg.CircuitBreakerCooldown.IsZero() {
+		return ErrArgumentMustBePositive(DefaultCodespace, "CircuitBreakerCooldown")
+	}
+
+	return nil
+}
+
+func (msg MsgSetCircuitBreaker) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgSetCircuitBreaker) GetSigners() []sdk.AccAddress {
+	return msg.Signers
+}
+
+func (msg MsgSetCircuitBreaker) Route() string { return RouterKey }
+
+func (msg MsgSetCircuitBreaker) Type() string { return "set_circuit_breaker" }
+
+type MsgUpdateBondRole struct {
+	Token     string           `json:"token" yaml:"token"`
+	Role      string           `json:"role" yaml:"role"`
+	Addresses []sdk.AccAddress `json:"addresses" yaml:"addresses"`
+	Threshold sdk.Uint         `json:"threshold" yaml:"threshold"`
+	Editor    sdk.AccAddress   `json:"editor" yaml:"editor"`
+	Signers   []sdk.AccAddress `json:"signers" yaml:"signers"`
+}
+
+func NewMsgUpdateBondRole(token, role string, addresses []sdk.AccAddress,
+	threshold sdk.Uint, editor sdk.AccAddress,
+	signers []sdk.AccAddress) MsgUpdateBondRole {
+	return MsgUpdateBondRole{
+		Token:     token,
+		Role:      strings.ToLower(role),
+		Addresses: addresses,
+		Threshold: threshold,
+		Editor:    editor,
+		Signers:   signers,
+	}
+}
+
+func (msg MsgUpdateBondRole) ValidateBasic() sdk.Error {
+	// Check if empty
+	if strings.TrimSpace(msg.Token) == "" {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "Token")
+	} else if strings.TrimSpace(msg.Role) == "" {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "Role")
+	} else if msg.Editor.Empty() {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "Editor")
+	} else if len(msg.Signers) == 0 {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "Signers")
+	}
+
+	// Check role, addresses and threshold
+	role := NewBondRole(msg.Role, msg.Addresses, msg.Threshold)
+	if err := role.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (msg MsgUpdateBondRole) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgUpdateBondRole) GetSigners() []sdk.AccAddress {
+	return msg.Signers
+}
+
+func (msg MsgUpdateBondRole) Route() string { return RouterKey }
+
+func (msg MsgUpdateBondRole) Type() string { return "update_bond_role" }
+
+type MsgSetFeeRecipients struct {
+	Token         string           `json:"token" yaml:"token"`
+	FeeRecipients FeeRecipients    `json:"fee_recipients" yaml:"fee_recipients"`
+	FeeAddress    sdk.AccAddress   `json:"fee_address" yaml:"fee_address"`
+	Editor        sdk.AccAddress   `json:"editor" yaml:"editor"`
+	Signers       []sdk.AccAddress `json:"signers" yaml:"signers"`
+}
+
+func NewMsgSetFeeRecipients(token string, feeRecipients FeeRecipients,
+	feeAddress, editor sdk.AccAddress,
+	signers []sdk.AccAddress) MsgSetFeeRecipients {
+	return MsgSetFeeRecipients{
+		Token:         token,
+		FeeRecipients: feeRecipients,
+		FeeAddress:    feeAddress,
+		Editor:        editor,
+		Signers:       signers,
+	}
+}
+
+func (msg MsgSetFeeRecipients) ValidateBasic() sdk.Error {
+	// Check if empty
+	if strings.TrimSpace(msg.Token) == "" {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "Token")
+	} else if msg.FeeAddress.Empty() {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "Fee address")
+	} else if msg.Editor.Empty() {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "Editor")
+	} else if len(msg.Signers) == 0 {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "Signers")
+	}
+
+	// Check recipients, shares and fee address
+	if err := msg.FeeRecipients.Validate(msg.FeeAddress); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (msg MsgSetFeeRecipients) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgSetFeeRecipients) GetSigners() []sdk.AccAddress {
+	return msg.Signers
+}
+
+func (msg MsgSetFeeRecipients) Route() string { return RouterKey }
+
+func (msg MsgSetFeeRecipients) Type() string { return "set_fee_recipients" }
+
+type MsgSetFeeSchedule struct {
+	Token       string           `json:"token" yaml:"token"`
+	FeeSchedule FeeSchedule      `json:"fee_schedule" yaml:"fee_schedule"`
+	Editor      sdk.AccAddress   `json:"editor" yaml:"editor"`
+	Signers     []sdk.AccAddress `json:"signers" yaml:"signers"`
+}
+
+func NewMsgSetFeeSchedule(token string, feeSchedule FeeSchedule,
+	editor sdk.AccAddress, signers []sdk.AccAddress) MsgSetFeeSchedule {
+	return MsgSetFeeSchedule{
+		Token:       token,
+		FeeSchedule: feeSchedule,
+		Editor:      editor,
+		Signers:     signers,
+	}
+}
+
+func (msg MsgSetFeeSchedule) ValidateBasic() sdk.Error {
+	// Check if empty
+	if strings.TrimSpace(msg.Token) == "" {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "Token")
+	} else if msg.Editor.Empty() {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "Editor")
+	} else if len(msg.Signers) == 0 {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "Signers")
+	}
+
+	// Check tiers and volatility batches
+	if err := msg.FeeSchedule.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (msg MsgSetFeeSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgSetFeeSchedule) GetSigners() []sdk.AccAddress {
+	return msg.Signers
+}
+
+func (msg MsgSetFeeSchedule) Route() string { return RouterKey }
+
+func (msg MsgSetFeeSchedule) Type() string { return "set_fee_schedule" }
+
+type MsgClaimBondRewards struct {
+	Token   string         `json:"token" yaml:"token"`
+	Claimer sdk.AccAddress `json:"claimer" yaml:"claimer"`
+}
+
+func NewMsgClaimBondRewards(token string, claimer sdk.AccAddress) MsgClaimBondRewards {
+	return MsgClaimBondRewards{
+		Token:   token,
+		Claimer: claimer,
+	}
+}
+
+func (msg MsgClaimBondRewards) ValidateBasic() sdk.Error {
+	// Check if empty
+	if strings.TrimSpace(msg.Token) == "" {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "Token")
+	} else if msg.Claimer.Empty() {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "Claimer")
+	}
+
+	return nil
+}
+
+func (msg MsgClaimBondRewards) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgClaimBondRewards) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Claimer}
+}
+
+func (msg MsgClaimBondRewards) Route() string { return RouterKey }
+
+func (msg MsgClaimBondRewards) Type() string { return "claim_bond_rewards" }
+
+type MsgSetTap struct {
+	Token           string           `json:"token" yaml:"token"`
+	Beneficiary     sdk.AccAddress   `json:"beneficiary" yaml:"beneficiary"`
+	Rate            sdk.Int          `json:"rate" yaml:"rate"`
+	FloorPercentage sdk.Dec          `json:"floor_percentage" yaml:"floor_percentage"`
+	Editor          sdk.AccAddress   `json:"editor" yaml:"editor"`
+	Signers         []sdk.AccAddress `json:"signers" yaml:"signers"`
+}
+
+func NewMsgSetTap(token string, beneficiary sdk.AccAddress, rate sdk.Int,
+	floorPercentage sdk.Dec, editor sdk.AccAddress, signers []sdk.AccAddress) MsgSetTap {
+	return MsgSetTap{
+		Token:           token,
+		Beneficiary:     beneficiary,
+		Rate:            rate,
+		FloorPercentage: floorPercentage,
+		Editor:          editor,
+		Signers:         signers,
+	}
+}
+
+func (msg MsgSetTap) ValidateBasic() sdk.Error {
+	// Check if empty
+	if strings.TrimSpace(msg.Token) == "" {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "Token")
+	} else if msg.Beneficiary.Empty() {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "Beneficiary")
+	} else if msg.Editor.Empty() {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "Editor")
+	} else if len(msg.Signers) == 0 {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "Signers")
+	}
+
+	// Check that rate not negative and floor percentage between 0 and 100
+	if msg.Rate.IsNegative() {
+		return ErrArgumentCannotBeNegative(DefaultCodespace, "Rate")
+	} else if msg.FloorPercentage.IsNegative() || msg.FloorPercentage.GT(sdk.NewDec(100)) {
+		return ErrTapFloorPercentageOutOfRange(DefaultCodespace, msg.FloorPercentage)
+	}
+
+	return nil
+}
+
+func (msg MsgSetTap) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgSetTap) GetSigners() []sdk.AccAddress {
+	return msg.Signers
+}
+
+func (msg MsgSetTap) Route() string { return RouterKey }
+
+func (msg MsgSetTap) Type() string { return "set_tap" }
+
+type MsgWithdrawTap struct {
+	Token   string           `json:"token" yaml:"token"`
+	Editor  sdk.AccAddress   `json:"editor" yaml:"editor"`
+	Signers []sdk.AccAddress `json:"signers" yaml:"signers"`
+}
+
+func NewMsgWithdrawTap(token string, editor sdk.AccAddress,
+	signers []sdk.AccAddress) MsgWithdrawTap {
+	return MsgWithdrawTap{
+		Token:   token,
+		Editor:  editor,
+		Signers: signers,
+	}
+}
+
+func (msg MsgWithdrawTap) ValidateBasic() sdk.Error {
+	// Check if empty
+	if strings.TrimSpace(msg.Token) == "" {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "Token")
+	} else if msg.Editor.Empty() {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "Editor")
+	} else if len(msg.Signers) == 0 {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "Signers")
+	}
+
+	return nil
+}
+
+func (msg MsgWithdrawTap) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgWithdrawTap) GetSigners() []sdk.AccAddress {
+	return msg.Signers
+}
+
+func (msg MsgWithdrawTap) Route() string { return RouterKey }
+
+func (msg MsgWithdrawTap) Type() string { return "withdraw_tap" }
+
+type MsgVoteTap struct {
+	Token  string         `json:"token" yaml:"token"`
+	Option string         `json:"option" yaml:"option"`
+	Voter  sdk.AccAddress `json:"voter" yaml:"voter"`
+}
+
+func NewMsgVoteTap(token, option string, voter sdk.AccAddress) MsgVoteTap {
+	return MsgVoteTap{
+		Token:  token,
+		Option: strings.ToLower(option),
+		Voter:  voter,
+	}
+}
+
+func (msg MsgVoteTap) ValidateBasic() sdk.Error {
+	// Check if empty
+	if strings.TrimSpace(msg.Token) == "" {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "Token")
+	} else if strings.TrimSpace(msg.Option) == "" {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "Option")
+	} else if msg.Voter.Empty() {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "Voter")
+	}
+
+	// Check that option is recognized
+	if !IsValidTapVoteOption(msg.Option) {
+		return ErrUnrecognizedTapVoteOption(DefaultCodespace, msg.Option)
+	}
+
+	return nil
+}
+
+func (msg MsgVoteTap) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgVoteTap) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Voter}
+}
+
+func (msg MsgVoteTap) Route() string { return RouterKey }
+
+func (msg MsgVoteTap) Type() string { return "vote_tap" }
+
+type MsgRefund struct {
+	Holder sdk.AccAddress `json:"holder" yaml:"holder"`
+	Amount sdk.Coin       `json:"amount" yaml:"amount"`
+}
+
+func NewMsgRefund(holder sdk.AccAddress, amount sdk.Coin) MsgRefund {
+	return MsgRefund{
+		Holder: holder,
+		Amount: amount,
+	}
+}
+
+func (msg MsgRefund) ValidateBasic() sdk.Error {
+	// Check if empty
+	if msg.Holder.Empty() {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "Holder")
+	}
+
+	// Check that non zero
+	if msg.Amount.Amount.IsZero() {
+		return ErrArgumentMustBePositive(DefaultCodespace, "Amount")
+	}
+
+	return nil
+}
+
+func (msg MsgRefund) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgRefund) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Holder}
+}
+
+func (msg MsgRefund) Route() string { return RouterKey }
+
+func (msg MsgRefund) Type() string { return "refund" }
+
+type MsgSetReserveStaking struct {
+	Token            string           `json:"token" yaml:"token"`
+	CapPercentage    sdk.Dec          `json:"cap_percentage" yaml:"cap_percentage"`
+	RewardsToReserve string           `json:"rewards_to_reserve" yaml:"rewards_to_reserve"`
+	Editor           sdk.AccAddress   `json:"editor" yaml:"editor"`
+	Signers          []sdk.AccAddress `json:"signers" yaml:"signers"`
+}
+
+func NewMsgSetReserveStaking(token string, capPercentage sdk.Dec, rewardsToReserve string,
+	editor sdk.AccAddress, signers []sdk.AccAddress) MsgSetReserveStaking {
+	return MsgSetReserveStaking{
+		Token:            token,
+		CapPercentage:    capPercentage,
+		RewardsToReserve: rewardsToReserve,
+		Editor:           editor,
+		Signers:          signers,
+	}
+}
+
+func (msg MsgSetReserveStaking) ValidateBasic() sdk.Error {
+	// Check if empty
+	if strings.TrimSpace(msg.Token) == "" {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "Token")
+	} else if strings.TrimSpace(msg.RewardsToReserve) == "" {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "RewardsToReserve")
+	} else if msg.Editor.Empty() {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "Editor")
+	} else if len(msg.Signers) == 0 {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "Signers")
+	}
+
+	// Check that true or false
+	if msg.RewardsToReserve != TRUE && msg.RewardsToReserve != FALSE {
+		return ErrArgumentMissingOrNonBoolean(DefaultCodespace, "RewardsToReserve")
+	}
+
+	// Check that cap percentage between 0 and 100
+	if msg.CapPercentage.IsNil() || msg.CapPercentage.IsNegative() ||
+		msg.CapPercentage.GT(sdk.NewDec(100)) {
+		return ErrStakingCapPercentageOutOfRange(DefaultCodespace, msg.CapPercentage)
+	}
+
+	return nil
+}
+
+func (msg MsgSetReserveStaking) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgSetReserveStaking) GetSigners() []sdk.AccAddress {
+	return msg.Signers
+}
+
+func (msg MsgSetReserveStaking) Route() string { return RouterKey }
+
+func (msg MsgSetReserveStaking) Type() string { return "set_reserve_staking" }
+
+type MsgDelegateReserve struct {
+	Token     string           `json:"token" yaml:"token"`
+	Validator sdk.ValAddress   `json:"validator" yaml:"validator"`
+	Amount    sdk.Coin         `json:"amount" yaml:"amount"`
+	Editor    sdk.AccAddress   `json:"editor" yaml:"editor"`
+	Signers   []sdk.AccAddress `json:"signers" yaml:"signers"`
+}
+
+func NewMsgDelegateReserve(token string, validator sdk.ValAddress, amount sdk.Coin,
+	editor sdk.AccAddress, signers []sdk.AccAddress) MsgDelegateReserve {
+	return MsgDelegateReserve{
+		Token:     token,
+		Validator: validator,
+		Amount:    amount,
+		Editor:    editor,
+		Signers:   signers,
+	}
+}
+
+func (msg MsgDelegateReserve) ValidateBasic() sdk.Error {
+	return validateReserveDelegationMsg(msg.Token, msg.Validator, msg.Amount, msg.Editor, msg.Signers)
+}
+
+func (msg MsgDelegateReserve) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgDelegateReserve) GetSigners() []sdk.AccAddress {
+	return msg.Signers
+}
+
+func (msg MsgDelegateReserve) Route() string { return RouterKey }
+
+func (msg MsgDelegateReserve) Type() string { return "delegate_reserve" }
+
+type MsgUndelegateReserve struct {
+	Token     string           `json:"token" yaml:"token"`
+	Validator sdk.ValAddress   `json:"validator" yaml:"validator"`
+	Amount    sdk.Coin         `json:"amount" yaml:"amount"`
+	Editor    sdk.AccAddress   `json:"editor" yaml:"editor"`
+	Signers   []sdk.AccAddress `json:"signers" yaml:"signers"`
+}
+
+func NewMsgUndelegateReserve(token string, validator sdk.ValAddress, amount sdk.Coin,
+	editor sdk.AccAddress, signers []sdk.AccAddress) MsgUndelegateReserve {
+	return MsgUndelegateReserve{
+		Token:     token,
+		Validator: validator,
+		Amount:    amount,
+		Editor:    editor,
+		Signers:   signers,
+	}
+}
+
+func (msg MsgUndelegateReserve) ValidateBasic() sdk.Error {
+	return validateReserveDelegationMsg(msg.Token, msg.Validator, msg.Amount, msg.Editor, msg.Signers)
+}
+
+func (msg MsgUndelegateReserve) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgUndelegateReserve) GetSigners() []sdk.AccAddress {
+	return msg.Signers
+}
+
+func (msg MsgUndelegateReserve) Route() string { return RouterKey }
+
+func (msg MsgUndelegateReserve) Type() string { return "undelegate_reserve" }
+
+type MsgSweepRoundingSurplus struct {
+	Token   string           `json:"token" yaml:"token"`
+	Editor  sdk.AccAddress   `json:"editor" yaml:"editor"`
+	Signers []sdk.AccAddress `json:"signers" yaml:"signers"`
+}
+
+func NewMsgSweepRoundingSurplus(token string, editor sdk.AccAddress,
+	signers []sdk.AccAddress) MsgSweepRoundingSurplus {
+	return MsgSweepRoundingSurplus{
+		Token:   token,
+		Editor:  editor,
+		Signers: signers,
+	}
+}
+
+func (msg MsgSweepRoundingSurplus) ValidateBasic() sdk.Error {
+	// Check if empty
+	if strings.TrimSpace(msg.Token) == "" {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "Token")
+	} else if msg.Editor.Empty() {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "Editor")
+	} else if len(msg.Signers) == 0 {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "Signers")
+	}
+
+	return nil
+}
+
+func (msg MsgSweepRoundingSurplus) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgSweepRoundingSurplus) GetSigners() []sdk.AccAddress {
+	return msg.Signers
+}
+
+func (msg MsgSweepRoundingSurplus) Route() string { return RouterKey }
+
+func (msg MsgSweepRoundingSurplus) Type() string { return "sweep_rounding_surplus" }
+
+func validateReserveDelegationMsg(token string, validator sdk.ValAddress,
+	amount sdk.Coin, editor sdk.AccAddress, signers []sdk.AccAddress) sdk.Error {
+	// Check if empty
+	if strings.TrimSpace(token) == "" {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "Token")
+	} else if validator.Empty() {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "Validator")
+	} else if editor.Empty() {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "Editor")
+	} else if len(signers) == 0 {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "Signers")
+	}
+
+	// Check that non zero
+	if amount.Amount.IsZero() {
+		return ErrArgumentMustBePositive(DefaultCodespace, "Amount")
+	}
+
+	return nil
+}
+
 type MsgBuy struct {
 	Buyer     sdk.AccAddress `json:"buyer" yaml:"buyer"`
 	Amount    sdk.Coin       `json:"amount" yaml:"amount"`
 	MaxPrices sdk.Coins      `json:"max_prices" yaml:"max_prices"`
+	Referrer  sdk.AccAddress `json:"referrer" yaml:"referrer"`
 }
 
-func NewMsgBuy(buyer sdk.AccAddress, amount sdk.Coin, maxPrices sdk.Coins) MsgBuy {
+func NewMsgBuy(buyer sdk.AccAddress, amount sdk.Coin, maxPrices sdk.Coins,
+	referrer sdk.AccAddress) MsgBuy {
 	return MsgBuy{
 		Buyer:     buyer,
 		Amount:    amount,
 		MaxPrices: maxPrices,
+		Referrer:  referrer,
 	}
 }
 
@@ -207,6 +976,12 @@ func (msg MsgBuy) ValidateBasic() sdk.Error {
 	if msg.Buyer.Empty() {
 		return ErrArgumentCannotBeEmpty(DefaultCodespace, "Buyer")
 	}
+	// Note: Referrer can be empty
+
+	// Check that buyer is not referring themselves
+	if msg.Referrer.Equals(msg.Buyer) {
+		return ErrReferrerIsBuyer(DefaultCodespace)
+	}
 
 	// Check that non zero
 	if msg.Amount.Amount.IsZero() {
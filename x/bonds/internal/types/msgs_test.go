@@ -130,6 +130,137 @@ func TestValidateBasicMsgCreateExitFeeIsZeroGivesNoError(t *testing.T) {
 	require.Nil(t, err)
 }
 
+func TestValidateBasicMsgCreateHolderRewardsIsNegativeGivesError(t *testing.T) {
+	message := NewValidMsgCreateBond()
+	message.HolderRewardsPercentage = sdk.NewDec(-1)
+
+	err := message.ValidateBasic()
+
+	require.NotNil(t, err)
+	require.Equal(t, CodeArgumentInvalid, err.Code())
+}
+
+func TestValidateBasicMsgCreateHolderRewardsAbove100GivesError(t *testing.T) {
+	message := NewValidMsgCreateBond()
+	message.HolderRewardsPercentage = sdk.MustNewDecFromStr("100.01")
+
+	err := message.ValidateBasic()
+
+	require.NotNil(t, err)
+	require.Equal(t, CodeFeeTooLarge, err.Code())
+}
+
+func TestValidateBasicMsgCreateHolderRewardsIs100GivesNoError(t *testing.T) {
+	message := NewValidMsgCreateBond()
+	message.HolderRewardsPercentage = sdk.NewDec(100)
+
+	err := message.ValidateBasic()
+
+	require.Nil(t, err)
+}
+
+func TestValidateBasicMsgCreateReferralFeeIsNegativeGivesError(t *testing.T) {
+	message := NewValidMsgCreateBond()
+	message.ReferralFeePercentage = sdk.NewDec(-1)
+
+	err := message.ValidateBasic()
+
+	require.NotNil(t, err)
+	require.Equal(t, CodeArgumentInvalid, err.Code())
+}
+
+func TestValidateBasicMsgCreateReferralFeeAbove100GivesError(t *testing.T) {
+	message := NewValidMsgCreateBond()
+	message.ReferralFeePercentage = sdk.MustNewDecFromStr("100.01")
+
+	err := message.ValidateBasic()
+
+	require.NotNil(t, err)
+	require.Equal(t, CodeFeeTooLarge, err.Code())
+}
+
+func TestValidateBasicMsgCreateLiquidityFeeIsNegativeGivesError(t *testing.T) {
+	message := NewValidMsgCreateSwapperBond()
+	message.LiquidityFeePercentage = sdk.NewDec(-1)
+
+	err := message.ValidateBasic()
+
+	require.NotNil(t, err)
+	require.Equal(t, CodeArgumentInvalid, err.Code())
+}
+
+func TestValidateBasicMsgCreateLiquidityFeeAbove100GivesError(t *testing.T) {
+	message := NewValidMsgCreateSwapperBond()
+	message.LiquidityFeePercentage = sdk.MustNewDecFromStr("100.01")
+
+	err := message.ValidateBasic()
+
+	require.NotNil(t, err)
+	require.Equal(t, CodeFeeTooLarge, err.Code())
+}
+
+func TestValidateBasicMsgCreateLiquidityFeeForNonSwapperGivesError(t *testing.T) {
+	message := NewValidMsgCreateBond()
+	message.LiquidityFeePercentage = sdk.NewDec(50)
+
+	err := message.ValidateBasic()
+
+	require.NotNil(t, err)
+	require.Equal(t, CodeFunctionNotAvailableForFunctionType, err.Code())
+}
+
+func TestValidateBasicMsgCreateLiquidityFeeForSwapperGivesNoError(t *testing.T) {
+	message := NewValidMsgCreateSwapperBond()
+	message.LiquidityFeePercentage = sdk.NewDec(50)
+
+	err := message.ValidateBasic()
+
+	require.Nil(t, err)
+}
+
+func TestValidateBasicMsgCreateMinExitFeeIsNegativeGivesError(t *testing.T) {
+	message := NewValidMsgCreateBond()
+	message.MinExitFeePercentage = sdk.NewDec(-1)
+	message.ExitFeeHoldingPeriod = sdk.NewUint(100)
+
+	err := message.ValidateBasic()
+
+	require.NotNil(t, err)
+	require.Equal(t, CodeArgumentInvalid, err.Code())
+}
+
+func TestValidateBasicMsgCreateMinExitFeeAboveExitFeeGivesError(t *testing.T) {
+	message := NewValidMsgCreateBond()
+	message.MinExitFeePercentage = message.ExitFeePercentage.Add(sdk.OneDec())
+	message.ExitFeeHoldingPeriod = sdk.NewUint(100)
+
+	err := message.ValidateBasic()
+
+	require.NotNil(t, err)
+	require.Equal(t, CodeFeeTooLarge, err.Code())
+}
+
+func TestValidateBasicMsgCreateMinExitFeeWithoutHoldingPeriodGivesError(t *testing.T) {
+	message := NewValidMsgCreateBond()
+	message.MinExitFeePercentage = sdk.ZeroDec()
+	message.ExitFeeHoldingPeriod = sdk.ZeroUint()
+
+	err := message.ValidateBasic()
+
+	require.NotNil(t, err)
+	require.Equal(t, CodeArgumentInvalid, err.Code())
+}
+
+func TestValidateBasicMsgCreateMinExitFeeWithHoldingPeriodGivesNoError(t *testing.T) {
+	message := NewValidMsgCreateBond()
+	message.MinExitFeePercentage = sdk.ZeroDec()
+	message.ExitFeeHoldingPeriod = sdk.NewUint(100)
+
+	err := message.ValidateBasic()
+
+	require.Nil(t, err)
+}
+
 func TestValidateBasicMsgCreateBondCorrectlyGivesNoError(t *testing.T) {
 	message := NewValidMsgCreateBond()
 
@@ -216,6 +347,413 @@ func TestValidateBasicMsgEditBondCorrectlyGivesNoError(t *testing.T) {
 	require.Nil(t, err)
 }
 
+func TestValidateBasicMsgCloseBondTokenArgumentMissingGivesError(t *testing.T) {
+	message := NewValidMsgCloseBond()
+	message.Token = ""
+
+	err := message.ValidateBasic()
+
+	require.NotNil(t, err)
+	require.Equal(t, CodeArgumentInvalid, err.Code())
+}
+
+func TestValidateBasicMsgCloseBondSignersArgumentMissingGivesError(t *testing.T) {
+	message := NewValidMsgCloseBond()
+	message.Signers = nil
+
+	err := message.ValidateBasic()
+
+	require.NotNil(t, err)
+	require.Equal(t, CodeArgumentInvalid, err.Code())
+}
+
+func TestValidateBasicMsgCloseBondCorrectlyGivesNoError(t *testing.T) {
+	message := NewValidMsgCloseBond()
+
+	err := message.ValidateBasic()
+
+	require.Nil(t, err)
+}
+
+func TestValidateBasicMsgSetBondPausedTokenArgumentMissingGivesError(t *testing.T) {
+	message := NewValidMsgSetBondPaused()
+	message.Token = ""
+
+	err := message.ValidateBasic()
+
+	require.NotNil(t, err)
+	require.Equal(t, CodeArgumentInvalid, err.Code())
+}
+
+func TestValidateBasicMsgSetBondPausedNonBooleanGivesError(t *testing.T) {
+	message := NewValidMsgSetBondPaused()
+	message.Paused = "yes"
+
+	err := message.ValidateBasic()
+
+	require.NotNil(t, err)
+	require.Equal(t, CodeArgumentMissingOrIncorrectType, err.Code())
+}
+
+func TestValidateBasicMsgSetBondPausedCorrectlyGivesNoError(t *testing.T) {
+	message := NewValidMsgSetBondPaused()
+
+	err := message.ValidateBasic()
+
+	require.Nil(t, err)
+}
+
+func TestValidateBasicMsgSetCircuitBreakerNegativeMaxPriceMoveGivesError(t *testing.T) {
+	message := NewValidMsgSetCircuitBreaker()
+	message.MaxPriceMovePercentage = sdk.NewDec(-1)
+
+	err := message.ValidateBasic()
+
+	require.NotNil(t, err)
+	require.Equal(t, CodeArgumentInvalid, err.Code())
+}
+
+func TestValidateBasicMsgSetCircuitBreakerInvalidModeGivesError(t *testing.T) {
+	message := NewValidMsgSetCircuitBreaker()
+	message.CircuitBreakerMode = "explode"
+
+	err := message.ValidateBasic()
+
+	require.NotNil(t, err)
+	require.Equal(t, CodeArgumentInvalid, err.Code())
+}
+
+func TestValidateBasicMsgSetCircuitBreakerHaltWithZeroCooldownGivesError(t *testing.T) {
+	message := NewValidMsgSetCircuitBreaker()
+	message.CircuitBreakerCooldown = sdk.ZeroUint()
+
+	err := message.ValidateBasic()
+
+	require.NotNil(t, err)
+	require.Equal(t, CodeArgumentInvalid, err.Code())
+}
+
+func TestValidateBasicMsgSetCircuitBreakerCorrectlyGivesNoError(t *testing.T) {
+	message := NewValidMsgSetCircuitBreaker()
+
+	err := message.ValidateBasic()
+
+	require.Nil(t, err)
+}
+
+func TestValidateBasicMsgUpdateBondRoleUnrecognizedRoleGivesError(t *testing.T) {
+	message := NewValidMsgUpdateBondRole()
+	message.Role = "superuser"
+
+	err := message.ValidateBasic()
+
+	require.NotNil(t, err)
+	require.Equal(t, CodeInvalidRole, err.Code())
+}
+
+func TestValidateBasicMsgUpdateBondRoleAddressesMissingGivesError(t *testing.T) {
+	message := NewValidMsgUpdateBondRole()
+	message.Addresses = nil
+
+	err := message.ValidateBasic()
+
+	require.NotNil(t, err)
+	require.Equal(t, CodeArgumentInvalid, err.Code())
+}
+
+func TestValidateBasicMsgUpdateBondRoleDuplicateAddressGivesError(t *testing.T) {
+	message := NewValidMsgUpdateBondRole()
+	message.Addresses = []sdk.AccAddress{initCreator, initCreator}
+
+	err := message.ValidateBasic()
+
+	require.NotNil(t, err)
+	require.Equal(t, CodeInvalidRole, err.Code())
+}
+
+func TestValidateBasicMsgUpdateBondRoleZeroThresholdGivesError(t *testing.T) {
+	message := NewValidMsgUpdateBondRole()
+	message.Threshold = sdk.ZeroUint()
+
+	err := message.ValidateBasic()
+
+	require.NotNil(t, err)
+	require.Equal(t, CodeInvalidRole, err.Code())
+}
+
+func TestValidateBasicMsgUpdateBondRoleThresholdAboveAddressesGivesError(t *testing.T) {
+	message := NewValidMsgUpdateBondRole()
+	message.Threshold = sdk.NewUint(3)
+
+	err := message.ValidateBasic()
+
+	require.NotNil(t, err)
+	require.Equal(t, CodeInvalidRole, err.Code())
+}
+
+func TestValidateBasicMsgUpdateBondRoleCorrectlyGivesNoError(t *testing.T) {
+	message := NewValidMsgUpdateBondRole()
+
+	err := message.ValidateBasic()
+
+	require.Nil(t, err)
+}
+
+func TestValidateBasicMsgCreateInvalidFeeRecipientsGivesError(t *testing.T) {
+	message := NewValidMsgCreateBond()
+	message.FeeRecipients = FeeRecipients{
+		NewFeeRecipient(initFeeAddress, sdk.NewDec(90)),
+	}
+
+	err := message.ValidateBasic()
+
+	require.NotNil(t, err)
+	require.Equal(t, CodeInvalidFeeRecipients, err.Code())
+}
+
+func TestValidateBasicMsgSetFeeRecipientsFeeAddressMissingGivesError(t *testing.T) {
+	message := NewValidMsgSetFeeRecipients()
+	message.FeeAddress = sdk.AccAddress{}
+
+	err := message.ValidateBasic()
+
+	require.NotNil(t, err)
+	require.Equal(t, CodeArgumentInvalid, err.Code())
+}
+
+func TestValidateBasicMsgSetFeeRecipientsFeeAddressNotARecipientGivesError(t *testing.T) {
+	message := NewValidMsgSetFeeRecipients()
+	message.FeeAddress = initReserveAddress
+
+	err := message.ValidateBasic()
+
+	require.NotNil(t, err)
+	require.Equal(t, CodeInvalidFeeRecipients, err.Code())
+}
+
+func TestValidateBasicMsgSetFeeRecipientsSharesNotSummingTo100GivesError(t *testing.T) {
+	message := NewValidMsgSetFeeRecipients()
+	message.FeeRecipients[0].Share = sdk.NewDec(50)
+
+	err := message.ValidateBasic()
+
+	require.NotNil(t, err)
+	require.Equal(t, CodeInvalidFeeRecipients, err.Code())
+}
+
+func TestValidateBasicMsgSetFeeRecipientsCorrectlyGivesNoError(t *testing.T) {
+	message := NewValidMsgSetFeeRecipients()
+
+	err := message.ValidateBasic()
+
+	require.Nil(t, err)
+}
+
+func TestValidateBasicMsgSetFeeScheduleTokenArgumentMissingGivesError(t *testing.T) {
+	message := NewValidMsgSetFeeSchedule()
+	message.Token = ""
+
+	err := message.ValidateBasic()
+
+	require.NotNil(t, err)
+	require.Equal(t, CodeArgumentInvalid, err.Code())
+}
+
+func TestValidateBasicMsgSetFeeScheduleUnorderedTiersGivesError(t *testing.T) {
+	message := NewValidMsgSetFeeSchedule()
+	message.FeeSchedule.SizeTiers = append(message.FeeSchedule.SizeTiers,
+		NewFeeTier(sdk.NewDec(100), sdk.MustNewDecFromStr("0.75")))
+
+	err := message.ValidateBasic()
+
+	require.NotNil(t, err)
+	require.Equal(t, CodeInvalidFeeSchedule, err.Code())
+}
+
+func TestValidateBasicMsgSetFeeScheduleVolatilityBatchesOutOfRangeGivesError(t *testing.T) {
+	message := NewValidMsgSetFeeSchedule()
+	message.FeeSchedule.VolatilityBatches = sdk.OneUint()
+
+	err := message.ValidateBasic()
+
+	require.NotNil(t, err)
+	require.Equal(t, CodeInvalidFeeSchedule, err.Code())
+}
+
+func TestValidateBasicMsgSetFeeScheduleCorrectlyGivesNoError(t *testing.T) {
+	message := NewValidMsgSetFeeSchedule()
+
+	err := message.ValidateBasic()
+
+	require.Nil(t, err)
+}
+
+func TestValidateBasicMsgClaimBondRewardsTokenArgumentMissingGivesError(t *testing.T) {
+	message := NewValidMsgClaimBondRewards()
+	message.Token = ""
+
+	err := message.ValidateBasic()
+
+	require.NotNil(t, err)
+	require.Equal(t, CodeArgumentInvalid, err.Code())
+}
+
+func TestValidateBasicMsgClaimBondRewardsClaimerArgumentMissingGivesError(t *testing.T) {
+	message := NewValidMsgClaimBondRewards()
+	message.Claimer = sdk.AccAddress{}
+
+	err := message.ValidateBasic()
+
+	require.NotNil(t, err)
+	require.Equal(t, CodeArgumentInvalid, err.Code())
+}
+
+func TestValidateBasicMsgClaimBondRewardsCorrectlyGivesNoError(t *testing.T) {
+	message := NewValidMsgClaimBondRewards()
+
+	err := message.ValidateBasic()
+
+	require.Nil(t, err)
+}
+
+func TestValidateBasicMsgSetTapBeneficiaryArgumentMissingGivesError(t *testing.T) {
+	message := NewValidMsgSetTap()
+	message.Beneficiary = sdk.AccAddress{}
+
+	err := message.ValidateBasic()
+
+	require.NotNil(t, err)
+	require.Equal(t, CodeArgumentInvalid, err.Code())
+}
+
+func TestValidateBasicMsgSetTapNegativeRateGivesError(t *testing.T) {
+	message := NewValidMsgSetTap()
+	message.Rate = sdk.NewInt(-1)
+
+	err := message.ValidateBasic()
+
+	require.NotNil(t, err)
+	require.Equal(t, CodeArgumentInvalid, err.Code())
+}
+
+func TestValidateBasicMsgSetTapFloorPercentageOutOfRangeGivesError(t *testing.T) {
+	message := NewValidMsgSetTap()
+	message.FloorPercentage = sdk.NewDec(101)
+
+	err := message.ValidateBasic()
+
+	require.NotNil(t, err)
+	require.Equal(t, CodeInvalidTap, err.Code())
+}
+
+func TestValidateBasicMsgSetTapCorrectlyGivesNoError(t *testing.T) {
+	message := NewValidMsgSetTap()
+
+	err := message.ValidateBasic()
+
+	require.Nil(t, err)
+}
+
+func TestValidateBasicMsgVoteTapUnrecognizedOptionGivesError(t *testing.T) {
+	message := NewValidMsgVoteTap()
+	message.Option = "lower"
+
+	err := message.ValidateBasic()
+
+	require.NotNil(t, err)
+	require.Equal(t, CodeArgumentInvalid, err.Code())
+}
+
+func TestValidateBasicMsgVoteTapCorrectlyGivesNoError(t *testing.T) {
+	message := NewValidMsgVoteTap()
+
+	err := message.ValidateBasic()
+
+	require.Nil(t, err)
+}
+
+func TestValidateBasicMsgSetReserveStakingNonBooleanRewardsToReserveGivesError(t *testing.T) {
+	message := NewValidMsgSetReserveStaking()
+	message.RewardsToReserve = "yes"
+
+	err := message.ValidateBasic()
+
+	require.NotNil(t, err)
+	require.Equal(t, CodeArgumentMissingOrIncorrectType, err.Code())
+}
+
+func TestValidateBasicMsgSetReserveStakingCapPercentageOutOfRangeGivesError(t *testing.T) {
+	message := NewValidMsgSetReserveStaking()
+	message.CapPercentage = sdk.NewDec(101)
+
+	err := message.ValidateBasic()
+
+	require.NotNil(t, err)
+	require.Equal(t, CodeInvalidReserveStaking, err.Code())
+}
+
+func TestValidateBasicMsgSetReserveStakingCorrectlyGivesNoError(t *testing.T) {
+	message := NewValidMsgSetReserveStaking()
+
+	err := message.ValidateBasic()
+
+	require.Nil(t, err)
+}
+
+func TestValidateBasicMsgDelegateReserveValidatorArgumentMissingGivesError(t *testing.T) {
+	message := NewValidMsgDelegateReserve()
+	message.Validator = sdk.ValAddress{}
+
+	err := message.ValidateBasic()
+
+	require.NotNil(t, err)
+	require.Equal(t, CodeArgumentInvalid, err.Code())
+}
+
+func TestValidateBasicMsgDelegateReserveZeroAmountGivesError(t *testing.T) {
+	message := NewValidMsgDelegateReserve()
+	message.Amount = sdk.NewInt64Coin(reserveToken, 0)
+
+	err := message.ValidateBasic()
+
+	require.NotNil(t, err)
+	require.Equal(t, CodeArgumentInvalid, err.Code())
+}
+
+func TestValidateBasicMsgDelegateReserveCorrectlyGivesNoError(t *testing.T) {
+	message := NewValidMsgDelegateReserve()
+
+	err := message.ValidateBasic()
+
+	require.Nil(t, err)
+}
+
+func TestValidateBasicMsgUndelegateReserveCorrectlyGivesNoError(t *testing.T) {
+	message := NewValidMsgUndelegateReserve()
+
+	err := message.ValidateBasic()
+
+	require.Nil(t, err)
+}
+
+func TestValidateBasicMsgSweepRoundingSurplusTokenArgumentMissingGivesError(t *testing.T) {
+	message := NewValidMsgSweepRoundingSurplus()
+	message.Token = ""
+
+	err := message.ValidateBasic()
+
+	require.NotNil(t, err)
+	require.Equal(t, CodeArgumentInvalid, err.Code())
+}
+
+func TestValidateBasicMsgSweepRoundingSurplusCorrectlyGivesNoError(t *testing.T) {
+	message := NewValidMsgSweepRoundingSurplus()
+
+	err := message.ValidateBasic()
+
+	require.Nil(t, err)
+}
+
 func TestValidateBasicMsgBuyBondBuyerArgumentMissingGivesError(t *testing.T) {
 	message := NewValidMsgBuy()
 	message.Buyer = sdk.AccAddress{}
@@ -226,6 +764,16 @@ func TestValidateBasicMsgBuyBondBuyerArgumentMissingGivesError(t *testing.T) {
 	require.Equal(t, CodeArgumentInvalid, err.Code())
 }
 
+func TestValidateBasicMsgBuyBondBuyerIsReferrerGivesError(t *testing.T) {
+	message := NewValidMsgBuy()
+	message.Referrer = message.Buyer
+
+	err := message.ValidateBasic()
+
+	require.NotNil(t, err)
+	require.Equal(t, CodeInvalidReferrer, err.Code())
+}
+
 func TestValidateBasicMsgBuyBondCorrectlyGivesNoError(t *testing.T) {
 	message := NewValidMsgBuy()
 
@@ -0,0 +1,204 @@
+package types
+
+import (
+	"bytes"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"sort"
+)
+
+// BatchOrders holds a batch's orders submitted by a single address
+type BatchOrders struct {
+	Buys  []BuyOrder  `json:"buys" yaml:"buys"`
+	Sells []SellOrder `json:"sells" yaml:"sells"`
+	Swaps []SwapOrder `json:"swaps" yaml:"swaps"`
+}
+
+func (bo BatchOrders) NumberOfOrders() int {
+	return len(bo.Buys) + len(bo.Sells) + len(bo.Swaps)
+}
+
+// AddressOrders holds an address' orders in a bond's current (pending) and
+// last (settled) batch, including cancelled orders and their cancel reasons
+type AddressOrders struct {
+	Token   string      `json:"token" yaml:"token"`
+	Pending BatchOrders `json:"pending" yaml:"pending"`
+	Settled BatchOrders `json:"settled" yaml:"settled"`
+}
+
+// GetOrdersByAddress returns the batch's orders submitted by the address
+func (b Batch) GetOrdersByAddress(address sdk.AccAddress) (orders BatchOrders) {
+	for _, o := range b.Buys {
+		if o.Address.Equals(address) {
+			orders.Buys = append(orders.Buys, o)
+		}
+	}
+	for _, o := range b.Sells {
+		if o.Address.Equals(address) {
+			orders.Sells = append(orders.Sells, o)
+		}
+	}
+	for _, o := range b.Swaps {
+		if o.Address.Equals(address) {
+			orders.Swaps = append(orders.Swaps, o)
+		}
+	}
+	return orders
+}
+
+// GetOrderAddresses returns the (unique) addresses that submitted the
+// batch's orders, sorted by address
+func (b Batch) GetOrderAddresses() (addresses []sdk.AccAddress) {
+	seen := make(map[string]bool)
+	add := func(address sdk.AccAddress) {
+		if !seen[string(address)] {
+			seen[string(address)] = true
+			addresses = append(addresses, address)
+		}
+	}
+	for _, o := range b.Buys {
+		add(o.Address)
+	}
+	for _, o := range b.Sells {
+		add(o.Address)
+	}
+	for _, o := range b.Swaps {
+		add(o.Address)
+	}
+	sort.Slice(addresses, func(i, j int) bool {
+		return bytes.Compare(addresses[i], addresses[j]) < 0
+	})
+	return addresses
+}
+
+// BuyDepthLevel holds the pending buys of a batch with the same max prices
+// (per bond token, including fees). The cumulative amount is the amount of
+// this and all higher levels, i.e. the amount still bought if the buy prices
+// reach this level's max prices.
+type BuyDepthLevel struct {
+	MaxPrices        sdk.DecCoins `json:"max_prices" yaml:"max_prices"`
+	Amount           sdk.Int      `json:"amount" yaml:"amount"`
+	CumulativeAmount sdk.Int      `json:"cumulative_amount" yaml:"cumulative_amount"`
+	Orders           sdk.Uint     `json:"orders" yaml:"orders"`
+}
+
+// SwapDepth holds the totals of a batch's pending swaps from one reserve
+// token to another
+type SwapDepth struct {
+	FromToken string   `json:"from_token" yaml:"from_token"`
+	ToToken   string   `json:"to_token" yaml:"to_token"`
+	Amount    sdk.Int  `json:"amount" yaml:"amount"`
+	Orders    sdk.Uint `json:"orders" yaml:"orders"`
+}
+
+// OrderBook holds the aggregated depth of a batch's pending (i.e. not
+// cancelled) orders, with buys grouped into levels from the highest to the
+// lowest max prices
+type OrderBook struct {
+	Token           string          `json:"token" yaml:"token"`
+	BlocksRemaining sdk.Uint        `json:"blocks_remaining" yaml:"blocks_remaining"`
+	TotalBuyAmount  sdk.Coin        `json:"total_buy_amount" yaml:"total_buy_amount"`
+	TotalSellAmount sdk.Coin        `json:"total_sell_amount" yaml:"total_sell_amount"`
+	Buys            sdk.Uint        `json:"buys" yaml:"buys"`
+	Sells           sdk.Uint        `json:"sells" yaml:"sells"`
+	BuyDepth        []BuyDepthLevel `json:"buy_depth" yaml:"buy_depth"`
+	SwapDepth       []SwapDepth     `json:"swap_depth" yaml:"swap_depth"`
+}
+
+func NewOrderBook(batch Batch) OrderBook {
+	orderBook := OrderBook{
+		Token:           batch.Token,
+		BlocksRemaining: batch.BlocksRemaining,
+		TotalBuyAmount:  sdk.NewInt64Coin(batch.Token, 0),
+		TotalSellAmount: sdk.NewInt64Coin(batch.Token, 0),
+		Buys:            sdk.ZeroUint(),
+		Sells:           sdk.ZeroUint(),
+	}
+
+	for _, b := range batch.Buys {
+		if b.IsCancelled() {
+			continue
+		}
+		orderBook.TotalBuyAmount = orderBook.TotalBuyAmount.Add(b.Amount)
+		orderBook.Buys = orderBook.Buys.Add(sdk.OneUint())
+		maxPrices := DivideDecCoinsByDec(
+			sdk.NewDecCoins(b.MaxPrices), sdk.NewDecFromInt(b.Amount.Amount))
+		orderBook.addBuyDepth(maxPrices, b.Amount.Amount)
+	}
+	for _, s := range batch.Sells {
+		if !s.IsCancelled() {
+			orderBook.TotalSellAmount = orderBook.TotalSellAmount.Add(s.Amount)
+			orderBook.Sells = orderBook.Sells.Add(sdk.OneUint())
+		}
+	}
+	for _, s := range batch.Swaps {
+		if !s.IsCancelled() {
+			orderBook.addSwapDepth(s.Amount.Denom, s.ToToken, s.Amount.Amount)
+		}
+	}
+
+	// Buy levels are sorted from the highest to the lowest max prices, with
+	// the max prices compared in order of reserve token denom
+	sort.SliceStable(orderBook.BuyDepth, func(i, j int) bool {
+		return isGreaterDecCoins(orderBook.BuyDepth[i].MaxPrices,
+			orderBook.BuyDepth[j].MaxPrices)
+	})
+	cumulative := sdk.ZeroInt()
+	for i, level := range orderBook.BuyDepth {
+		cumulative = cumulative.Add(level.Amount)
+		orderBook.BuyDepth[i].CumulativeAmount = cumulative
+	}
+
+	// Swap directions are sorted by from and to token
+	sort.SliceStable(orderBook.SwapDepth, func(i, j int) bool {
+		a, b := orderBook.SwapDepth[i], orderBook.SwapDepth[j]
+		if a.FromToken != b.FromToken {
+			return a.FromToken < b.FromToken
+		}
+		return a.ToToken < b.ToToken
+	})
+
+	return orderBook
+}
+
+func (ob *OrderBook) addBuyDepth(maxPrices sdk.DecCoins, amount sdk.Int) {
+	for i, level := range ob.BuyDepth {
+		if level.MaxPrices.IsEqual(maxPrices) {
+			ob.BuyDepth[i].Amount = level.Amount.Add(amount)
+			ob.BuyDepth[i].Orders = level.Orders.Add(sdk.OneUint())
+			return
+		}
+	}
+	ob.BuyDepth = append(ob.BuyDepth, BuyDepthLevel{
+		MaxPrices:        maxPrices,
+		Amount:           amount,
+		CumulativeAmount: sdk.ZeroInt(),
+		Orders:           sdk.OneUint(),
+	})
+}
+
+func (ob *OrderBook) addSwapDepth(fromToken, toToken string, amount sdk.Int) {
+	for i, depth := range ob.SwapDepth {
+		if depth.FromToken == fromToken && depth.ToToken == toToken {
+			ob.SwapDepth[i].Amount = depth.Amount.Add(amount)
+			ob.SwapDepth[i].Orders = depth.Orders.Add(sdk.OneUint())
+			return
+		}
+	}
+	ob.SwapDepth = append(ob.SwapDepth, SwapDepth{
+		FromToken: fromToken,
+		ToToken:   toToken,
+		Amount:    amount,
+		Orders:    sdk.OneUint(),
+	})
+}
+
+func isGreaterDecCoins(a, b sdk.DecCoins) bool {
+	// Adding a and b gives (sorted) coins with all of the denoms in a and b
+	for _, coin := range a.Add(b) {
+		amountA, amountB := a.AmountOf(coin.Denom), b.AmountOf(coin.Denom)
+		if !amountA.Equal(amountB) {
+			return amountA.GT(amountB)
+		}
+	}
+	return false
+}
@@ -0,0 +1,82 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"testing"
+)
+
+func TestBatchGetOrdersByAddressAndAddresses(t *testing.T) {
+	other := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	buy := NewBuyOrder(initCreator, sdk.NewInt64Coin(token, 10), nil, nil)
+	sell := NewSellOrder(other, sdk.NewInt64Coin(token, 5), sdk.ZeroDec())
+	swap := NewSwapOrder(initCreator, sdk.NewInt64Coin(reserveToken, 5), reserveToken2)
+
+	batch := NewBatch(token, sdk.OneUint())
+	batch.Buys = []BuyOrder{buy}
+	batch.Sells = []SellOrder{sell}
+	batch.Swaps = []SwapOrder{swap}
+
+	require.Equal(t, BatchOrders{Buys: []BuyOrder{buy}, Swaps: []SwapOrder{swap}},
+		batch.GetOrdersByAddress(initCreator))
+	require.Equal(t, BatchOrders{Sells: []SellOrder{sell}},
+		batch.GetOrdersByAddress(other))
+
+	// Addresses are unique and sorted
+	addresses := batch.GetOrderAddresses()
+	require.Len(t, addresses, 2)
+	require.ElementsMatch(t, []sdk.AccAddress{initCreator, other}, addresses)
+	require.True(t, string(addresses[0]) < string(addresses[1]))
+}
+
+func TestNewOrderBook(t *testing.T) {
+	buy := func(amount, maxPrice int64) BuyOrder {
+		return NewBuyOrder(initCreator, sdk.NewInt64Coin(token, amount),
+			sdk.NewCoins(sdk.NewInt64Coin(reserveToken, maxPrice)), nil)
+	}
+	swap := func(from string, amount int64, to string) SwapOrder {
+		return NewSwapOrder(initCreator, sdk.NewInt64Coin(from, amount), to)
+	}
+
+	batch := NewBatch(token, sdk.NewUint(3))
+	batch.Buys = []BuyOrder{
+		buy(10, 1000), // 100 per token
+		buy(5, 1000),  // 200 per token
+		buy(20, 2000), // 100 per token
+		buy(50, 1),    // cancelled
+	}
+	batch.Buys[3].Cancelled = TRUE
+	batch.Sells = []SellOrder{
+		NewSellOrder(initCreator, sdk.NewInt64Coin(token, 7), sdk.ZeroDec()),
+	}
+	batch.Swaps = []SwapOrder{
+		swap(reserveToken2, 3, reserveToken),
+		swap(reserveToken, 4, reserveToken2),
+		swap(reserveToken2, 6, reserveToken),
+	}
+
+	orderBook := NewOrderBook(batch)
+	require.Equal(t, token, orderBook.Token)
+	require.Equal(t, sdk.NewUint(3), orderBook.BlocksRemaining)
+	require.Equal(t, sdk.NewInt64Coin(token, 35), orderBook.TotalBuyAmount)
+	require.Equal(t, sdk.NewInt64Coin(token, 7), orderBook.TotalSellAmount)
+	require.Equal(t, sdk.NewUint(3), orderBook.Buys)
+	require.Equal(t, sdk.OneUint(), orderBook.Sells)
+
+	// Buy levels sorted from the highest to the lowest max prices
+	require.Equal(t, []BuyDepthLevel{
+		{MaxPrices: decCoins(200), Amount: sdk.NewInt(5),
+			CumulativeAmount: sdk.NewInt(5), Orders: sdk.OneUint()},
+		{MaxPrices: decCoins(100), Amount: sdk.NewInt(30),
+			CumulativeAmount: sdk.NewInt(35), Orders: sdk.NewUint(2)},
+	}, orderBook.BuyDepth)
+
+	// Swap totals sorted by direction
+	require.Equal(t, []SwapDepth{
+		{FromToken: reserveToken, ToToken: reserveToken2,
+			Amount: sdk.NewInt(4), Orders: sdk.OneUint()},
+		{FromToken: reserveToken2, ToToken: reserveToken,
+			Amount: sdk.NewInt(9), Orders: sdk.NewUint(2)},
+	}, orderBook.SwapDepth)
+}
@@ -0,0 +1,318 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"strings"
+)
+
+// Parameter store keys
+var (
+	KeyMaxTxFeePercentage     = []byte("MaxTxFeePercentage")
+	KeyMaxExitFeePercentage   = []byte("MaxExitFeePercentage")
+	KeyMinBatchBlocks         = []byte("MinBatchBlocks")
+	KeyMaxBatchBlocks         = []byte("MaxBatchBlocks")
+	KeyBondCreationFee        = []byte("BondCreationFee")
+	KeyBondCreationDeposit    = []byte("BondCreationDeposit")
+	KeyReserveDenomDenylist   = []byte("ReserveDenomDenylist")
+	KeyBondDenomDenylist      = []byte("BondDenomDenylist")
+	KeyMaxOrdersPerBatch      = []byte("MaxOrdersPerBatch")
+	KeyCandleIntervalBlocks   = []byte("CandleIntervalBlocks")
+	KeyMaxCandles             = []byte("MaxCandles")
+	KeyPriceObservationBlocks = []byte("PriceObservationBlocks")
+)
+
+// bonds parameters
+type Params struct {
+	MaxTxFeePercentage     sdk.Dec   `json:"max_tx_fee_percentage" yaml:"max_tx_fee_percentage"`
+	MaxExitFeePercentage   sdk.Dec   `json:"max_exit_fee_percentage" yaml:"max_exit_fee_percentage"`
+	MinBatchBlocks         sdk.Uint  `json:"min_batch_blocks" yaml:"min_batch_blocks"`
+	MaxBatchBlocks         sdk.Uint  `json:"max_batch_blocks" yaml:"max_batch_blocks"`
+	BondCreationFee        sdk.Coins `json:"bond_creation_fee" yaml:"bond_creation_fee"`
+	BondCreationDeposit    sdk.Coins `json:"bond_creation_deposit" yaml:"bond_creation_deposit"`
+	ReserveDenomDenylist   []string  `json:"reserve_denom_denylist" yaml:"reserve_denom_denylist"`
+	BondDenomDenylist      []string  `json:"bond_denom_denylist" yaml:"bond_denom_denylist"`
+	MaxOrdersPerBatch      uint64    `json:"max_orders_per_batch" yaml:"max_orders_per_batch"`
+	CandleIntervalBlocks   sdk.Uint  `json:"candle_interval_blocks" yaml:"candle_interval_blocks"`
+	MaxCandles             uint64    `json:"max_candles" yaml:"max_candles"`
+	PriceObservationBlocks sdk.Uint  `json:"price_observation_blocks" yaml:"price_observation_blocks"`
+}
+
+// ParamKeyTable for bonds module.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(maxTxFeePercentage, maxExitFeePercentage sdk.Dec,
+	minBatchBlocks, maxBatchBlocks sdk.Uint, bondCreationFee, bondCreationDeposit sdk.Coins,
+	reserveDenomDenylist, bondDenomDenylist []string,
+	maxOrdersPerBatch uint64, candleIntervalBlocks sdk.Uint, maxCandles uint64,
+	priceObservationBlocks sdk.Uint) Params {
+
+	return Params{
+		MaxTxFeePercentage:     maxTxFeePercentage,
+		MaxExitFeePercentage:   maxExitFeePercentage,
+		MinBatchBlocks:         minBatchBlocks,
+		MaxBatchBlocks:         maxBatchBlocks,
+		BondCreationFee:        bondCreationFee,
+		BondCreationDeposit:    bondCreationDeposit,
+		ReserveDenomDenylist:   reserveDenomDenylist,
+		BondDenomDenylist:      bondDenomDenylist,
+		MaxOrdersPerBatch:      maxOrdersPerBatch,
+		CandleIntervalBlocks:   candleIntervalBlocks,
+		MaxCandles:             maxCandles,
+		PriceObservationBlocks: priceObservationBlocks,
+	}
+}
+
+// default bonds module parameters
+func DefaultParams() Params {
+	return Params{
+		MaxTxFeePercentage:     sdk.NewDec(50),
+		MaxExitFeePercentage:   sdk.NewDec(50),
+		MinBatchBlocks:         sdk.OneUint(),
+		MaxBatchBlocks:         sdk.NewUint(1000),
+		BondCreationFee:        sdk.Coins{},
+		BondCreationDeposit:    sdk.Coins{},
+		ReserveDenomDenylist:   []string{},
+		BondDenomDenylist:      []string{sdk.DefaultBondDenom},
+		MaxOrdersPerBatch:      1000,
+		CandleIntervalBlocks:   sdk.NewUint(100),
+		MaxCandles:             1000,
+		PriceObservationBlocks: sdk.NewUint(100000),
+	}
+}
+
+// validate params
+func (p Params) Validate() error {
+	if err := validateMaxFeePercentage(p.MaxTxFeePercentage); err != nil {
+		return err
+	}
+	if err := validateMaxFeePercentage(p.MaxExitFeePercentage); err != nil {
+		return err
+	}
+	if err := validateBatchBlocks(p.MinBatchBlocks); err != nil {
+		return err
+	}
+	if err := validateBatchBlocks(p.MaxBatchBlocks); err != nil {
+		return err
+	}
+	if err := validateBondCreationFee(p.BondCreationFee); err != nil {
+		return err
+	}
+	if err := validateBondCreationDeposit(p.BondCreationDeposit); err != nil {
+		return err
+	}
+	if err := validateDenomDenylist(p.ReserveDenomDenylist); err != nil {
+		return err
+	}
+	if err := validateDenomDenylist(p.BondDenomDenylist); err != nil {
+		return err
+	}
+	if err := validateMaxOrdersPerBatch(p.MaxOrdersPerBatch); err != nil {
+		return err
+	}
+	if err := validateCandleIntervalBlocks(p.CandleIntervalBlocks); err != nil {
+		return err
+	}
+	if err := validateMaxCandles(p.MaxCandles); err != nil {
+		return err
+	}
+	if err := validatePriceObservationBlocks(p.PriceObservationBlocks); err != nil {
+		return err
+	}
+	if p.MaxBatchBlocks.LT(p.MinBatchBlocks) {
+		return fmt.Errorf(
+			"max batch blocks (%s) must be greater than or equal to min batch blocks (%s)",
+			p.MaxBatchBlocks, p.MinBatchBlocks,
+		)
+	}
+
+	return nil
+}
+
+func (p Params) String() string {
+	return fmt.Sprintf(`Bonds Params:
+  Max Tx Fee Percentage:    %s
+  Max Exit Fee Percentage:  %s
+  Min Batch Blocks:         %s
+  Max Batch Blocks:         %s
+  Bond Creation Fee:        %s
+  Bond Creation Deposit:    %s
+  Reserve Denom Denylist:   %s
+  Bond Denom Denylist:      %s
+  Max Orders Per Batch:     %d
+  Candle Interval Blocks:   %s
+  Max Candles:              %d
+  Price Observation Blocks: %s
+`,
+		p.MaxTxFeePercentage, p.MaxExitFeePercentage, p.MinBatchBlocks,
+		p.MaxBatchBlocks, p.BondCreationFee, p.BondCreationDeposit,
+		StringsToString(p.ReserveDenomDenylist),
+		StringsToString(p.BondDenomDenylist), p.MaxOrdersPerBatch,
+		p.CandleIntervalBlocks, p.MaxCandles, p.PriceObservationBlocks,
+	)
+}
+
+// Implements params.ParamSet
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(KeyMaxTxFeePercentage, &p.MaxTxFeePercentage, validateMaxFeePercentage),
+		params.NewParamSetPair(KeyMaxExitFeePercentage, &p.MaxExitFeePercentage, validateMaxFeePercentage),
+		params.NewParamSetPair(KeyMinBatchBlocks, &p.MinBatchBlocks, validateBatchBlocks),
+		params.NewParamSetPair(KeyMaxBatchBlocks, &p.MaxBatchBlocks, validateBatchBlocks),
+		params.NewParamSetPair(KeyBondCreationFee, &p.BondCreationFee, validateBondCreationFee),
+		params.NewParamSetPair(KeyBondCreationDeposit, &p.BondCreationDeposit, validateBondCreationDeposit),
+		params.NewParamSetPair(KeyReserveDenomDenylist, &p.ReserveDenomDenylist, validateDenomDenylist),
+		params.NewParamSetPair(KeyBondDenomDenylist, &p.BondDenomDenylist, validateDenomDenylist),
+		params.NewParamSetPair(KeyMaxOrdersPerBatch, &p.MaxOrdersPerBatch, validateMaxOrdersPerBatch),
+		params.NewParamSetPair(KeyCandleIntervalBlocks, &p.CandleIntervalBlocks, validateCandleIntervalBlocks),
+		params.NewParamSetPair(KeyMaxCandles, &p.MaxCandles, validateMaxCandles),
+		params.NewParamSetPair(KeyPriceObservationBlocks, &p.PriceObservationBlocks, validatePriceObservationBlocks),
+	}
+}
+
+func (p Params) ReserveDenomIsDenied(denom string) bool {
+	for _, d := range p.ReserveDenomDenylist {
+		if d == denom {
+			return true
+		}
+	}
+	return false
+}
+
+func (p Params) BondDenomIsDenied(denom string) bool {
+	for _, d := range p.BondDenomDenylist {
+		if d == denom {
+			return true
+		}
+	}
+	return false
+}
+
+func validateMaxFeePercentage(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("max fee percentage cannot be negative: %s", v)
+	}
+	if v.GTE(sdk.NewDec(100)) {
+		return fmt.Errorf("max fee percentage must be less than 100: %s", v)
+	}
+
+	return nil
+}
+
+func validateBatchBlocks(i interface{}) error {
+	v, ok := i.(sdk.Uint)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsZero() {
+		return errors.New("batch blocks must be positive")
+	}
+
+	return nil
+}
+
+func validateBondCreationFee(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if !v.IsValid() {
+		return fmt.Errorf("invalid bond creation fee: %s", v)
+	}
+
+	return nil
+}
+
+func validateBondCreationDeposit(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if !v.IsValid() {
+		return fmt.Errorf("invalid bond creation deposit: %s", v)
+	}
+
+	return nil
+}
+
+func validateDenomDenylist(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for _, denom := range v {
+		if strings.TrimSpace(denom) == "" {
+			return errors.New("denylisted denom cannot be blank")
+		}
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateMaxOrdersPerBatch(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return errors.New("max orders per batch must be positive")
+	}
+
+	return nil
+}
+
+func validateCandleIntervalBlocks(i interface{}) error {
+	v, ok := i.(sdk.Uint)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsZero() {
+		return errors.New("candle interval blocks must be positive")
+	}
+
+	return nil
+}
+
+func validateMaxCandles(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return errors.New("max candles must be positive")
+	}
+
+	return nil
+}
+
+func validatePriceObservationBlocks(i interface{}) error {
+	v, ok := i.(sdk.Uint)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsZero() {
+		return errors.New("price observation blocks must be positive")
+	}
+
+	return nil
+}
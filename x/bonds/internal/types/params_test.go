@@ -0,0 +1,59 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestDefaultParamsAreValid(t *testing.T) {
+	require.Nil(t, DefaultParams().Validate())
+}
+
+func TestParamsValidate(t *testing.T) {
+	testCases := []struct {
+		modify  func(p *Params)
+		isValid bool
+	}{
+		{func(p *Params) {}, true},
+		{func(p *Params) { p.MaxTxFeePercentage = sdk.NewDec(-1) }, false},
+		{func(p *Params) { p.MaxTxFeePercentage = sdk.NewDec(100) }, false},
+		{func(p *Params) { p.MaxExitFeePercentage = sdk.NewDec(-1) }, false},
+		{func(p *Params) { p.MaxExitFeePercentage = sdk.NewDec(100) }, false},
+		{func(p *Params) { p.MinBatchBlocks = sdk.ZeroUint() }, false},
+		{func(p *Params) { p.MaxBatchBlocks = sdk.ZeroUint() }, false},
+		{func(p *Params) {
+			p.MinBatchBlocks = sdk.NewUint(10)
+			p.MaxBatchBlocks = sdk.NewUint(5)
+		}, false},
+		{func(p *Params) { p.BondCreationFee = sdk.Coins{sdk.Coin{Denom: "abc", Amount: sdk.NewInt(-1)}} }, false},
+		{func(p *Params) { p.BondCreationFee = sdk.NewCoins(sdk.NewInt64Coin("abc", 1)) }, true},
+		{func(p *Params) { p.ReserveDenomDenylist = []string{""} }, false},
+		{func(p *Params) { p.ReserveDenomDenylist = []string{"abc", "def"} }, true},
+		{func(p *Params) { p.BondDenomDenylist = []string{"A"} }, false},
+		{func(p *Params) { p.MaxOrdersPerBatch = 0 }, false},
+		{func(p *Params) { p.CandleIntervalBlocks = sdk.ZeroUint() }, false},
+		{func(p *Params) { p.MaxCandles = 0 }, false},
+		{func(p *Params) { p.PriceObservationBlocks = sdk.ZeroUint() }, false},
+	}
+	for _, tc := range testCases {
+		params := DefaultParams()
+		tc.modify(&params)
+		if tc.isValid {
+			require.Nil(t, params.Validate())
+		} else {
+			require.NotNil(t, params.Validate())
+		}
+	}
+}
+
+func TestParamsDenylists(t *testing.T) {
+	params := DefaultParams()
+	params.ReserveDenomDenylist = []string{"res"}
+	params.BondDenomDenylist = []string{"bond"}
+
+	require.True(t, params.ReserveDenomIsDenied("res"))
+	require.False(t, params.ReserveDenomIsDenied("bond"))
+	require.True(t, params.BondDenomIsDenied("bond"))
+	require.False(t, params.BondDenomIsDenied("res"))
+}
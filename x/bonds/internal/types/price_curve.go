@@ -0,0 +1,57 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxPriceCurveSamples is the max number of points that a price curve can be
+// sampled at in a single query
+const MaxPriceCurveSamples = 1000
+
+type PriceCurvePoint struct {
+	Supply   sdk.Int      `json:"supply" yaml:"supply"`
+	Prices   sdk.DecCoins `json:"prices" yaml:"prices"`
+	Integral sdk.DecCoins `json:"integral" yaml:"integral"`
+}
+
+// GetPriceCurveSupplies returns the given number of evenly spaced supplies
+// from the start to the end of the range (inclusive), with any duplicates due
+// to the range being smaller than the number of samples left out
+func GetPriceCurveSupplies(from, to sdk.Int, samples int) (supplies []sdk.Int) {
+	if samples == 1 || from.Equal(to) {
+		return []sdk.Int{from}
+	}
+
+	span := to.Sub(from)
+	for i := 0; i < samples; i++ {
+		supply := from.Add(span.MulRaw(int64(i)).QuoRaw(int64(samples - 1)))
+		if len(supplies) == 0 || !supply.Equal(supplies[len(supplies)-1]) {
+			supplies = append(supplies, supply)
+		}
+	}
+	return supplies
+}
+
+func (bond Bond) GetPriceCurve(from, to sdk.Int, samples int) ([]PriceCurvePoint, sdk.Error) {
+	if bond.FunctionType == SwapperFunction {
+		return nil, ErrFunctionNotAvailableForFunctionType(DefaultCodespace)
+	} else if from.IsNegative() || from.GT(to) || to.GT(bond.MaxSupply.Amount) {
+		return nil, ErrInvalidPriceCurveRange(DefaultCodespace, from, to, bond.MaxSupply.Amount)
+	} else if samples < 1 || samples > MaxPriceCurveSamples {
+		return nil, ErrPriceCurveSamplesOutOfRange(DefaultCodespace, samples, MaxPriceCurveSamples)
+	}
+
+	var points []PriceCurvePoint
+	for _, supply := range GetPriceCurveSupplies(from, to, samples) {
+		prices, err := bond.GetPricesAtSupply(supply)
+		if err != nil {
+			return nil, err
+		}
+		points = append(points, PriceCurvePoint{
+			Supply:   supply,
+			Prices:   prices,
+			Integral: bond.GetNewReserveDecCoins(bond.CurveIntegral(supply)),
+		})
+	}
+	return points, nil
+}
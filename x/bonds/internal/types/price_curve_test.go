@@ -0,0 +1,69 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestGetPriceCurveSupplies(t *testing.T) {
+	testCases := []struct {
+		from     int64
+		to       int64
+		samples  int
+		expected []int64
+	}{
+		{0, 100, 5, []int64{0, 25, 50, 75, 100}},
+		{10, 20, 4, []int64{10, 13, 16, 20}},
+		{0, 2, 5, []int64{0, 1, 2}}, // duplicates left out
+		{0, 100, 1, []int64{0}},
+		{50, 50, 10, []int64{50}},
+	}
+	for _, tc := range testCases {
+		var expected []sdk.Int
+		for _, e := range tc.expected {
+			expected = append(expected, sdk.NewInt(e))
+		}
+		actual := GetPriceCurveSupplies(sdk.NewInt(tc.from), sdk.NewInt(tc.to), tc.samples)
+		require.Equal(t, expected, actual)
+	}
+}
+
+func TestBondGetPriceCurve(t *testing.T) {
+	bond := getValidBond() // y = 12x^2 + 100
+
+	points, err := bond.GetPriceCurve(sdk.ZeroInt(), sdk.NewInt(10), 2)
+	require.Nil(t, err)
+	require.Len(t, points, 2)
+
+	require.Equal(t, sdk.ZeroInt(), points[0].Supply)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 100))), points[0].Prices)
+	require.True(t, points[0].Integral.IsZero())
+
+	// Integral is 4x^3 + 100x
+	require.Equal(t, sdk.NewInt(10), points[1].Supply)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 1300))), points[1].Prices)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 5000))), points[1].Integral)
+}
+
+func TestBondGetPriceCurveInvalidArgumentsFails(t *testing.T) {
+	bond := getValidBond()
+	maxSupply := bond.MaxSupply.Amount
+
+	// Range is reversed or exceeds the max supply
+	_, err := bond.GetPriceCurve(sdk.NewInt(10), sdk.NewInt(5), 2)
+	require.Equal(t, CodeInvalidPriceCurve, err.Code())
+	_, err = bond.GetPriceCurve(sdk.ZeroInt(), maxSupply.AddRaw(1), 2)
+	require.Equal(t, CodeInvalidPriceCurve, err.Code())
+
+	// Samples are out of range
+	_, err = bond.GetPriceCurve(sdk.ZeroInt(), maxSupply, 0)
+	require.Equal(t, CodeInvalidPriceCurve, err.Code())
+	_, err = bond.GetPriceCurve(sdk.ZeroInt(), maxSupply, MaxPriceCurveSamples+1)
+	require.Equal(t, CodeInvalidPriceCurve, err.Code())
+
+	// Swapper function bonds do not have a price curve
+	bond.FunctionType = SwapperFunction
+	_, err = bond.GetPriceCurve(sdk.ZeroInt(), maxSupply, 2)
+	require.Equal(t, CodeFunctionNotAvailableForFunctionType, err.Code())
+}
@@ -11,6 +11,149 @@ func (b QueryBonds) String() string {
 	return strings.Join(b[:], "\n")
 }
 
+const (
+	DefaultBondsDetailedLimit = 100
+	MaxBondsDetailedLimit     = 500
+)
+
+// Filters are ignored if empty. A zero limit is replaced by the default limit
+// when the params are validated
+type QueryBondsDetailedParams struct {
+	Offset       sdk.Uint       `json:"offset" yaml:"offset"`
+	Limit        sdk.Uint       `json:"limit" yaml:"limit"`
+	FunctionType string         `json:"function_type" yaml:"function_type"`
+	ReserveToken string         `json:"reserve_token" yaml:"reserve_token"`
+	Creator      sdk.AccAddress `json:"creator" yaml:"creator"`
+	AllowSells   string         `json:"allow_sells" yaml:"allow_sells"`
+	Paused       string         `json:"paused" yaml:"paused"`
+	Dissolved    string         `json:"dissolved" yaml:"dissolved"`
+}
+
+func NewQueryBondsDetailedParams(offset, limit sdk.Uint, functionType,
+	reserveToken string, creator sdk.AccAddress, allowSells, paused,
+	dissolved string) QueryBondsDetailedParams {
+	return QueryBondsDetailedParams{
+		Offset:       offset,
+		Limit:        limit,
+		FunctionType: functionType,
+		ReserveToken: reserveToken,
+		Creator:      creator,
+		AllowSells:   allowSells,
+		Paused:       paused,
+		Dissolved:    dissolved,
+	}
+}
+
+// Validate also sets the limit to the default limit if it is zero, so that
+// every query returns a bounded page of bonds
+func (p *QueryBondsDetailedParams) Validate() sdk.Error {
+	if p.Limit.IsZero() {
+		p.Limit = sdk.NewUint(DefaultBondsDetailedLimit)
+	} else if p.Limit.GT(sdk.NewUint(MaxBondsDetailedLimit)) {
+		return ErrArgumentExceedsMaximum(DefaultCodespace, "limit", MaxBondsDetailedLimit)
+	}
+
+	if p.FunctionType != "" {
+		if _, ok := RequiredParamsForFunctionType[p.FunctionType]; !ok {
+			return ErrUnrecognizedFunctionType(DefaultCodespace)
+		}
+	}
+
+	// Check that empty, true, or false
+	booleans := []struct{ name, value string }{
+		{"AllowSells", p.AllowSells},
+		{"Paused", p.Paused},
+		{"Dissolved", p.Dissolved},
+	}
+	for _, b := range booleans {
+		if b.value != "" && b.value != TRUE && b.value != FALSE {
+			return ErrArgumentMissingOrNonBoolean(DefaultCodespace, b.name)
+		}
+	}
+
+	return nil
+}
+
+func (p QueryBondsDetailedParams) Matches(bond Bond) bool {
+	if p.FunctionType != "" && p.FunctionType != bond.FunctionType {
+		return false
+	} else if p.ReserveToken != "" && !bond.ReserveDenomsContain(p.ReserveToken) {
+		return false
+	} else if !p.Creator.Empty() && !p.Creator.Equals(bond.Creator) {
+		return false
+	} else if p.AllowSells != "" && p.AllowSells != bond.AllowSells {
+		return false
+	} else if p.Paused != "" && p.Paused != bond.Paused {
+		return false
+	} else if p.Dissolved != "" && p.Dissolved != bond.Dissolved {
+		return false
+	}
+	return true
+}
+
+type BondDetails struct {
+	Token           string    `json:"token" yaml:"token"`
+	FunctionType    string    `json:"function_type" yaml:"function_type"`
+	ReserveTokens   []string  `json:"reserve_tokens" yaml:"reserve_tokens"`
+	CurrentSupply   sdk.Coin  `json:"current_supply" yaml:"current_supply"`
+	CurrentPrice    sdk.Coins `json:"current_price" yaml:"current_price"`
+	ReserveBalances sdk.Coins `json:"reserve_balances" yaml:"reserve_balances"`
+	AllowSells      string    `json:"allow_sells" yaml:"allow_sells"`
+	Paused          string    `json:"paused" yaml:"paused"`
+	Dissolved       string    `json:"dissolved" yaml:"dissolved"`
+}
+
+type QueryBondsDetailed struct {
+	Total sdk.Uint      `json:"total" yaml:"total"`
+	Bonds []BondDetails `json:"bonds" yaml:"bonds"`
+}
+
+// A zero limit returns all candles, and a zero interval returns the candles
+// as stored, i.e. using the interval set when the candles were recorded
+type QueryCandlesParams struct {
+	Offset         sdk.Uint `json:"offset" yaml:"offset"`
+	Limit          sdk.Uint `json:"limit" yaml:"limit"`
+	IntervalBlocks sdk.Uint `json:"interval_blocks" yaml:"interval_blocks"`
+}
+
+func NewQueryCandlesParams(offset, limit, intervalBlocks sdk.Uint) QueryCandlesParams {
+	return QueryCandlesParams{
+		Offset:         offset,
+		Limit:          limit,
+		IntervalBlocks: intervalBlocks,
+	}
+}
+
+// Candles are sorted from newest to oldest, so that the first page holds the
+// most recent candles
+type QueryCandles struct {
+	Total   sdk.Uint `json:"total" yaml:"total"`
+	Candles []Candle `json:"candles" yaml:"candles"`
+}
+
+type QueryPriceObservations []PriceObservation
+
+type QueryOrdersByAddress []AddressOrders
+
+// AccountPosition holds an account's balance of a bond token valued at the
+// bond's current price, the returns (after fees) of selling the whole balance
+// at the bond's current supply, and the account's orders in the bond's
+// current batch. The current price is left empty if it cannot be calculated,
+// and the returns are left empty if the bond does not allow sells.
+type AccountPosition struct {
+	Token         string      `json:"token" yaml:"token"`
+	Balance       sdk.Coin    `json:"balance" yaml:"balance"`
+	CurrentPrice  sdk.Coins   `json:"current_price" yaml:"current_price"`
+	Returns       sdk.Coins   `json:"returns" yaml:"returns"`
+	TxFees        sdk.Coins   `json:"tx_fees" yaml:"tx_fees"`
+	ExitFees      sdk.Coins   `json:"exit_fees" yaml:"exit_fees"`
+	TotalReturns  sdk.Coins   `json:"total_returns" yaml:"total_returns"`
+	TotalFees     sdk.Coins   `json:"total_fees" yaml:"total_fees"`
+	PendingOrders BatchOrders `json:"pending_orders" yaml:"pending_orders"`
+}
+
+type QueryAccountPositions []AccountPosition
+
 type QueryBuyPrice struct {
 	AdjustedSupply sdk.Coin  `json:"adjusted_supply" yaml:"asdjusted_supply"`
 	Prices         sdk.Coins `json:"prices" yaml:"prices"`
@@ -28,7 +171,101 @@ type QuerySellReturn struct {
 	TotalFees      sdk.Coins `json:"total_fees" yaml:"total_fees"`
 }
 
+// Prices are the batch-wide buy and sell prices (per bond token) with the
+// quoted order added to the batch, which all buys or sells in the batch pay
+type QueryBuyQuote struct {
+	AdjustedSupply sdk.Coin      `json:"adjusted_supply" yaml:"adjusted_supply"`
+	BuyPrices      sdk.DecCoins  `json:"buy_prices" yaml:"buy_prices"`
+	SellPrices     sdk.DecCoins  `json:"sell_prices" yaml:"sell_prices"`
+	Prices         sdk.Coins     `json:"prices" yaml:"prices"`
+	TxFees         sdk.Coins     `json:"tx_fees" yaml:"tx_fees"`
+	TotalPrices    sdk.Coins     `json:"total_prices" yaml:"total_prices"`
+	TotalFees      sdk.Coins     `json:"total_fees" yaml:"total_fees"`
+	PriceImpacts   []PriceImpact `json:"price_impacts" yaml:"price_impacts"`
+}
+
+type QuerySellQuote struct {
+	AdjustedSupply sdk.Coin      `json:"adjusted_supply" yaml:"adjusted_supply"`
+	BuyPrices      sdk.DecCoins  `json:"buy_prices" yaml:"buy_prices"`
+	SellPrices     sdk.DecCoins  `json:"sell_prices" yaml:"sell_prices"`
+	Returns        sdk.Coins     `json:"returns" yaml:"returns"`
+	TxFees         sdk.Coins     `json:"tx_fees" yaml:"tx_fees"`
+	ExitFees       sdk.Coins     `json:"exit_fees" yaml:"exit_fees"`
+	TotalReturns   sdk.Coins     `json:"total_returns" yaml:"total_returns"`
+	TotalFees      sdk.Coins     `json:"total_fees" yaml:"total_fees"`
+	PriceImpacts   []PriceImpact `json:"price_impacts" yaml:"price_impacts"`
+}
+
+// The spot rate is the amount of the to token per from token at the current
+// reserves, and the effective rate is the total returns per from token
+// (including fees). The price impact is the percentage change from the spot
+// rate to the effective rate, and is negative since the effective rate is
+// always worse. Returns are calculated at the current reserves, whereas a swap
+// is only performed at the end of the batch, after the batch's buys and sells
+// and the pending swaps ahead of it (i.e. the batch's swaps that are not
+// cancelled, in the order that they will be performed).
 type QuerySwapReturn struct {
-	TotalReturns sdk.Coins `json:"total_returns" yaml:"total_returns"`
-	TotalFees    sdk.Coins `json:"total_fees" yaml:"total_fees"`
+	FromAmount         sdk.Coin    `json:"from_amount" yaml:"from_amount"`
+	TotalReturns       sdk.Coins   `json:"total_returns" yaml:"total_returns"`
+	TotalFees          sdk.Coins   `json:"total_fees" yaml:"total_fees"`
+	SpotRate           sdk.Dec     `json:"spot_rate" yaml:"spot_rate"`
+	EffectiveRate      sdk.Dec     `json:"effective_rate" yaml:"effective_rate"`
+	PriceImpact        sdk.Dec     `json:"price_impact" yaml:"price_impact"`
+	ViolatesSanityRate bool        `json:"violates_sanity_rate" yaml:"violates_sanity_rate"`
+	SwapsAhead         []SwapOrder `json:"swaps_ahead" yaml:"swaps_ahead"`
+}
+
+type QueryTap struct {
+	Tap             *Tap      `json:"tap" yaml:"tap"`
+	Dissolved       string    `json:"dissolved" yaml:"dissolved"`
+	Accrued         sdk.Int   `json:"accrued" yaml:"accrued"`
+	Floor           sdk.Coins `json:"floor" yaml:"floor"`
+	Available       sdk.Coins `json:"available" yaml:"available"`
+	RaiseVotes      sdk.Int   `json:"raise_votes" yaml:"raise_votes"`
+	DissolveVotes   sdk.Int   `json:"dissolve_votes" yaml:"dissolve_votes"`
+	TotalBondTokens sdk.Int   `json:"total_bond_tokens" yaml:"total_bond_tokens"`
+}
+
+type QueryReserveStaking struct {
+	Staking     *ReserveStaking     `json:"staking" yaml:"staking"`
+	Cap         sdk.Coins           `json:"cap" yaml:"cap"`
+	Liquid      sdk.Coins           `json:"liquid" yaml:"liquid"`
+	Delegated   sdk.Coins           `json:"delegated" yaml:"delegated"`
+	Unbonding   sdk.Coins           `json:"unbonding" yaml:"unbonding"`
+	QueuedTotal sdk.Coins           `json:"queued_total" yaml:"queued_total"`
+	Delegations []ReserveDelegation `json:"delegations" yaml:"delegations"`
+}
+
+type QueryRoundingSurplus struct {
+	Surplus   sdk.DecCoins `json:"surplus" yaml:"surplus"`
+	Sweepable sdk.Coins    `json:"sweepable" yaml:"sweepable"`
+}
+
+type QueryReferrals struct {
+	ReferralFeePercentage sdk.Dec         `json:"referral_fee_percentage" yaml:"referral_fee_percentage"`
+	ReferrerTotals        []ReferrerTotal `json:"referrer_totals" yaml:"referrer_totals"`
+}
+
+type QueryPriceCurve struct {
+	ReserveTokens []string          `json:"reserve_tokens" yaml:"reserve_tokens"`
+	Points        []PriceCurvePoint `json:"points" yaml:"points"`
+}
+
+// CSV returns the price curve as CSV, with a price and integral column for
+// each reserve token
+func (pc QueryPriceCurve) CSV() string {
+	header := []string{"supply"}
+	for _, r := range pc.ReserveTokens {
+		header = append(header, "price_"+r, "integral_"+r)
+	}
+
+	lines := []string{strings.Join(header, ",")}
+	for _, p := range pc.Points {
+		line := []string{p.Supply.String()}
+		for _, r := range pc.ReserveTokens {
+			line = append(line, p.Prices.AmountOf(r).String(), p.Integral.AmountOf(r).String())
+		}
+		lines = append(lines, strings.Join(line, ","))
+	}
+	return strings.Join(lines, "\n")
 }
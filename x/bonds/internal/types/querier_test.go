@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	"testing"
 )
@@ -22,3 +23,69 @@ func TestStringMessageForQueryBondIsAsExpected(t *testing.T) {
 
 	require.Equal(t, expectedResult, b.String())
 }
+
+func TestQueryBondsDetailedParamsValidate(t *testing.T) {
+	params := NewQueryBondsDetailedParams(sdk.ZeroUint(), sdk.ZeroUint(),
+		"", "", nil, "", "", "")
+	require.Nil(t, params.Validate())
+
+	params.FunctionType = "invalid_function"
+	require.Equal(t, CodeUnrecognizedFunctionType, params.Validate().Code())
+	params.FunctionType = SwapperFunction
+	require.Nil(t, params.Validate())
+
+	params.AllowSells = "yes"
+	require.Equal(t, CodeArgumentMissingOrIncorrectType, params.Validate().Code())
+	params.AllowSells = FALSE
+	require.Nil(t, params.Validate())
+
+	// A zero limit is replaced by the default limit
+	require.Equal(t, sdk.NewUint(DefaultBondsDetailedLimit), params.Limit)
+
+	// The limit cannot exceed the max limit
+	params.Limit = sdk.NewUint(MaxBondsDetailedLimit)
+	require.Nil(t, params.Validate())
+	params.Limit = sdk.NewUint(MaxBondsDetailedLimit + 1)
+	require.Equal(t, CodeArgumentInvalid, params.Validate().Code())
+}
+
+func TestQueryBondsDetailedParamsMatches(t *testing.T) {
+	bond := getValidBond()
+	params := NewQueryBondsDetailedParams(sdk.ZeroUint(), sdk.ZeroUint(),
+		"", "", nil, "", "", "")
+
+	// No filters match any bond
+	require.True(t, params.Matches(bond))
+
+	// Every filter has to match
+	params.FunctionType = PowerFunction
+	params.ReserveToken = reserveToken
+	params.Creator = initCreator
+	params.AllowSells = TRUE
+	params.Paused = FALSE
+	params.Dissolved = FALSE
+	require.True(t, params.Matches(bond))
+
+	params.ReserveToken = reserveToken2
+	require.False(t, params.Matches(bond))
+	params.ReserveToken = reserveToken
+
+	params.Dissolved = TRUE
+	require.False(t, params.Matches(bond))
+}
+
+func TestQueryPriceCurveCSV(t *testing.T) {
+	priceCurve := QueryPriceCurve{
+		ReserveTokens: []string{reserveToken, reserveToken2},
+		Points: []PriceCurvePoint{{
+			Supply:   sdk.NewInt(10),
+			Prices:   sdk.NewDecCoins(sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 5))),
+			Integral: sdk.NewDecCoins(sdk.NewCoins(sdk.NewInt64Coin(reserveToken, 20))),
+		}},
+	}
+
+	// Reserve tokens missing from a point are shown as zero
+	expected := "supply,price_res,integral_res,price_rez,integral_rez\n" +
+		"10,5.000000000000000000,20.000000000000000000,0.000000000000000000,0.000000000000000000"
+	require.Equal(t, expected, priceCurve.CSV())
+}
@@ -0,0 +1,41 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PriceImpact holds the percentage change, in a reserve token, of a batch's
+// buy and sell prices (per bond token) caused by adding an order to the batch.
+// The changes are positive if the prices went up and negative otherwise, and
+// apply to all other buys or sells in the batch.
+type PriceImpact struct {
+	Denom     string  `json:"denom" yaml:"denom"`
+	BuyPrice  sdk.Dec `json:"buy_price" yaml:"buy_price"`
+	SellPrice sdk.Dec `json:"sell_price" yaml:"sell_price"`
+}
+
+// NewPriceImpacts returns the price impacts for each reserve token, given a
+// batch's buy and sell prices before and after adding an order to the batch
+func NewPriceImpacts(buyPricesBefore, sellPricesBefore, buyPricesAfter,
+	sellPricesAfter sdk.DecCoins) (impacts []PriceImpact) {
+	// Adding the prices gives (sorted) coins with all of the reserve denoms
+	allPrices := buyPricesBefore.Add(sellPricesBefore).Add(
+		buyPricesAfter).Add(sellPricesAfter)
+	for _, coin := range allPrices {
+		impacts = append(impacts, PriceImpact{
+			Denom: coin.Denom,
+			BuyPrice: percentageChange(buyPricesBefore.AmountOf(coin.Denom),
+				buyPricesAfter.AmountOf(coin.Denom)),
+			SellPrice: percentageChange(sellPricesBefore.AmountOf(coin.Denom),
+				sellPricesAfter.AmountOf(coin.Denom)),
+		})
+	}
+	return impacts
+}
+
+func percentageChange(before, after sdk.Dec) sdk.Dec {
+	if before.IsZero() {
+		return sdk.ZeroDec()
+	}
+	return after.Sub(before).Quo(before).MulInt64(100)
+}
@@ -0,0 +1,25 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestNewPriceImpacts(t *testing.T) {
+	// Buy prices up by 25% and 50%, sell prices down by 50% and unchanged
+	impacts := NewPriceImpacts(decCoins(100, 10), decCoins(100, 10),
+		decCoins(125, 15), decCoins(50, 10))
+	expected := []PriceImpact{
+		{Denom: reserveToken, BuyPrice: sdk.NewDec(25), SellPrice: sdk.NewDec(-50)},
+		{Denom: reserveToken2, BuyPrice: sdk.NewDec(50), SellPrice: sdk.ZeroDec()},
+	}
+	require.Equal(t, expected, impacts)
+
+	// No change if there was no price before
+	impacts = NewPriceImpacts(nil, nil, decCoins(100), decCoins(100))
+	expected = []PriceImpact{
+		{Denom: reserveToken, BuyPrice: sdk.ZeroDec(), SellPrice: sdk.ZeroDec()},
+	}
+	require.Equal(t, expected, impacts)
+}
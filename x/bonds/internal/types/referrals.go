@@ -0,0 +1,27 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type ReferrerTotal struct {
+	Token    string         `json:"token" yaml:"token"`
+	Referrer sdk.AccAddress `json:"referrer" yaml:"referrer"`
+	Buys     sdk.Uint       `json:"buys" yaml:"buys"`
+	Paid     sdk.Coins      `json:"paid" yaml:"paid"`
+}
+
+func NewReferrerTotal(token string, referrer sdk.AccAddress) ReferrerTotal {
+	return ReferrerTotal{
+		Token:    token,
+		Referrer: referrer,
+		Buys:     sdk.ZeroUint(),
+		Paid:     nil,
+	}
+}
+
+func (rt ReferrerTotal) AddPayout(referralFees sdk.Coins) ReferrerTotal {
+	rt.Buys = rt.Buys.Add(sdk.OneUint())
+	rt.Paid = rt.Paid.Add(referralFees)
+	return rt
+}
@@ -0,0 +1,28 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type HolderRewards struct {
+	Token              string         `json:"token" yaml:"token"`
+	Address            sdk.AccAddress `json:"address" yaml:"address"`
+	RewardPerTokenPaid sdk.DecCoins   `json:"reward_per_token_paid" yaml:"reward_per_token_paid"`
+	Unclaimed          sdk.DecCoins   `json:"unclaimed" yaml:"unclaimed"`
+}
+
+func NewHolderRewards(token string, address sdk.AccAddress) HolderRewards {
+	return HolderRewards{
+		Token:              token,
+		Address:            address,
+		RewardPerTokenPaid: nil,
+		Unclaimed:          nil,
+	}
+}
+
+func (hr HolderRewards) GetAccrued(rewardPerToken sdk.DecCoins, balance sdk.Int) sdk.DecCoins {
+	// Rewards accrued since the last settlement are the holder's balance
+	// multiplied by the growth in the reward per token since then
+	rewardPerTokenGrowth := rewardPerToken.Sub(hr.RewardPerTokenPaid)
+	return rewardPerTokenGrowth.MulDecTruncate(sdk.NewDecFromInt(balance))
+}
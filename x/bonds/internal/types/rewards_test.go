@@ -0,0 +1,31 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"testing"
+)
+
+func TestHolderRewardsGetAccrued(t *testing.T) {
+	address := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	holderRewards := NewHolderRewards(initToken, address)
+
+	rewardPerToken, err := sdk.ParseDecCoins("0.5aaa,2.25bbb")
+	require.Nil(t, err)
+
+	// Nothing marked as paid, so everything is accrued
+	expected, err := sdk.ParseDecCoins("5.0aaa,22.5bbb")
+	require.Nil(t, err)
+	require.Equal(t, expected, holderRewards.GetAccrued(rewardPerToken, sdk.NewInt(10)))
+
+	// Only the difference since the paid reward per token is accrued
+	holderRewards.RewardPerTokenPaid, err = sdk.ParseDecCoins("0.5aaa,2.0bbb")
+	require.Nil(t, err)
+	expected, err = sdk.ParseDecCoins("2.5bbb")
+	require.Nil(t, err)
+	require.Equal(t, expected, holderRewards.GetAccrued(rewardPerToken, sdk.NewInt(10)))
+
+	// A zero balance accrues nothing
+	require.True(t, holderRewards.GetAccrued(rewardPerToken, sdk.ZeroInt()).IsZero())
+}
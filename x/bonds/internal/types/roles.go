@@ -0,0 +1,148 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	RoleAdmin          = "admin"
+	RoleMetadataEditor = "metadata_editor"
+	RoleFeeManager     = "fee_manager"
+	RolePauser         = "pauser"
+	RoleWithdrawer     = "withdrawer"
+	RoleStaker         = "staker"
+)
+
+var AllRoles = []string{
+	RoleAdmin, RoleMetadataEditor, RoleFeeManager, RolePauser, RoleWithdrawer,
+	RoleStaker,
+}
+
+func IsValidRole(role string) bool {
+	for _, r := range AllRoles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+type BondRole struct {
+	Role      string           `json:"role" yaml:"role"`
+	Addresses []sdk.AccAddress `json:"addresses" yaml:"addresses"`
+	Threshold sdk.Uint         `json:"threshold" yaml:"threshold"`
+}
+
+func NewBondRole(role string, addresses []sdk.AccAddress, threshold sdk.Uint) BondRole {
+	return BondRole{
+		Role:      role,
+		Addresses: addresses,
+		Threshold: threshold,
+	}
+}
+
+func (br BondRole) Validate() sdk.Error {
+	if !IsValidRole(br.Role) {
+		return ErrUnrecognizedRole(DefaultCodespace, br.Role)
+	} else if len(br.Addresses) == 0 {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "Addresses")
+	}
+
+	// Check that addresses are not empty and not duplicated
+	for i, addr := range br.Addresses {
+		if addr.Empty() {
+			return ErrArgumentCannotBeEmpty(DefaultCodespace, "Address")
+		}
+		for _, other := range br.Addresses[i+1:] {
+			if addr.Equals(other) {
+				return ErrDuplicateRoleAddress(DefaultCodespace, addr)
+			}
+		}
+	}
+
+	// Check that threshold is in the range [1, number of addresses]
+	numberOfAddresses := sdk.NewUint(uint64(len(br.Addresses)))
+	if br.Threshold.IsZero() || br.Threshold.GT(numberOfAddresses) {
+		return ErrInvalidRoleThreshold(DefaultCodespace, br.Threshold, numberOfAddresses)
+	}
+
+	return nil
+}
+
+func (br BondRole) HasAddress(address sdk.AccAddress) bool {
+	for _, addr := range br.Addresses {
+		if addr.Equals(address) {
+			return true
+		}
+	}
+	return false
+}
+
+func (br BondRole) Authorizes(signers []sdk.AccAddress) bool {
+	// Every signer has to hold the role, and has to sign only once
+	for i, signer := range signers {
+		if !br.HasAddress(signer) {
+			return false
+		}
+		for _, other := range signers[i+1:] {
+			if signer.Equals(other) {
+				return false
+			}
+		}
+	}
+
+	// The number of signers has to reach the role's threshold
+	return !br.Threshold.IsZero() &&
+		sdk.NewUint(uint64(len(signers))).GTE(br.Threshold)
+}
+
+type BondRoles []BondRole
+
+func NewDefaultBondRoles(signers []sdk.AccAddress) (roles BondRoles) {
+	// By default, every role requires all of the bond's signers
+	threshold := sdk.NewUint(uint64(len(signers)))
+	for _, role := range AllRoles {
+		roles = append(roles, NewBondRole(role, signers, threshold))
+	}
+	return roles
+}
+
+func (brs BondRoles) Get(role string) (BondRole, bool) {
+	for _, br := range brs {
+		if br.Role == role {
+			return br, true
+		}
+	}
+	return BondRole{}, false
+}
+
+// GetAllAddresses returns every address that holds at least one of the roles,
+// in the order in which the addresses first appear in the roles
+func (brs BondRoles) GetAllAddresses() (addresses []sdk.AccAddress) {
+	seen := make(map[string]bool)
+	for _, br := range brs {
+		for _, address := range br.Addresses {
+			if !seen[address.String()] {
+				seen[address.String()] = true
+				addresses = append(addresses, address)
+			}
+		}
+	}
+	return addresses
+}
+
+func (brs BondRoles) Set(role BondRole) (updated BondRoles) {
+	replaced := false
+	for _, br := range brs {
+		if br.Role == role.Role {
+			updated = append(updated, role)
+			replaced = true
+		} else {
+			updated = append(updated, br)
+		}
+	}
+	if !replaced {
+		updated = append(updated, role)
+	}
+	return updated
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"testing"
+)
+
+func TestNewDefaultBondRolesRequireAllSigners(t *testing.T) {
+	addr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	addr2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	signers := []sdk.AccAddress{addr1, addr2}
+
+	roles := NewDefaultBondRoles(signers)
+
+	require.Len(t, roles, len(AllRoles))
+	for _, role := range AllRoles {
+		bondRole, found := roles.Get(role)
+		require.True(t, found)
+		require.Equal(t, signers, bondRole.Addresses)
+		require.Equal(t, sdk.NewUint(2), bondRole.Threshold)
+		require.Nil(t, bondRole.Validate())
+	}
+}
+
+func TestBondRolesSetReplacesExistingRole(t *testing.T) {
+	addr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	addr2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	roles := NewDefaultBondRoles([]sdk.AccAddress{addr1})
+
+	newRole := NewBondRole(RolePauser, []sdk.AccAddress{addr2}, sdk.OneUint())
+	roles = roles.Set(newRole)
+
+	require.Len(t, roles, len(AllRoles))
+	bondRole, found := roles.Get(RolePauser)
+	require.True(t, found)
+	require.Equal(t, newRole, bondRole)
+
+	// Other roles are left untouched
+	bondRole, found = roles.Get(RoleAdmin)
+	require.True(t, found)
+	require.Equal(t, []sdk.AccAddress{addr1}, bondRole.Addresses)
+}
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"fmt"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// A sell is queued if, when performed, the bond's liquid (i.e. not staked)
+// reserve is not enough to pay out its returns and fees. The sell's effect on
+// the bond's reserve and supply is applied as soon as the sell is queued, so
+// the returns and fees are no longer part of the bond's current reserve
+type QueuedSell struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	Amount  sdk.Coin       `json:"amount" yaml:"amount"`
+	Returns sdk.Coins      `json:"returns" yaml:"returns"`
+	Fees    sdk.Coins      `json:"fees" yaml:"fees"`
+}
+
+func NewQueuedSell(address sdk.AccAddress, amount sdk.Coin, returns, fees sdk.Coins) QueuedSell {
+	return QueuedSell{
+		Address: address,
+		Amount:  amount,
+		Returns: returns,
+		Fees:    fees,
+	}
+}
+
+func (qs QueuedSell) GetTotal() sdk.Coins {
+	return qs.Returns.Add(qs.Fees)
+}
+
+type ReserveStaking struct {
+	CapPercentage    sdk.Dec      `json:"cap_percentage" yaml:"cap_percentage"`
+	RewardsToReserve string       `json:"rewards_to_reserve" yaml:"rewards_to_reserve"`
+	Losses           sdk.Int      `json:"losses" yaml:"losses"`
+	QueuedSells      []QueuedSell `json:"queued_sells" yaml:"queued_sells"`
+}
+
+func NewReserveStaking(capPercentage sdk.Dec, rewardsToReserve string) ReserveStaking {
+	return ReserveStaking{
+		CapPercentage:    capPercentage,
+		RewardsToReserve: rewardsToReserve,
+		Losses:           sdk.ZeroInt(),
+		QueuedSells:      nil,
+	}
+}
+
+func (rs ReserveStaking) String() string {
+	return fmt.Sprintf("{cap_percentage:%s,rewards_to_reserve:%s,losses:%s,queued_sells:%d}",
+		rs.CapPercentage.String(), rs.RewardsToReserve, rs.Losses.String(), len(rs.QueuedSells))
+}
+
+func (rs ReserveStaking) RewardsGoToReserve() bool {
+	return rs.RewardsToReserve == TRUE
+}
+
+func (rs ReserveStaking) HasQueuedSells() bool {
+	return len(rs.QueuedSells) != 0
+}
+
+//noinspection GoNilness
+func (rs ReserveStaking) GetQueuedTotal() (total sdk.Coins) {
+	for _, qs := range rs.QueuedSells {
+		total = total.Add(qs.GetTotal())
+	}
+	return total
+}
+
+type ReserveDelegation struct {
+	Validator sdk.ValAddress `json:"validator" yaml:"validator"`
+	Amount    sdk.Coin       `json:"amount" yaml:"amount"`
+}
+
+func NewReserveDelegation(validator sdk.ValAddress, amount sdk.Coin) ReserveDelegation {
+	return ReserveDelegation{
+		Validator: validator,
+		Amount:    amount,
+	}
+}
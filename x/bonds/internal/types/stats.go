@@ -0,0 +1,85 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BondStats holds a bond's cumulative statistics over all of its performed
+// orders. The fees include any referral fees and any liquidity fees kept in
+// the reserve, and the largest swaps hold the largest swap from each reserve
+// token. Buyers are counted once, no matter how many buys they performed.
+type BondStats struct {
+	Token        string    `json:"token" yaml:"token"`
+	TotalMinted  sdk.Int   `json:"total_minted" yaml:"total_minted"`
+	TotalBurned  sdk.Int   `json:"total_burned" yaml:"total_burned"`
+	TotalSwapped sdk.Coins `json:"total_swapped" yaml:"total_swapped"`
+	TotalFees    sdk.Coins `json:"total_fees" yaml:"total_fees"`
+	Buys         sdk.Uint  `json:"buys" yaml:"buys"`
+	Sells        sdk.Uint  `json:"sells" yaml:"sells"`
+	Swaps        sdk.Uint  `json:"swaps" yaml:"swaps"`
+	UniqueBuyers sdk.Uint  `json:"unique_buyers" yaml:"unique_buyers"`
+	LargestBuy   sdk.Int   `json:"largest_buy" yaml:"largest_buy"`
+	LargestSell  sdk.Int   `json:"largest_sell" yaml:"largest_sell"`
+	LargestSwaps sdk.Coins `json:"largest_swaps" yaml:"largest_swaps"`
+}
+
+func NewBondStats(token string) BondStats {
+	return BondStats{
+		Token:        token,
+		TotalMinted:  sdk.ZeroInt(),
+		TotalBurned:  sdk.ZeroInt(),
+		TotalSwapped: nil,
+		TotalFees:    nil,
+		Buys:         sdk.ZeroUint(),
+		Sells:        sdk.ZeroUint(),
+		Swaps:        sdk.ZeroUint(),
+		UniqueBuyers: sdk.ZeroUint(),
+		LargestBuy:   sdk.ZeroInt(),
+		LargestSell:  sdk.ZeroInt(),
+		LargestSwaps: nil,
+	}
+}
+
+func (bs BondStats) AddBuy(amount sdk.Int, fees sdk.Coins, newBuyer bool) BondStats {
+	bs.TotalMinted = bs.TotalMinted.Add(amount)
+	bs.TotalFees = bs.TotalFees.Add(fees)
+	bs.Buys = bs.Buys.Add(sdk.OneUint())
+	if newBuyer {
+		bs.UniqueBuyers = bs.UniqueBuyers.Add(sdk.OneUint())
+	}
+	bs.LargestBuy = sdk.MaxInt(bs.LargestBuy, amount)
+	return bs
+}
+
+func (bs BondStats) AddSell(amount sdk.Int, fees sdk.Coins) BondStats {
+	bs.TotalBurned = bs.TotalBurned.Add(amount)
+	bs.TotalFees = bs.TotalFees.Add(fees)
+	bs.Sells = bs.Sells.Add(sdk.OneUint())
+	bs.LargestSell = sdk.MaxInt(bs.LargestSell, amount)
+	return bs
+}
+
+func (bs BondStats) AddSwap(amount sdk.Coin, fees sdk.Coins) BondStats {
+	bs.TotalSwapped = bs.TotalSwapped.Add(sdk.Coins{amount})
+	bs.TotalFees = bs.TotalFees.Add(fees)
+	bs.Swaps = bs.Swaps.Add(sdk.OneUint())
+	if largest := bs.LargestSwaps.AmountOf(amount.Denom); amount.Amount.GT(largest) {
+		bs.LargestSwaps = bs.LargestSwaps.Add(sdk.Coins{
+			sdk.NewCoin(amount.Denom, amount.Amount.Sub(largest))})
+	}
+	return bs
+}
+
+// BondBuyer records that an address performed at least one buy of a bond,
+// and is used to count the bond's unique buyers
+type BondBuyer struct {
+	Token   string         `json:"token" yaml:"token"`
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+}
+
+func NewBondBuyer(token string, address sdk.AccAddress) BondBuyer {
+	return BondBuyer{
+		Token:   token,
+		Address: address,
+	}
+}
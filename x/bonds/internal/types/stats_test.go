@@ -0,0 +1,38 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestBondStatsAddOrders(t *testing.T) {
+	fees := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(reserveToken, amount))
+	}
+	swap := func(denom string, amount int64) sdk.Coin {
+		return sdk.NewInt64Coin(denom, amount)
+	}
+
+	stats := NewBondStats(token).
+		AddBuy(sdk.NewInt(10), fees(1), true).
+		AddBuy(sdk.NewInt(30), fees(3), false).
+		AddSell(sdk.NewInt(20), fees(2)).
+		AddSwap(swap(reserveToken, 5), nil).
+		AddSwap(swap(reserveToken2, 8), sdk.Coins{swap(reserveToken2, 1)}).
+		AddSwap(swap(reserveToken, 3), nil)
+
+	require.Equal(t, sdk.NewInt(40), stats.TotalMinted)
+	require.Equal(t, sdk.NewInt(20), stats.TotalBurned)
+	require.Equal(t, sdk.NewCoins(swap(reserveToken, 8), swap(reserveToken2, 8)), stats.TotalSwapped)
+	require.Equal(t, sdk.NewCoins(swap(reserveToken, 6), swap(reserveToken2, 1)), stats.TotalFees)
+	require.Equal(t, sdk.NewUint(2), stats.Buys)
+	require.Equal(t, sdk.OneUint(), stats.Sells)
+	require.Equal(t, sdk.NewUint(3), stats.Swaps)
+	require.Equal(t, sdk.OneUint(), stats.UniqueBuyers)
+
+	// Largest orders, with the largest swap from each reserve token
+	require.Equal(t, sdk.NewInt(30), stats.LargestBuy)
+	require.Equal(t, sdk.NewInt(20), stats.LargestSell)
+	require.Equal(t, sdk.NewCoins(swap(reserveToken, 5), swap(reserveToken2, 8)), stats.LargestSwaps)
+}
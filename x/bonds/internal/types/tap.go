@@ -0,0 +1,81 @@
+package types
+
+import (
+	"fmt"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	TapVoteRaise    = "raise"
+	TapVoteDissolve = "dissolve"
+)
+
+// The tap amounts (rate, accrued, withdrawn) apply to each of the bond's
+// reserve tokens, so that the reserve balances are always kept equal
+type Tap struct {
+	Beneficiary       sdk.AccAddress `json:"beneficiary" yaml:"beneficiary"`
+	Rate              sdk.Int        `json:"rate" yaml:"rate"`
+	FloorPercentage   sdk.Dec        `json:"floor_percentage" yaml:"floor_percentage"`
+	ProposedRate      sdk.Int        `json:"proposed_rate" yaml:"proposed_rate"`
+	Accrued           sdk.Int        `json:"accrued" yaml:"accrued"`
+	LastAccrualHeight int64          `json:"last_accrual_height" yaml:"last_accrual_height"`
+	Withdrawn         sdk.Int        `json:"withdrawn" yaml:"withdrawn"`
+}
+
+func NewTap(beneficiary sdk.AccAddress, rate sdk.Int, floorPercentage sdk.Dec,
+	height int64) Tap {
+	return Tap{
+		Beneficiary:       beneficiary,
+		Rate:              rate,
+		FloorPercentage:   floorPercentage,
+		ProposedRate:      sdk.ZeroInt(),
+		Accrued:           sdk.ZeroInt(),
+		LastAccrualHeight: height,
+		Withdrawn:         sdk.ZeroInt(),
+	}
+}
+
+func (tap Tap) String() string {
+	return fmt.Sprintf("{beneficiary:%s,rate:%s,floor_percentage:%s,proposed_rate:%s,withdrawn:%s}",
+		tap.Beneficiary.String(), tap.Rate.String(), tap.FloorPercentage.String(),
+		tap.ProposedRate.String(), tap.Withdrawn.String())
+}
+
+func (tap Tap) HasProposedRate() bool {
+	return tap.ProposedRate.IsPositive()
+}
+
+func (tap Tap) GetAccrued(height int64) sdk.Int {
+	// The tap accrues its rate for every block since its last accrual
+	if height <= tap.LastAccrualHeight {
+		return tap.Accrued
+	}
+	blocks := sdk.NewInt(height - tap.LastAccrualHeight)
+	return tap.Accrued.Add(tap.Rate.Mul(blocks))
+}
+
+func (tap Tap) Accrue(height int64) Tap {
+	// Locks in the amount accrued so far, so that a change in rate only
+	// applies from the current height onwards
+	tap.Accrued = tap.GetAccrued(height)
+	tap.LastAccrualHeight = height
+	return tap
+}
+
+func IsValidTapVoteOption(option string) bool {
+	return option == TapVoteRaise || option == TapVoteDissolve
+}
+
+type TapVote struct {
+	Token  string         `json:"token" yaml:"token"`
+	Voter  sdk.AccAddress `json:"voter" yaml:"voter"`
+	Option string         `json:"option" yaml:"option"`
+}
+
+func NewTapVote(token string, voter sdk.AccAddress, option string) TapVote {
+	return TapVote{
+		Token:  token,
+		Voter:  voter,
+		Option: option,
+	}
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestTapGetAccrued(t *testing.T) {
+	tap := NewTap(initCreator, sdk.NewInt(100), sdk.NewDec(50), 10)
+	tap.Accrued = sdk.NewInt(5)
+
+	testCases := []struct {
+		height   int64
+		expected sdk.Int
+	}{
+		{5, sdk.NewInt(5)},
+		{10, sdk.NewInt(5)},
+		{11, sdk.NewInt(105)},
+		{20, sdk.NewInt(1005)},
+	}
+	for _, tc := range testCases {
+		require.Equal(t, tc.expected, tap.GetAccrued(tc.height))
+	}
+}
+
+func TestTapAccrue(t *testing.T) {
+	tap := NewTap(initCreator, sdk.NewInt(100), sdk.NewDec(50), 10)
+
+	// Accruing locks in the amount accrued up to the height
+	accrued := tap.Accrue(20)
+	require.Equal(t, sdk.NewInt(1000), accrued.Accrued)
+	require.Equal(t, int64(20), accrued.LastAccrualHeight)
+
+	// A rate change only applies from the accrual height onwards
+	accrued.Rate = sdk.NewInt(10)
+	require.Equal(t, sdk.NewInt(1100), accrued.GetAccrued(30))
+
+	// Original tap is not modified
+	require.Equal(t, sdk.ZeroInt(), tap.Accrued)
+	require.Equal(t, int64(10), tap.LastAccrualHeight)
+}
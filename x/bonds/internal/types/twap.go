@@ -0,0 +1,94 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PriceObservation holds a bond's prices (per bond token) as of a height and
+// the cumulative prices up to that height, i.e. the sum of the prices in
+// effect during each block before the height. For swapper function bonds, it
+// also holds the exchange rates between the two reserve tokens, with each
+// reserve token's rate being the amount of the other reserve token per unit.
+type PriceObservation struct {
+	Token                   string       `json:"token" yaml:"token"`
+	Height                  int64        `json:"height" yaml:"height"`
+	Prices                  sdk.DecCoins `json:"prices" yaml:"prices"`
+	ExchangeRates           sdk.DecCoins `json:"exchange_rates" yaml:"exchange_rates"`
+	CumulativePrices        sdk.DecCoins `json:"cumulative_prices" yaml:"cumulative_prices"`
+	CumulativeExchangeRates sdk.DecCoins `json:"cumulative_exchange_rates" yaml:"cumulative_exchange_rates"`
+}
+
+func NewPriceObservation(token string, height int64, prices, exchangeRates sdk.DecCoins) PriceObservation {
+	return PriceObservation{
+		Token:                   token,
+		Height:                  height,
+		Prices:                  prices,
+		ExchangeRates:           exchangeRates,
+		CumulativePrices:        nil,
+		CumulativeExchangeRates: nil,
+	}
+}
+
+// CumulativeAt returns the cumulative prices and exchange rates up to a
+// height that is not before the observation's height, assuming that the
+// observation's prices and exchange rates remained in effect until then
+func (o PriceObservation) CumulativeAt(height int64) (cumulativePrices, cumulativeExchangeRates sdk.DecCoins) {
+	blocks := sdk.NewInt(height - o.Height)
+	cumulativePrices = o.CumulativePrices.Add(
+		MultiplyDecCoinsByInt(o.Prices, blocks))
+	cumulativeExchangeRates = o.CumulativeExchangeRates.Add(
+		MultiplyDecCoinsByInt(o.ExchangeRates, blocks))
+	return cumulativePrices, cumulativeExchangeRates
+}
+
+// Next returns the observation of new prices and exchange rates at a height
+// that is after the observation's height
+func (o PriceObservation) Next(height int64, prices, exchangeRates sdk.DecCoins) PriceObservation {
+	next := NewPriceObservation(o.Token, height, prices, exchangeRates)
+	next.CumulativePrices, next.CumulativeExchangeRates = o.CumulativeAt(height)
+	return next
+}
+
+// Twap holds a bond's time-weighted average prices and (for swapper function
+// bonds) exchange rates over the blocks from FromHeight up to ToHeight
+type Twap struct {
+	Token         string       `json:"token" yaml:"token"`
+	FromHeight    int64        `json:"from_height" yaml:"from_height"`
+	ToHeight      int64        `json:"to_height" yaml:"to_height"`
+	Prices        sdk.DecCoins `json:"prices" yaml:"prices"`
+	ExchangeRates sdk.DecCoins `json:"exchange_rates" yaml:"exchange_rates"`
+}
+
+// NewTwap returns the time-weighted averages over the blocks between two
+// heights, given the cumulative prices and exchange rates up to each height
+func NewTwap(token string, fromHeight, toHeight int64, fromPrices, fromRates,
+	toPrices, toRates sdk.DecCoins) Twap {
+	blocks := sdk.NewDec(toHeight - fromHeight)
+	return Twap{
+		Token:         token,
+		FromHeight:    fromHeight,
+		ToHeight:      toHeight,
+		Prices:        DivideDecCoinsByDec(toPrices.Sub(fromPrices), blocks),
+		ExchangeRates: DivideDecCoinsByDec(toRates.Sub(fromRates), blocks),
+	}
+}
+
+// GetExchangeRates returns the exchange rates between the reserve tokens of
+// a swapper function bond given the bond's prices (per bond token), or nil
+// for other function types or if any price is zero
+func (bond Bond) GetExchangeRates(pricesPT sdk.DecCoins) sdk.DecCoins {
+	if bond.FunctionType != SwapperFunction {
+		return nil
+	}
+
+	price1 := pricesPT.AmountOf(bond.ReserveTokens[0])
+	price2 := pricesPT.AmountOf(bond.ReserveTokens[1])
+	if price1.IsZero() || price2.IsZero() {
+		return nil
+	}
+
+	return sdk.DecCoins{
+		sdk.NewDecCoinFromDec(bond.ReserveTokens[0], price2.Quo(price1)),
+		sdk.NewDecCoinFromDec(bond.ReserveTokens[1], price1.Quo(price2)),
+	}.Sort()
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestPriceObservationCumulativeAtAndNext(t *testing.T) {
+	o := NewPriceObservation(token, 10, decCoins(2), decCoins(1))
+	require.True(t, o.CumulativePrices.Empty())
+	require.True(t, o.CumulativeExchangeRates.Empty())
+
+	// Prices of 2 and exchange rates of 1 in effect for 5 blocks
+	cumulativePrices, cumulativeRates := o.CumulativeAt(15)
+	require.Equal(t, decCoins(10), cumulativePrices)
+	require.Equal(t, decCoins(5), cumulativeRates)
+
+	// Next observation continues from the cumulative values up to its height
+	next := o.Next(15, decCoins(4), decCoins(3))
+	require.Equal(t, int64(15), next.Height)
+	require.Equal(t, decCoins(4), next.Prices)
+	require.Equal(t, decCoins(3), next.ExchangeRates)
+	require.Equal(t, decCoins(10), next.CumulativePrices)
+	require.Equal(t, decCoins(5), next.CumulativeExchangeRates)
+
+	cumulativePrices, cumulativeRates = next.CumulativeAt(20)
+	require.Equal(t, decCoins(30), cumulativePrices)
+	require.Equal(t, decCoins(20), cumulativeRates)
+}
+
+func TestNewTwap(t *testing.T) {
+	twap := NewTwap(token, 10, 20,
+		decCoins(10, 5), nil, decCoins(30, 35), nil)
+	require.Equal(t, token, twap.Token)
+	require.Equal(t, int64(10), twap.FromHeight)
+	require.Equal(t, int64(20), twap.ToHeight)
+	require.Equal(t, decCoins(2, 3), twap.Prices)
+	require.True(t, twap.ExchangeRates.Empty())
+}
+
+func TestGetExchangeRates(t *testing.T) {
+	prices := decCoins(2, 4)
+
+	// No exchange rates for non-swapper function bonds
+	bond := getValidBond()
+	require.Nil(t, bond.GetExchangeRates(prices))
+
+	// Each reserve token's rate is the amount of the other reserve token per
+	// unit, i.e. 1res=2rez and 1rez=0.5res
+	bond.FunctionType = SwapperFunction
+	bond.ReserveTokens = swapperReserves
+	expected := sdk.DecCoins{
+		sdk.NewInt64DecCoin(reserveToken, 2),
+		sdk.NewDecCoinFromDec(reserveToken2, sdk.MustNewDecFromStr("0.5")),
+	}
+	require.Equal(t, expected, bond.GetExchangeRates(prices))
+
+	// No exchange rates if any price is zero
+	require.Nil(t, bond.GetExchangeRates(decCoins(2)))
+}
@@ -88,6 +88,26 @@ func DivideDecCoinsByDec(dcs sdk.DecCoins, scale sdk.Dec) (scaled sdk.DecCoins)
 	return scaled
 }
 
+//noinspection GoNilness
+func DivideDecCoinsByDecRoundUp(dcs sdk.DecCoins, scale sdk.Dec) (scaled sdk.DecCoins) {
+	// Rounded up (rather than to the nearest value) at the precision of sdk.Dec
+	for _, dc := range dcs {
+		scaled = scaled.Add(sdk.DecCoins{
+			sdk.NewDecCoinFromDec(dc.Denom, dc.Amount.QuoRoundUp(scale))})
+	}
+	return scaled
+}
+
+//noinspection GoNilness
+func DivideDecCoinsByDecTruncate(dcs sdk.DecCoins, scale sdk.Dec) (scaled sdk.DecCoins) {
+	// Rounded down (rather than to the nearest value) at the precision of sdk.Dec
+	for _, dc := range dcs {
+		scaled = scaled.Add(sdk.DecCoins{
+			sdk.NewDecCoinFromDec(dc.Denom, dc.Amount.QuoTruncate(scale))})
+	}
+	return scaled
+}
+
 func AdjustFees(fees sdk.Coins, maxFees sdk.Coins) sdk.Coins {
 
 	// List of extra fees to deduct at the end
@@ -119,3 +139,20 @@ func AccAddressesToString(addresses []sdk.AccAddress) (result string) {
 func StringsToString(strs []string) (result string) {
 	return "[" + strings.Join(strs, ",") + "]"
 }
+
+func GetPriceMovePercentage(currentPrices, prices sdk.DecCoins) sdk.Dec {
+	// Largest percentage by which any of the prices moved away from the
+	// current price of the same denomination (zero current prices ignored)
+	maxMove := sdk.ZeroDec()
+	for _, p := range prices {
+		current := currentPrices.AmountOf(p.Denom)
+		if !current.IsPositive() {
+			continue
+		}
+		move := p.Amount.Sub(current).Abs().Quo(current).MulInt64(100)
+		if move.GT(maxMove) {
+			maxMove = move
+		}
+	}
+	return maxMove
+}
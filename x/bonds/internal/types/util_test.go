@@ -212,6 +212,27 @@ func TestMultiplyDecCoinsByDecDividesAllValues(t *testing.T) {
 	require.True(t, DivideDecCoinsByDec(ins, scaleDec).IsEqual(outs))
 }
 
+func TestDivideDecCoinsByDecRoundsInGivenDirection(t *testing.T) {
+	ins := sdk.DecCoins{
+		sdk.NewDecCoinFromDec("token1", sdk.MustNewDecFromStr("1")),
+		sdk.NewDecCoinFromDec("token2", sdk.MustNewDecFromStr("2")),
+	}
+	scaleDec := sdk.MustNewDecFromStr("3")
+
+	// 1/3 and 2/3 are rounded up or down at the 18th decimal place, whereas
+	// rounding to the nearest value would round 2/3 up and 1/3 down
+	roundedUp := sdk.DecCoins{
+		sdk.NewDecCoinFromDec("token1", sdk.MustNewDecFromStr("0.333333333333333334")),
+		sdk.NewDecCoinFromDec("token2", sdk.MustNewDecFromStr("0.666666666666666667")),
+	}
+	roundedDown := sdk.DecCoins{
+		sdk.NewDecCoinFromDec("token1", sdk.MustNewDecFromStr("0.333333333333333333")),
+		sdk.NewDecCoinFromDec("token2", sdk.MustNewDecFromStr("0.666666666666666666")),
+	}
+	require.True(t, DivideDecCoinsByDecRoundUp(ins, scaleDec).IsEqual(roundedUp))
+	require.True(t, DivideDecCoinsByDecTruncate(ins, scaleDec).IsEqual(roundedDown))
+}
+
 func TestRoundFee(t *testing.T) {
 	token := "token"
 
@@ -294,3 +315,32 @@ func TestAccAddressesToString(t *testing.T) {
 		require.Equal(t, tc.out, AccAddressesToString(tc.in))
 	}
 }
+
+func TestGetPriceMovePercentage(t *testing.T) {
+	current := sdk.DecCoins{
+		sdk.NewDecCoinFromDec("token1", sdk.MustNewDecFromStr("100")),
+		sdk.NewDecCoinFromDec("token2", sdk.MustNewDecFromStr("10")),
+	}.Sort()
+
+	testCases := []struct {
+		prices   sdk.DecCoins
+		expected sdk.Dec
+	}{
+		{nil, sdk.ZeroDec()},
+		{current, sdk.ZeroDec()},
+		{sdk.DecCoins{
+			sdk.NewDecCoinFromDec("token1", sdk.MustNewDecFromStr("116")),
+			sdk.NewDecCoinFromDec("token2", sdk.MustNewDecFromStr("10")),
+		}.Sort(), sdk.MustNewDecFromStr("16")},
+		{sdk.DecCoins{
+			sdk.NewDecCoinFromDec("token1", sdk.MustNewDecFromStr("110")),
+			sdk.NewDecCoinFromDec("token2", sdk.MustNewDecFromStr("7.5")),
+		}.Sort(), sdk.MustNewDecFromStr("25")},
+		{sdk.DecCoins{
+			sdk.NewDecCoinFromDec("token3", sdk.MustNewDecFromStr("1")),
+		}, sdk.ZeroDec()},
+	}
+	for _, tc := range testCases {
+		require.Equal(t, tc.expected, GetPriceMovePercentage(current, tc.prices))
+	}
+}
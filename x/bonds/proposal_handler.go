@@ -0,0 +1,28 @@
+package bonds
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/ixoworld/bonds/x/bonds/internal/keeper"
+	"github.com/ixoworld/bonds/x/bonds/internal/types"
+)
+
+// NewParamChangeProposalHandler wraps the params module's proposal handler so
+// that a parameter change proposal fails if it leaves the bonds parameters
+// inconsistent as a whole (e.g. a min batch blocks greater than the max batch
+// blocks), since each parameter change is otherwise only validated on its own
+func NewParamChangeProposalHandler(keeper keeper.Keeper, paramsHandler gov.Handler) gov.Handler {
+	return func(ctx sdk.Context, content gov.Content) sdk.Error {
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := paramsHandler(cacheCtx, content); err != nil {
+			return err
+		}
+
+		if err := keeper.GetParams(cacheCtx).Validate(); err != nil {
+			return types.ErrInvalidParams(types.DefaultCodespace, err.Error())
+		}
+
+		writeCache()
+		return nil
+	}
+}
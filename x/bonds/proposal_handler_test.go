@@ -0,0 +1,51 @@
+package bonds_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/ixoworld/bonds/x/bonds"
+	"github.com/ixoworld/bonds/x/bonds/internal/types"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestParamChangeProposalHandler(t *testing.T) {
+	app, ctx := createTestApp(false)
+	h := bonds.NewParamChangeProposalHandler(app.BondsKeeper,
+		params.NewParamChangeProposalHandler(app.ParamsKeeper))
+	newProposal := func(changes ...params.ParamChange) params.ParameterChangeProposal {
+		return params.NewParameterChangeProposal("title", "description", changes)
+	}
+	minBatchBlocks := func(value string) params.ParamChange {
+		return params.NewParamChange(bonds.DefaultParamspace, "MinBatchBlocks", value)
+	}
+	maxBatchBlocks := func(value string) params.ParamChange {
+		return params.NewParamChange(bonds.DefaultParamspace, "MaxBatchBlocks", value)
+	}
+	defaultParams := app.BondsKeeper.GetParams(ctx)
+
+	// Min batch blocks cannot be changed to more than the max (1000)
+	err := h(ctx, newProposal(minBatchBlocks(`"2000"`)))
+	require.NotNil(t, err)
+	require.Equal(t, types.CodeInvalidParams, err.Code())
+	require.Equal(t, defaultParams, app.BondsKeeper.GetParams(ctx))
+
+	// Max batch blocks cannot be changed to less than the min (1)
+	err = h(ctx, newProposal(maxBatchBlocks(`"0"`)))
+	require.NotNil(t, err)
+	require.Equal(t, defaultParams, app.BondsKeeper.GetParams(ctx))
+
+	// Both can be changed together if consistent
+	err = h(ctx, newProposal(maxBatchBlocks(`"5000"`), minBatchBlocks(`"2000"`)))
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewUint(2000), app.BondsKeeper.GetParams(ctx).MinBatchBlocks)
+	require.Equal(t, sdk.NewUint(5000), app.BondsKeeper.GetParams(ctx).MaxBatchBlocks)
+}
+
+func TestSetInvalidParamsPanics(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	params := app.BondsKeeper.GetParams(ctx)
+	params.MinBatchBlocks = params.MaxBatchBlocks.Add(sdk.OneUint())
+	require.Panics(t, func() { app.BondsKeeper.SetParams(ctx, params) })
+}
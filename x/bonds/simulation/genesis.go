@@ -17,6 +17,22 @@ const (
 	MaxBonds                = "max_bonds"
 	MaxNumberOfInitialBonds = 100
 	MaxNumberOfBonds        = 100000
+
+	// Randomized min batch blocks are always less than this value and max
+	// batch blocks are always greater than or equal to it, so that a param
+	// change to either of the two can never give a min greater than the max
+	BatchBlocksSplit = 5
+
+	MaxTxFeePercentage     = "max_tx_fee_percentage"
+	MaxExitFeePercentage   = "max_exit_fee_percentage"
+	MinBatchBlocks         = "min_batch_blocks"
+	MaxBatchBlocks         = "max_batch_blocks"
+	BondCreationFee        = "bond_creation_fee"
+	BondCreationDeposit    = "bond_creation_deposit"
+	MaxOrdersPerBatch      = "max_orders_per_batch"
+	CandleIntervalBlocks   = "candle_interval_blocks"
+	MaxCandles             = "max_candles"
+	PriceObservationBlocks = "price_observation_blocks"
 )
 
 // GenInitialNumberOfBonds randomized initial number of bonds
@@ -29,10 +45,128 @@ func GenMaxNumberOfBonds(r *rand.Rand) (maxBonds uint64) {
 	return uint64(r.Int63n(MaxNumberOfBonds-MaxNumberOfInitialBonds) + MaxNumberOfInitialBonds + 1)
 }
 
+// GenMaxTxFeePercentage randomized MaxTxFeePercentage
+func GenMaxTxFeePercentage(r *rand.Rand) sdk.Dec {
+	return sdk.NewDec(int64(simulation.RandIntBetween(r, 10, 100)))
+}
+
+// GenMaxExitFeePercentage randomized MaxExitFeePercentage
+func GenMaxExitFeePercentage(r *rand.Rand) sdk.Dec {
+	return sdk.NewDec(int64(simulation.RandIntBetween(r, 10, 100)))
+}
+
+// GenMinBatchBlocks randomized MinBatchBlocks
+func GenMinBatchBlocks(r *rand.Rand) sdk.Uint {
+	return sdk.NewUint(uint64(simulation.RandIntBetween(r, 1, BatchBlocksSplit)))
+}
+
+// GenMaxBatchBlocks randomized MaxBatchBlocks
+func GenMaxBatchBlocks(r *rand.Rand) sdk.Uint {
+	return sdk.NewUint(uint64(simulation.RandIntBetween(r, BatchBlocksSplit, 20)))
+}
+
+// GenBondCreationFee randomized BondCreationFee
+func GenBondCreationFee(r *rand.Rand) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom,
+		int64(simulation.RandIntBetween(r, 0, 1000))))
+}
+
+// GenBondCreationDeposit randomized BondCreationDeposit
+func GenBondCreationDeposit(r *rand.Rand) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom,
+		int64(simulation.RandIntBetween(r, 0, 1000))))
+}
+
+// GenMaxOrdersPerBatch randomized MaxOrdersPerBatch
+func GenMaxOrdersPerBatch(r *rand.Rand) uint64 {
+	return uint64(simulation.RandIntBetween(r, 10, 1000))
+}
+
+// GenCandleIntervalBlocks randomized CandleIntervalBlocks
+func GenCandleIntervalBlocks(r *rand.Rand) sdk.Uint {
+	return sdk.NewUint(uint64(simulation.RandIntBetween(r, 1, 50)))
+}
+
+// GenMaxCandles randomized MaxCandles
+func GenMaxCandles(r *rand.Rand) uint64 {
+	return uint64(simulation.RandIntBetween(r, 1, 100))
+}
+
+// GenPriceObservationBlocks randomized PriceObservationBlocks
+func GenPriceObservationBlocks(r *rand.Rand) sdk.Uint {
+	return sdk.NewUint(uint64(simulation.RandIntBetween(r, 1, 100)))
+}
+
 // RandomizedGenState generates a random GenesisState
 func RandomizedGenState(simState *module.SimulationState) {
 	r := simState.Rand
 
+	// Generate random module parameters
+	var maxTxFeePercentage sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxTxFeePercentage, &maxTxFeePercentage, simState.Rand,
+		func(r *rand.Rand) { maxTxFeePercentage = GenMaxTxFeePercentage(r) },
+	)
+
+	var maxExitFeePercentage sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxExitFeePercentage, &maxExitFeePercentage, simState.Rand,
+		func(r *rand.Rand) { maxExitFeePercentage = GenMaxExitFeePercentage(r) },
+	)
+
+	var minBatchBlocks sdk.Uint
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MinBatchBlocks, &minBatchBlocks, simState.Rand,
+		func(r *rand.Rand) { minBatchBlocks = GenMinBatchBlocks(r) },
+	)
+
+	var maxBatchBlocks sdk.Uint
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxBatchBlocks, &maxBatchBlocks, simState.Rand,
+		func(r *rand.Rand) { maxBatchBlocks = GenMaxBatchBlocks(r) },
+	)
+
+	var bondCreationFee sdk.Coins
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, BondCreationFee, &bondCreationFee, simState.Rand,
+		func(r *rand.Rand) { bondCreationFee = GenBondCreationFee(r) },
+	)
+
+	var bondCreationDeposit sdk.Coins
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, BondCreationDeposit, &bondCreationDeposit, simState.Rand,
+		func(r *rand.Rand) { bondCreationDeposit = GenBondCreationDeposit(r) },
+	)
+
+	var maxOrdersPerBatch uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxOrdersPerBatch, &maxOrdersPerBatch, simState.Rand,
+		func(r *rand.Rand) { maxOrdersPerBatch = GenMaxOrdersPerBatch(r) },
+	)
+
+	var candleIntervalBlocks sdk.Uint
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, CandleIntervalBlocks, &candleIntervalBlocks, simState.Rand,
+		func(r *rand.Rand) { candleIntervalBlocks = GenCandleIntervalBlocks(r) },
+	)
+
+	var maxCandles uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxCandles, &maxCandles, simState.Rand,
+		func(r *rand.Rand) { maxCandles = GenMaxCandles(r) },
+	)
+
+	var priceObservationBlocks sdk.Uint
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, PriceObservationBlocks, &priceObservationBlocks, simState.Rand,
+		func(r *rand.Rand) { priceObservationBlocks = GenPriceObservationBlocks(r) },
+	)
+
+	params := types.NewParams(maxTxFeePercentage, maxExitFeePercentage,
+		minBatchBlocks, maxBatchBlocks, bondCreationFee, bondCreationDeposit, []string{},
+		[]string{sdk.DefaultBondDenom}, maxOrdersPerBatch, candleIntervalBlocks, maxCandles,
+		priceObservationBlocks)
+
 	// Generate a random number of initial bonds and maximum bonds
 	var initialBonds, maxBonds uint64
 	simState.AppParams.GetOrGenerate(
@@ -85,9 +219,8 @@ func RandomizedGenState(simState *module.SimulationState) {
 		}
 		functionParameters := getRandomFunctionParameters(r, functionType)
 
-		// Max fee is 100, so exit fee uses 100-txFee as max
-		txFeePercentage := simulation.RandomDecAmount(r, sdk.NewDec(100))
-		exitFeePercentage := simulation.RandomDecAmount(r, sdk.NewDec(100).Sub(txFeePercentage))
+		// Fees are within the params' maximums and sum to at most 100
+		txFeePercentage, exitFeePercentage := getRandomFeePercentages(r, params)
 
 		// Addresses
 		reserveAddress := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
@@ -97,8 +230,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 		maxSupply := sdk.NewCoin(token, sdk.NewInt(int64(
 			simulation.RandIntBetween(r, 1000000, 1000000000))))
 		allowSells := getRandomAllowSellsValue(r)
-		batchBlocks := sdk.NewUint(uint64(
-			simulation.RandIntBetween(r, 1, 10)))
+		batchBlocks := getRandomBatchBlocks(r, params)
 
 		bond := types.NewBond(token, name, desc, creator, functionType,
 			functionParameters, reserveTokens, reserveAddress, txFeePercentage,
@@ -106,6 +238,27 @@ func RandomizedGenState(simState *module.SimulationState) {
 			blankSanityRate, blankSanityMarginPercentage, allowSells, signers, batchBlocks)
 		batch := types.NewBatch(bond.Token, bond.BatchBlocks)
 
+		// Half of the time, a share of fees is paid out to token holders
+		if simulation.RandIntBetween(r, 0, 2) == 0 {
+			bond.HolderRewardsPercentage = getRandomHolderRewardsPercentage(r)
+		}
+
+		// Half of the time, swappers keep a share of swap fees in the reserve
+		if functionType == types.SwapperFunction && simulation.RandIntBetween(r, 0, 2) == 0 {
+			bond.LiquidityFeePercentage = getRandomLiquidityFeePercentage(r)
+		}
+
+		// Half of the time, a share of referred buys' fees goes to referrers
+		if simulation.RandIntBetween(r, 0, 2) == 0 {
+			bond.ReferralFeePercentage = getRandomReferralFeePercentage(r)
+		}
+
+		// Half of the time, the exit fee decreases over a holding period
+		if simulation.RandIntBetween(r, 0, 2) == 0 {
+			bond.MinExitFeePercentage, bond.ExitFeeHoldingPeriod =
+				getRandomHoldingPeriodExitFee(r, bond.ExitFeePercentage)
+		}
+
 		bonds = append(bonds, bond)
 		batches = append(batches, batch)
 		incrementBondCount()
@@ -114,7 +267,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 		}
 	}
 
-	bondsGenesis := types.NewGenesisState(bonds, batches)
+	bondsGenesis := types.NewGenesisState(bonds, batches, nil, nil, nil, nil, nil, nil, nil, nil, params)
 
 	fmt.Printf("Selected randomly generated bonds genesis state:\n%s\n", codec.MustMarshalJSONIndent(simState.Cdc, bondsGenesis))
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(bondsGenesis)
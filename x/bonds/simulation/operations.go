@@ -18,17 +18,51 @@ import (
 
 // Simulation operation weights constants
 const (
-	OpWeightMsgCreateBond = "op_weight_msg_create_bond"
-	OpWeightMsgEditBond   = "op_weight_msg_edit_bond"
-	OpWeightMsgBuy        = "op_weight_msg_buy"
-	OpWeightMsgSell       = "op_weight_msg_sell"
-	OpWeightMsgSwap       = "op_weight_msg_swap"
-
-	DefaultWeightMsgCreateBond = 5
-	DefaultWeightMsgEditBond   = 5
-	DefaultWeightMsgBuy        = 100
-	DefaultWeightMsgSell       = 100
-	DefaultWeightMsgSwap       = 100
+	OpWeightMsgCreateBond           = "op_weight_msg_create_bond"
+	OpWeightMsgEditBond             = "op_weight_msg_edit_bond"
+	OpWeightMsgCloseBond            = "op_weight_msg_close_bond"
+	OpWeightMsgSetBondPaused        = "op_weight_msg_set_bond_paused"
+	OpWeightMsgSetCircuitBreaker    = "op_weight_msg_set_circuit_breaker"
+	OpWeightMsgUpdateBondRole       = "op_weight_msg_update_bond_role"
+	OpWeightMsgSetFeeRecipients     = "op_weight_msg_set_fee_recipients"
+	OpWeightMsgSetFeeSchedule       = "op_weight_msg_set_fee_schedule"
+	OpWeightMsgClaimBondRewards     = "op_weight_msg_claim_bond_rewards"
+	OpWeightMsgSetTap               = "op_weight_msg_set_tap"
+	OpWeightMsgWithdrawTap          = "op_weight_msg_withdraw_tap"
+	OpWeightMsgVoteTap              = "op_weight_msg_vote_tap"
+	OpWeightMsgRefund               = "op_weight_msg_refund"
+	OpWeightMsgSetReserveStaking    = "op_weight_msg_set_reserve_staking"
+	OpWeightMsgDelegateReserve      = "op_weight_msg_delegate_reserve"
+	OpWeightMsgUndelegateReserve    = "op_weight_msg_undelegate_reserve"
+	OpWeightMsgSweepRoundingSurplus = "op_weight_msg_sweep_rounding_surplus"
+	OpWeightMsgBuy                  = "op_weight_msg_buy"
+	OpWeightMsgSell                 = "op_weight_msg_sell"
+	OpWeightMsgSwap                 = "op_weight_msg_swap"
+
+	DefaultWeightMsgCreateBond           = 5
+	DefaultWeightMsgEditBond             = 5
+	DefaultWeightMsgCloseBond            = 2
+	DefaultWeightMsgSetBondPaused        = 2
+	DefaultWeightMsgSetCircuitBreaker    = 2
+	DefaultWeightMsgUpdateBondRole       = 2
+	DefaultWeightMsgSetFeeRecipients     = 2
+	DefaultWeightMsgSetFeeSchedule       = 2
+	DefaultWeightMsgClaimBondRewards     = 20
+	DefaultWeightMsgSetTap               = 2
+	DefaultWeightMsgWithdrawTap          = 5
+	DefaultWeightMsgVoteTap              = 5
+	DefaultWeightMsgRefund               = 20
+	DefaultWeightMsgSetReserveStaking    = 2
+	DefaultWeightMsgSweepRoundingSurplus = 5
+	DefaultWeightMsgBuy                  = 100
+	DefaultWeightMsgSell                 = 100
+	DefaultWeightMsgSwap                 = 100
+
+	// Reserve delegations are disabled by default, since the staking module's
+	// undelegate and redelegate operations expect every delegator to be one
+	// of the simulation accounts, which reserve addresses are not
+	DefaultWeightMsgDelegateReserve   = 0
+	DefaultWeightMsgUndelegateReserve = 0
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
@@ -51,6 +85,111 @@ func WeightedOperations(
 		},
 	)
 
+	var weightMsgCloseBond int
+	appParams.GetOrGenerate(cdc, OpWeightMsgCloseBond, &weightMsgCloseBond, nil,
+		func(_ *rand.Rand) {
+			weightMsgCloseBond = DefaultWeightMsgCloseBond
+		},
+	)
+
+	var weightMsgSetBondPaused int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSetBondPaused, &weightMsgSetBondPaused, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetBondPaused = DefaultWeightMsgSetBondPaused
+		},
+	)
+
+	var weightMsgSetCircuitBreaker int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSetCircuitBreaker, &weightMsgSetCircuitBreaker, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetCircuitBreaker = DefaultWeightMsgSetCircuitBreaker
+		},
+	)
+
+	var weightMsgUpdateBondRole int
+	appParams.GetOrGenerate(cdc, OpWeightMsgUpdateBondRole, &weightMsgUpdateBondRole, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpdateBondRole = DefaultWeightMsgUpdateBondRole
+		},
+	)
+
+	var weightMsgSetFeeRecipients int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSetFeeRecipients, &weightMsgSetFeeRecipients, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetFeeRecipients = DefaultWeightMsgSetFeeRecipients
+		},
+	)
+
+	var weightMsgSetFeeSchedule int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSetFeeSchedule, &weightMsgSetFeeSchedule, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetFeeSchedule = DefaultWeightMsgSetFeeSchedule
+		},
+	)
+
+	var weightMsgClaimBondRewards int
+	appParams.GetOrGenerate(cdc, OpWeightMsgClaimBondRewards, &weightMsgClaimBondRewards, nil,
+		func(_ *rand.Rand) {
+			weightMsgClaimBondRewards = DefaultWeightMsgClaimBondRewards
+		},
+	)
+
+	var weightMsgSetTap int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSetTap, &weightMsgSetTap, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetTap = DefaultWeightMsgSetTap
+		},
+	)
+
+	var weightMsgWithdrawTap int
+	appParams.GetOrGenerate(cdc, OpWeightMsgWithdrawTap, &weightMsgWithdrawTap, nil,
+		func(_ *rand.Rand) {
+			weightMsgWithdrawTap = DefaultWeightMsgWithdrawTap
+		},
+	)
+
+	var weightMsgVoteTap int
+	appParams.GetOrGenerate(cdc, OpWeightMsgVoteTap, &weightMsgVoteTap, nil,
+		func(_ *rand.Rand) {
+			weightMsgVoteTap = DefaultWeightMsgVoteTap
+		},
+	)
+
+	var weightMsgRefund int
+	appParams.GetOrGenerate(cdc, OpWeightMsgRefund, &weightMsgRefund, nil,
+		func(_ *rand.Rand) {
+			weightMsgRefund = DefaultWeightMsgRefund
+		},
+	)
+
+	var weightMsgSetReserveStaking int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSetReserveStaking, &weightMsgSetReserveStaking, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetReserveStaking = DefaultWeightMsgSetReserveStaking
+		},
+	)
+
+	var weightMsgDelegateReserve int
+	appParams.GetOrGenerate(cdc, OpWeightMsgDelegateReserve, &weightMsgDelegateReserve, nil,
+		func(_ *rand.Rand) {
+			weightMsgDelegateReserve = DefaultWeightMsgDelegateReserve
+		},
+	)
+
+	var weightMsgUndelegateReserve int
+	appParams.GetOrGenerate(cdc, OpWeightMsgUndelegateReserve, &weightMsgUndelegateReserve, nil,
+		func(_ *rand.Rand) {
+			weightMsgUndelegateReserve = DefaultWeightMsgUndelegateReserve
+		},
+	)
+
+	var weightMsgSweepRoundingSurplus int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSweepRoundingSurplus, &weightMsgSweepRoundingSurplus, nil,
+		func(_ *rand.Rand) {
+			weightMsgSweepRoundingSurplus = DefaultWeightMsgSweepRoundingSurplus
+		},
+	)
+
 	var weightMsgBuy int
 	appParams.GetOrGenerate(cdc, OpWeightMsgBuy, &weightMsgBuy, nil,
 		func(_ *rand.Rand) {
@@ -72,89 +211,1032 @@ func WeightedOperations(
 		},
 	)
 
-	return simulation.WeightedOperations{
-		simulation.NewWeightedOperation(
-			weightMsgCreateBond,
-			SimulateMsgCreateBond(ak),
-		),
-		simulation.NewWeightedOperation(
-			weightMsgEditBond,
-			SimulateMsgEditBond(ak, k),
-		),
-		simulation.NewWeightedOperation(
-			weightMsgBuy,
-			SimulateMsgBuy(ak, k),
-		),
-		simulation.NewWeightedOperation(
-			weightMsgSell,
-			SimulateMsgSell(ak, k),
-		),
-		simulation.NewWeightedOperation(
-			weightMsgSwap,
-			SimulateMsgSwap(ak, k),
-		),
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgCreateBond,
+			SimulateMsgCreateBond(ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgEditBond,
+			SimulateMsgEditBond(ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgCloseBond,
+			SimulateMsgCloseBond(ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSetBondPaused,
+			SimulateMsgSetBondPaused(ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSetCircuitBreaker,
+			SimulateMsgSetCircuitBreaker(ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgUpdateBondRole,
+			SimulateMsgUpdateBondRole(ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSetFeeRecipients,
+			SimulateMsgSetFeeRecipients(ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSetFeeSchedule,
+			SimulateMsgSetFeeSchedule(ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgClaimBondRewards,
+			SimulateMsgClaimBondRewards(ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSetTap,
+			SimulateMsgSetTap(ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgWithdrawTap,
+			SimulateMsgWithdrawTap(ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgVoteTap,
+			SimulateMsgVoteTap(ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRefund,
+			SimulateMsgRefund(ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSetReserveStaking,
+			SimulateMsgSetReserveStaking(ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgDelegateReserve,
+			SimulateMsgDelegateReserve(ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgUndelegateReserve,
+			SimulateMsgUndelegateReserve(ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSweepRoundingSurplus,
+			SimulateMsgSweepRoundingSurplus(ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgBuy,
+			SimulateMsgBuy(ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSell,
+			SimulateMsgSell(ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSwap,
+			SimulateMsgSwap(ak, k),
+		),
+	}
+}
+
+func SimulateMsgCreateBond(ak auth.AccountKeeper, k keeper.Keeper) simulation.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account, chainID string) (opMsg simulation.OperationMsg, fOpt []simulation.FutureOperation, err error) {
+
+		if totalBondCount >= maxBondCount {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		// Batch blocks range might be invalid due to param changes
+		params := k.GetParams(ctx)
+		if params.MaxBatchBlocks.LT(params.MinBatchBlocks) {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		// Get accounts that can afford the bond creation fee and deposit
+		var filteredAccs []simulation.Account
+		feeAndDeposit := params.BondCreationFee.Add(params.BondCreationDeposit)
+		for _, a := range accs {
+			coins := ak.GetAccount(ctx, a.Address).SpendableCoins(ctx.BlockTime())
+			if coins.IsAllGTE(feeAndDeposit) {
+				filteredAccs = append(filteredAccs, a)
+			}
+		}
+
+		if len(filteredAccs) == 0 {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		simAccount, _ := simulation.RandomAcc(r, filteredAccs)
+		address := simAccount.Address
+		account := ak.GetAccount(ctx, address)
+
+		token := getNextBondName()
+		name := getRandomNonEmptyString(r)
+		desc := getRandomNonEmptyString(r)
+
+		creator := address
+		signers := []sdk.AccAddress{creator}
+
+		var functionType string
+		var reserveTokens []string
+		randFunctionType := simulation.RandIntBetween(r, 0, 3)
+		if randFunctionType == 0 {
+			functionType = types.PowerFunction
+			reserveTokens = defaultReserveTokens
+		} else if randFunctionType == 1 {
+			functionType = types.SigmoidFunction
+			reserveTokens = defaultReserveTokens
+		} else if randFunctionType == 2 {
+			functionType = types.SwapperFunction
+			reserveToken1, ok1 := getRandomBondName(r)
+			reserveToken2, ok2 := getRandomBondNameExcept(r, reserveToken1)
+			if !ok1 || !ok2 {
+				return simulation.NoOpMsg(types.ModuleName), nil, nil
+			}
+			reserveTokens = []string{reserveToken1, reserveToken2}
+		} else {
+			panic("unexpected randFunctionType")
+		}
+		functionParameters := getRandomFunctionParameters(r, functionType)
+
+		// Fees are within the params' maximums and sum to at most 100
+		txFeePercentage, exitFeePercentage := getRandomFeePercentages(r, params)
+
+		// Addresses
+		feeAddress := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+		// Half of the time, fees are split among multiple recipients
+		var feeRecipients types.FeeRecipients
+		if simulation.RandIntBetween(r, 0, 2) == 0 {
+			feeRecipients = getRandomFeeRecipients(r, feeAddress)
+		}
+
+		// Half of the time, a share of fees is paid out to token holders
+		holderRewardsPercentage := sdk.ZeroDec()
+		if simulation.RandIntBetween(r, 0, 2) == 0 {
+			holderRewardsPercentage = getRandomHolderRewardsPercentage(r)
+		}
+
+		// Half of the time, swappers keep a share of swap fees in the reserve
+		liquidityFeePercentage := sdk.ZeroDec()
+		if functionType == types.SwapperFunction && simulation.RandIntBetween(r, 0, 2) == 0 {
+			liquidityFeePercentage = getRandomLiquidityFeePercentage(r)
+		}
+
+		// Half of the time, a share of referred buys' fees goes to referrers
+		referralFeePercentage := sdk.ZeroDec()
+		if simulation.RandIntBetween(r, 0, 2) == 0 {
+			referralFeePercentage = getRandomReferralFeePercentage(r)
+		}
+
+		// Half of the time, the exit fee decreases over a holding period
+		var minExitFeePercentage sdk.Dec
+		exitFeeHoldingPeriod := sdk.ZeroUint()
+		if simulation.RandIntBetween(r, 0, 2) == 0 {
+			minExitFeePercentage, exitFeeHoldingPeriod =
+				getRandomHoldingPeriodExitFee(r, exitFeePercentage)
+		}
+
+		// Max supply, allow sells, batch blocks
+		maxSupply := sdk.NewCoin(token, sdk.NewInt(int64(
+			simulation.RandIntBetween(r, 1000000, 1000000000))))
+		allowSells := getRandomAllowSellsValue(r)
+		batchBlocks := getRandomBatchBlocks(r, params)
+
+		msg := types.NewMsgCreateBond(token, name, desc, creator, functionType,
+			functionParameters, reserveTokens, txFeePercentage,
+			exitFeePercentage, feeAddress, feeRecipients, holderRewardsPercentage,
+			liquidityFeePercentage, referralFeePercentage, minExitFeePercentage, exitFeeHoldingPeriod,
+			maxSupply, blankOrderQuantityLimits, blankSanityRate, blankSanityMarginPercentage, allowSells, signers, batchBlocks)
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil,
+				fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			sdk.Coins{},
+			gas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+
+		res := app.Deliver(tx)
+		if !res.IsOK() {
+			return simulation.NoOpMsg(types.ModuleName), nil, errors.New(res.Log)
+		}
+
+		incrementBondCount() // since successfully created
+		if msg.FunctionType == types.SwapperFunction {
+			newSwapperBond(msg.Token)
+		}
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+func SimulateMsgEditBond(ak auth.AccountKeeper, k keeper.Keeper) simulation.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account, chainID string) (opMsg simulation.OperationMsg, fOpt []simulation.FutureOperation, err error) {
+
+		// Get random bond
+		token, ok := getRandomBondName(r)
+		if !ok {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		bond, found := k.GetBond(ctx, token)
+		if !found {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		name := getRandomNonEmptyString(r)
+		desc := getRandomNonEmptyString(r)
+
+		simAccount, _ := simulation.FindAccount(accs, bond.Creator)
+		address := simAccount.Address
+		account := ak.GetAccount(ctx, address)
+
+		editor := address
+		signers := []sdk.AccAddress{editor}
+		if !bond.RoleAuthorizes(types.RoleMetadataEditor, signers) {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		msg := types.NewMsgEditBond(token, name, desc,
+			types.DoNotModifyField, types.DoNotModifyField,
+			types.DoNotModifyField, editor, signers)
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil,
+				fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			sdk.Coins{},
+			gas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+
+		res := app.Deliver(tx)
+		if !res.IsOK() {
+			return simulation.NoOpMsg(types.ModuleName), nil, errors.New(res.Log)
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+func SimulateMsgCloseBond(ak auth.AccountKeeper, k keeper.Keeper) simulation.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account, chainID string) (opMsg simulation.OperationMsg, fOpt []simulation.FutureOperation, err error) {
+
+		// Get random bond that has no supply and no pending orders
+		token, ok := getRandomBondName(r)
+		if !ok {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		bond, found := k.GetBond(ctx, token)
+		if !found || !bond.CurrentSupply.IsZero() ||
+			k.MustGetBatch(ctx, token).NumberOfOrders() != 0 {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		// Bond also cannot have any staked reserve or queued sells
+		if bond.HasReserveStaking() && (bond.Staking.HasQueuedSells() ||
+			k.GetStakedReserve(ctx, token).IsPositive()) {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		simAccount, _ := simulation.FindAccount(accs, bond.Creator)
+		address := simAccount.Address
+		account := ak.GetAccount(ctx, address)
+
+		closer := address
+		signers := []sdk.AccAddress{closer}
+		if !bond.RoleAuthorizes(types.RoleAdmin, signers) {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		msg := types.NewMsgCloseBond(token, closer, signers)
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil,
+				fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			sdk.Coins{},
+			gas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+
+		res := app.Deliver(tx)
+		if !res.IsOK() {
+			return simulation.NoOpMsg(types.ModuleName), nil, errors.New(res.Log)
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+func SimulateMsgSetBondPaused(ak auth.AccountKeeper, k keeper.Keeper) simulation.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account, chainID string) (opMsg simulation.OperationMsg, fOpt []simulation.FutureOperation, err error) {
+
+		// Get random bond
+		token, ok := getRandomBondName(r)
+		if !ok {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		bond, found := k.GetBond(ctx, token)
+		if !found {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		// Toggle the bond's paused state
+		paused := types.TRUE
+		if bond.IsPaused() {
+			paused = types.FALSE
+		}
+
+		simAccount, _ := simulation.FindAccount(accs, bond.Creator)
+		address := simAccount.Address
+		account := ak.GetAccount(ctx, address)
+
+		editor := address
+		signers := []sdk.AccAddress{editor}
+		if !bond.RoleAuthorizes(types.RolePauser, signers) {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		msg := types.NewMsgSetBondPaused(token, paused, editor, signers)
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil,
+				fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			sdk.Coins{},
+			gas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+
+		res := app.Deliver(tx)
+		if !res.IsOK() {
+			return simulation.NoOpMsg(types.ModuleName), nil, errors.New(res.Log)
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+func SimulateMsgSetCircuitBreaker(ak auth.AccountKeeper, k keeper.Keeper) simulation.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account, chainID string) (opMsg simulation.OperationMsg, fOpt []simulation.FutureOperation, err error) {
+
+		// Get random bond
+		token, ok := getRandomBondName(r)
+		if !ok {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		bond, found := k.GetBond(ctx, token)
+		if !found {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		maxPriceMove, mode, cooldown := getRandomCircuitBreakerValues(r)
+
+		simAccount, _ := simulation.FindAccount(accs, bond.Creator)
+		address := simAccount.Address
+		account := ak.GetAccount(ctx, address)
+
+		editor := address
+		signers := []sdk.AccAddress{editor}
+		if !bond.RoleAuthorizes(types.RolePauser, signers) {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		msg := types.NewMsgSetCircuitBreaker(token, maxPriceMove, mode, cooldown, editor, signers)
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil,
+				fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			sdk.Coins{},
+			gas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+
+		res := app.Deliver(tx)
+		if !res.IsOK() {
+			return simulation.NoOpMsg(types.ModuleName), nil, errors.New(res.Log)
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+func SimulateMsgUpdateBondRole(ak auth.AccountKeeper, k keeper.Keeper) simulation.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account, chainID string) (opMsg simulation.OperationMsg, fOpt []simulation.FutureOperation, err error) {
+
+		// Get random bond
+		token, ok := getRandomBondName(r)
+		if !ok {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		bond, found := k.GetBond(ctx, token)
+		if !found {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		simAccount, _ := simulation.FindAccount(accs, bond.Creator)
+		address := simAccount.Address
+		account := ak.GetAccount(ctx, address)
+
+		editor := address
+		signers := []sdk.AccAddress{editor}
+		if !bond.RoleAuthorizes(types.RoleAdmin, signers) {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		// Share a random non-admin role between the creator and another
+		// account, so that the creator can still act in that role
+		role, addresses, threshold := getRandomRoleValues(r, accs, bond.Creator)
+
+		msg := types.NewMsgUpdateBondRole(token, role, addresses, threshold, editor, signers)
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil,
+				fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			sdk.Coins{},
+			gas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+
+		res := app.Deliver(tx)
+		if !res.IsOK() {
+			return simulation.NoOpMsg(types.ModuleName), nil, errors.New(res.Log)
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+func getBuyIntoSwapper(r *rand.Rand, ctx sdk.Context, k keeper.Keeper,
+	bond types.Bond, account exported.Account) (msg types.MsgBuy, err error, ok bool) {
+	address := account.GetAddress()
+	spendable := account.SpendableCoins(ctx.BlockTime())
+
+	// Come up with max prices based on what is spendable
+	spendableReserve1 := spendable.AmountOf(bond.ReserveTokens[0])
+	spendableReserve2 := spendable.AmountOf(bond.ReserveTokens[1])
+	maxPriceInt1, err := simulation.RandPositiveInt(r, spendableReserve1)
+	if err != nil {
+		return types.MsgBuy{}, err, false
+	}
+	maxPriceInt2, err := simulation.RandPositiveInt(r, spendableReserve2)
+	if err != nil {
+		return types.MsgBuy{}, err, false
+	}
+	maxPrices := sdk.NewCoins(
+		sdk.NewCoin(bond.ReserveTokens[0], maxPriceInt1),
+		sdk.NewCoin(bond.ReserveTokens[1], maxPriceInt2),
+	)
+
+	// Get lesser of max possible increase in supply and max order quantity
+	var maxBuyAmount sdk.Int
+	maxIncreaseInSupply := bond.MaxSupply.Sub(bond.CurrentSupply).Amount
+	maxOrderQuantity := bond.OrderQuantityLimits.AmountOf(bond.Token)
+	if maxOrderQuantity.IsZero() {
+		maxBuyAmount = maxIncreaseInSupply
+	} else {
+		maxBuyAmount = sdk.MinInt(maxIncreaseInSupply, maxOrderQuantity)
+	}
+
+	if maxBuyAmount.IsZero() {
+		return types.MsgBuy{}, nil, false
+	}
+
+	toBuyInt, err := simulation.RandPositiveInt(r, maxBuyAmount)
+	if err != nil {
+		return types.MsgBuy{}, err, false
+	}
+	amountToBuy := sdk.NewCoin(bond.Token, toBuyInt)
+
+	// If not the first buy, create order and check if can afford
+	referrer := getRandomReferrer(r)
+	if bond.CurrentSupply.IsPositive() {
+		_, _, err = k.GetUpdatedBatchPricesAfterBuy(ctx, bond.Token,
+			types.NewBuyOrder(address, amountToBuy, maxPrices, referrer))
+		if err != nil {
+			return types.MsgBuy{}, err, true
+		}
+	}
+
+	return types.NewMsgBuy(address, amountToBuy, maxPrices, referrer), nil, true
+}
+
+func getBuyIntoPowerOrSigmoid(r *rand.Rand, ctx sdk.Context, k keeper.Keeper,
+	bond types.Bond, account exported.Account) (msg types.MsgBuy, err error, ok bool) {
+	address := account.GetAddress()
+	spendable := account.SpendableCoins(ctx.BlockTime())
+
+	// Come up with max price based on what is spendable
+	spendableReserve := spendable.AmountOf(bond.ReserveTokens[0])
+	maxPriceInt, err := simulation.RandPositiveInt(r, spendableReserve)
+	if err != nil {
+		return types.MsgBuy{}, err, false
+	}
+	maxPrices := sdk.Coins{sdk.NewCoin(bond.ReserveTokens[0], maxPriceInt)}
+
+	// Get lesser of max possible increase in supply and max order quantity
+	var maxBuyAmount sdk.Int
+	maxIncreaseInSupply := bond.MaxSupply.Sub(bond.CurrentSupply).Amount
+	maxOrderQuantity := bond.OrderQuantityLimits.AmountOf(bond.Token)
+	if maxOrderQuantity.IsZero() {
+		maxBuyAmount = maxIncreaseInSupply
+	} else {
+		maxBuyAmount = sdk.MinInt(maxIncreaseInSupply, maxOrderQuantity)
+	}
+
+	if maxBuyAmount.IsZero() {
+		return types.MsgBuy{}, nil, false
+	}
+
+	toBuyInt, err := simulation.RandPositiveInt(r, maxBuyAmount)
+	if err != nil {
+		return types.MsgBuy{}, err, false
+	}
+	amountToBuy := sdk.NewCoin(bond.Token, toBuyInt)
+
+	// Create order and check if can afford
+	referrer := getRandomReferrer(r)
+	_, _, err = k.GetUpdatedBatchPricesAfterBuy(ctx, bond.Token,
+		types.NewBuyOrder(address, amountToBuy, maxPrices, referrer))
+	if err != nil {
+		return types.MsgBuy{}, err, true
+	}
+
+	return types.NewMsgBuy(address, amountToBuy, maxPrices, referrer), nil, true
+}
+
+func SimulateMsgSetFeeRecipients(ak auth.AccountKeeper, k keeper.Keeper) simulation.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account, chainID string) (opMsg simulation.OperationMsg, fOpt []simulation.FutureOperation, err error) {
+
+		// Get random bond
+		token, ok := getRandomBondName(r)
+		if !ok {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		bond, found := k.GetBond(ctx, token)
+		if !found {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		simAccount, _ := simulation.FindAccount(accs, bond.Creator)
+		address := simAccount.Address
+		account := ak.GetAccount(ctx, address)
+
+		editor := address
+		signers := []sdk.AccAddress{editor}
+		if !bond.RoleAuthorizes(types.RoleFeeManager, signers) {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		// Keep the fee address, but split fees among new recipients
+		feeRecipients := getRandomFeeRecipients(r, bond.FeeAddress)
+
+		msg := types.NewMsgSetFeeRecipients(token, feeRecipients,
+			bond.FeeAddress, editor, signers)
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil,
+				fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			sdk.Coins{},
+			gas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+
+		res := app.Deliver(tx)
+		if !res.IsOK() {
+			return simulation.NoOpMsg(types.ModuleName), nil, errors.New(res.Log)
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+func SimulateMsgSetFeeSchedule(ak auth.AccountKeeper, k keeper.Keeper) simulation.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account, chainID string) (opMsg simulation.OperationMsg, fOpt []simulation.FutureOperation, err error) {
+
+		// Get random bond
+		token, ok := getRandomBondName(r)
+		if !ok {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		bond, found := k.GetBond(ctx, token)
+		if !found {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		simAccount, _ := simulation.FindAccount(accs, bond.Creator)
+		address := simAccount.Address
+		account := ak.GetAccount(ctx, address)
+
+		editor := address
+		signers := []sdk.AccAddress{editor}
+		if !bond.RoleAuthorizes(types.RoleFeeManager, signers) {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		// Skip if the schedule would push the bond's fees above the maximums
+		feeSchedule := getRandomFeeSchedule(r)
+		params := k.GetParams(ctx)
+		maxMultiplier := feeSchedule.GetMaxMultiplier()
+		maxTxFee := bond.TxFeePercentage.Mul(maxMultiplier)
+		maxExitFee := bond.ExitFeePercentage.Mul(maxMultiplier)
+		if maxTxFee.GT(params.MaxTxFeePercentage) ||
+			maxExitFee.GT(params.MaxExitFeePercentage) ||
+			maxTxFee.Add(maxExitFee).GTE(sdk.NewDec(100)) {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		msg := types.NewMsgSetFeeSchedule(token, feeSchedule, editor, signers)
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil,
+				fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			sdk.Coins{},
+			gas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+
+		res := app.Deliver(tx)
+		if !res.IsOK() {
+			return simulation.NoOpMsg(types.ModuleName), nil, errors.New(res.Log)
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+func SimulateMsgClaimBondRewards(ak auth.AccountKeeper, k keeper.Keeper) simulation.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account, chainID string) (opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		// Get random bond
+		token, ok := getRandomBondName(r)
+		if !ok {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		bond, found := k.GetBond(ctx, token)
+		if !found || !bond.HasHolderRewards() {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		// Get accounts that have rewards to claim
+		var filteredAccs []simulation.Account
+		for _, a := range accs {
+			if !k.GetClaimableRewards(ctx, bond.Token, a.Address).IsZero() {
+				filteredAccs = append(filteredAccs, a)
+			}
+		}
+
+		if len(filteredAccs) == 0 {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		simAccount, _ := simulation.RandomAcc(r, filteredAccs)
+		address := simAccount.Address
+		account := ak.GetAccount(ctx, address)
+
+		msg := types.NewMsgClaimBondRewards(token, address)
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			sdk.Coins{},
+			gas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+
+		res := app.Deliver(tx)
+		if !res.IsOK() {
+			return simulation.NoOpMsg(types.ModuleName), nil, errors.New(res.Log)
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+func SimulateMsgSetTap(ak auth.AccountKeeper, k keeper.Keeper) simulation.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account, chainID string) (opMsg simulation.OperationMsg, fOpt []simulation.FutureOperation, err error) {
+
+		// Get random bond
+		token, ok := getRandomBondName(r)
+		if !ok {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		bond, found := k.GetBond(ctx, token)
+		if !found || bond.FunctionType == types.SwapperFunction || bond.IsDissolved() {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		simAccount, _ := simulation.FindAccount(accs, bond.Creator)
+		address := simAccount.Address
+		account := ak.GetAccount(ctx, address)
+
+		editor := address
+		signers := []sdk.AccAddress{editor}
+		if !bond.RoleAuthorizes(types.RoleAdmin, signers) {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		// The floor cannot be lowered, so it is at least the current floor
+		minFloorPercentage := sdk.ZeroDec()
+		if bond.HasTap() {
+			minFloorPercentage = bond.Tap.FloorPercentage
+		}
+		beneficiary, rate, floorPercentage := getRandomTapValues(
+			r, accs, minFloorPercentage)
+
+		msg := types.NewMsgSetTap(token, beneficiary, rate, floorPercentage, editor, signers)
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil,
+				fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			sdk.Coins{},
+			gas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+
+		res := app.Deliver(tx)
+		if !res.IsOK() {
+			return simulation.NoOpMsg(types.ModuleName), nil, errors.New(res.Log)
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+func SimulateMsgWithdrawTap(ak auth.AccountKeeper, k keeper.Keeper) simulation.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account, chainID string) (opMsg simulation.OperationMsg, fOpt []simulation.FutureOperation, err error) {
+
+		// Get random bond
+		token, ok := getRandomBondName(r)
+		if !ok {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		bond, found := k.GetBond(ctx, token)
+		if !found || !bond.HasTap() || bond.IsDissolved() ||
+			k.GetTapAvailable(ctx, token).IsZero() {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		// The liquid reserve might not be enough if any of it is staked
+		if bond.HasReserveStaking() && k.GetStakedReserve(ctx, token).IsPositive() {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		simAccount, _ := simulation.FindAccount(accs, bond.Creator)
+		address := simAccount.Address
+		account := ak.GetAccount(ctx, address)
+
+		editor := address
+		signers := []sdk.AccAddress{editor}
+		if !bond.RoleAuthorizes(types.RoleWithdrawer, signers) {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		msg := types.NewMsgWithdrawTap(token, editor, signers)
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil,
+				fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			sdk.Coins{},
+			gas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+
+		res := app.Deliver(tx)
+		if !res.IsOK() {
+			return simulation.NoOpMsg(types.ModuleName), nil, errors.New(res.Log)
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+func SimulateMsgVoteTap(ak auth.AccountKeeper, k keeper.Keeper) simulation.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account, chainID string) (opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		// Get random bond
+		token, ok := getRandomBondName(r)
+		if !ok {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		bond, found := k.GetBond(ctx, token)
+		if !found || !bond.HasTap() || bond.IsDissolved() {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		// Get accounts that hold the bond's tokens
+		var filteredAccs []simulation.Account
+		for _, a := range accs {
+			coins := ak.GetAccount(ctx, a.Address).GetCoins()
+			if coins.AmountOf(bond.Token).IsPositive() {
+				filteredAccs = append(filteredAccs, a)
+			}
+		}
+
+		if len(filteredAccs) == 0 {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		// Votes to raise the tap require a proposed rate
+		option := getRandomTapVoteOption(r)
+		if option == types.TapVoteRaise && !bond.Tap.HasProposedRate() {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		simAccount, _ := simulation.RandomAcc(r, filteredAccs)
+		address := simAccount.Address
+		account := ak.GetAccount(ctx, address)
+
+		msg := types.NewMsgVoteTap(token, option, address)
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			sdk.Coins{},
+			gas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+
+		res := app.Deliver(tx)
+		if !res.IsOK() {
+			return simulation.NoOpMsg(types.ModuleName), nil, errors.New(res.Log)
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+func SimulateMsgRefund(ak auth.AccountKeeper, k keeper.Keeper) simulation.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account, chainID string) (opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		// Get random bond
+		token, ok := getRandomBondName(r)
+		if !ok {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		bond, found := k.GetBond(ctx, token)
+		if !found || !bond.IsDissolved() {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		// The liquid reserve might not be enough until the reserve unbonds
+		if bond.HasReserveStaking() && k.GetStakedReserve(ctx, token).IsPositive() {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		// Get accounts that have the token to be refunded
+		var filteredAccs []simulation.Account
+		for _, a := range accs {
+			coins := ak.GetAccount(ctx, a.Address).SpendableCoins(ctx.BlockTime())
+			if coins.AmountOf(bond.Token).IsPositive() {
+				filteredAccs = append(filteredAccs, a)
+			}
+		}
+
+		if len(filteredAccs) == 0 {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		simAccount, _ := simulation.RandomAcc(r, filteredAccs)
+		address := simAccount.Address
+		account := ak.GetAccount(ctx, address)
+		amount := account.SpendableCoins(ctx.BlockTime()).AmountOf(bond.Token)
+
+		toRefundInt, err := simulation.RandPositiveInt(r, amount)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+		amountToRefund := sdk.NewCoin(bond.Token, toRefundInt)
+
+		msg := types.NewMsgRefund(address, amountToRefund)
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			sdk.Coins{},
+			gas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+
+		res := app.Deliver(tx)
+		if !res.IsOK() {
+			return simulation.NoOpMsg(types.ModuleName), nil, errors.New(res.Log)
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
 	}
 }
 
-func SimulateMsgCreateBond(ak auth.AccountKeeper) simulation.Operation {
+func SimulateMsgSetReserveStaking(ak auth.AccountKeeper, k keeper.Keeper) simulation.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
 		accs []simulation.Account, chainID string) (opMsg simulation.OperationMsg, fOpt []simulation.FutureOperation, err error) {
 
-		if totalBondCount >= maxBondCount {
+		// Get random bond with only the staking denom as its reserve token
+		token, ok := getRandomBondName(r)
+		if !ok {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		bond, found := k.GetBond(ctx, token)
+		stakingDenom := k.StakingKeeper.BondDenom(ctx)
+		if !found || bond.IsDissolved() || len(bond.ReserveTokens) != 1 ||
+			bond.ReserveTokens[0] != stakingDenom {
 			return simulation.NoOpMsg(types.ModuleName), nil, nil
 		}
 
-		simAccount, _ := simulation.RandomAcc(r, accs)
+		simAccount, _ := simulation.FindAccount(accs, bond.Creator)
 		address := simAccount.Address
 		account := ak.GetAccount(ctx, address)
 
-		token := getNextBondName()
-		name := getRandomNonEmptyString(r)
-		desc := getRandomNonEmptyString(r)
-
-		creator := address
-		signers := []sdk.AccAddress{creator}
-
-		var functionType string
-		var reserveTokens []string
-		randFunctionType := simulation.RandIntBetween(r, 0, 3)
-		if randFunctionType == 0 {
-			functionType = types.PowerFunction
-			reserveTokens = defaultReserveTokens
-		} else if randFunctionType == 1 {
-			functionType = types.SigmoidFunction
-			reserveTokens = defaultReserveTokens
-		} else if randFunctionType == 2 {
-			functionType = types.SwapperFunction
-			reserveToken1, ok1 := getRandomBondName(r)
-			reserveToken2, ok2 := getRandomBondNameExcept(r, reserveToken1)
-			if !ok1 || !ok2 {
-				return simulation.NoOpMsg(types.ModuleName), nil, nil
-			}
-			reserveTokens = []string{reserveToken1, reserveToken2}
-		} else {
-			panic("unexpected randFunctionType")
+		editor := address
+		signers := []sdk.AccAddress{editor}
+		if !bond.RoleAuthorizes(types.RoleAdmin, signers) {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
 		}
-		functionParameters := getRandomFunctionParameters(r, functionType)
-
-		// Max fee is 100, so exit fee uses 100-txFee as max
-		txFeePercentage := simulation.RandomDecAmount(r, sdk.NewDec(100))
-		exitFeePercentage := simulation.RandomDecAmount(r, sdk.NewDec(100).Sub(txFeePercentage))
-
-		// Addresses
-		feeAddress := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
 
-		// Max supply, allow sells, batch blocks
-		maxSupply := sdk.NewCoin(token, sdk.NewInt(int64(
-			simulation.RandIntBetween(r, 1000000, 1000000000))))
-		allowSells := getRandomAllowSellsValue(r)
-		batchBlocks := sdk.NewUint(uint64(
-			simulation.RandIntBetween(r, 1, 10)))
+		capPercentage, rewardsToReserve := getRandomReserveStakingValues(r)
 
-		msg := types.NewMsgCreateBond(token, name, desc, creator, functionType,
-			functionParameters, reserveTokens, txFeePercentage,
-			exitFeePercentage, feeAddress, maxSupply, blankOrderQuantityLimits,
-			blankSanityRate, blankSanityMarginPercentage, allowSells, signers, batchBlocks)
+		msg := types.NewMsgSetReserveStaking(token, capPercentage, rewardsToReserve, editor, signers)
 		if msg.ValidateBasic() != nil {
 			return simulation.NoOpMsg(types.ModuleName), nil,
 				fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
@@ -175,30 +1257,47 @@ func SimulateMsgCreateBond(ak auth.AccountKeeper) simulation.Operation {
 			return simulation.NoOpMsg(types.ModuleName), nil, errors.New(res.Log)
 		}
 
-		incrementBondCount() // since successfully created
-		if msg.FunctionType == types.SwapperFunction {
-			newSwapperBond(msg.Token)
-		}
 		return simulation.NewOperationMsg(msg, true, ""), nil, nil
 	}
 }
 
-func SimulateMsgEditBond(ak auth.AccountKeeper, k keeper.Keeper) simulation.Operation {
+func SimulateMsgDelegateReserve(ak auth.AccountKeeper, k keeper.Keeper) simulation.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
 		accs []simulation.Account, chainID string) (opMsg simulation.OperationMsg, fOpt []simulation.FutureOperation, err error) {
 
-		// Get random bond
+		// Get random bond with reserve staking and no queued sells
 		token, ok := getRandomBondName(r)
 		if !ok {
 			return simulation.NoOpMsg(types.ModuleName), nil, nil
 		}
 		bond, found := k.GetBond(ctx, token)
-		if !found {
+		if !found || !bond.HasReserveStaking() || bond.IsDissolved() ||
+			bond.Staking.HasQueuedSells() {
 			return simulation.NoOpMsg(types.ModuleName), nil, nil
 		}
 
-		name := getRandomNonEmptyString(r)
-		desc := getRandomNonEmptyString(r)
+		// Get random validator that can be delegated to
+		validators := k.StakingKeeper.GetAllValidators(ctx)
+		if len(validators) == 0 {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		validator := validators[simulation.RandIntBetween(r, 0, len(validators))]
+		if validator.InvalidExRate() {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		// Amount cannot exceed the liquid reserve or what is left of the cap
+		staked := k.GetStakedReserve(ctx, token)
+		maxAmount := sdk.MinInt(k.GetLiquidReserve(ctx, token),
+			bond.GetStakingCap().Sub(staked))
+		if !maxAmount.IsPositive() {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		amountInt, err := simulation.RandPositiveInt(r, maxAmount)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+		amount := sdk.NewCoin(bond.ReserveTokens[0], amountInt)
 
 		simAccount, _ := simulation.FindAccount(accs, bond.Creator)
 		address := simAccount.Address
@@ -206,10 +1305,11 @@ func SimulateMsgEditBond(ak auth.AccountKeeper, k keeper.Keeper) simulation.Oper
 
 		editor := address
 		signers := []sdk.AccAddress{editor}
+		if !bond.RoleAuthorizes(types.RoleStaker, signers) {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
 
-		msg := types.NewMsgEditBond(token, name, desc,
-			types.DoNotModifyField, types.DoNotModifyField,
-			types.DoNotModifyField, editor, signers)
+		msg := types.NewMsgDelegateReserve(token, validator.OperatorAddress, amount, editor, signers)
 		if msg.ValidateBasic() != nil {
 			return simulation.NoOpMsg(types.ModuleName), nil,
 				fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
@@ -234,100 +1334,122 @@ func SimulateMsgEditBond(ak auth.AccountKeeper, k keeper.Keeper) simulation.Oper
 	}
 }
 
-func getBuyIntoSwapper(r *rand.Rand, ctx sdk.Context, k keeper.Keeper,
-	bond types.Bond, account exported.Account) (msg types.MsgBuy, err error, ok bool) {
-	address := account.GetAddress()
-	spendable := account.SpendableCoins(ctx.BlockTime())
+func SimulateMsgUndelegateReserve(ak auth.AccountKeeper, k keeper.Keeper) simulation.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account, chainID string) (opMsg simulation.OperationMsg, fOpt []simulation.FutureOperation, err error) {
 
-	// Come up with max prices based on what is spendable
-	spendableReserve1 := spendable.AmountOf(bond.ReserveTokens[0])
-	spendableReserve2 := spendable.AmountOf(bond.ReserveTokens[1])
-	maxPriceInt1, err := simulation.RandPositiveInt(r, spendableReserve1)
-	if err != nil {
-		return types.MsgBuy{}, err, false
-	}
-	maxPriceInt2, err := simulation.RandPositiveInt(r, spendableReserve2)
-	if err != nil {
-		return types.MsgBuy{}, err, false
-	}
-	maxPrices := sdk.NewCoins(
-		sdk.NewCoin(bond.ReserveTokens[0], maxPriceInt1),
-		sdk.NewCoin(bond.ReserveTokens[1], maxPriceInt2),
-	)
+		// Get random bond with a random delegation of its reserve
+		token, ok := getRandomBondName(r)
+		if !ok {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		bond, found := k.GetBond(ctx, token)
+		if !found || !bond.HasReserveStaking() {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		delegations := k.GetReserveDelegations(ctx, token)
+		if len(delegations) == 0 {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		delegation := delegations[simulation.RandIntBetween(r, 0, len(delegations))]
+		if !delegation.Amount.IsPositive() {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
 
-	// Get lesser of max possible increase in supply and max order quantity
-	var maxBuyAmount sdk.Int
-	maxIncreaseInSupply := bond.MaxSupply.Sub(bond.CurrentSupply).Amount
-	maxOrderQuantity := bond.OrderQuantityLimits.AmountOf(bond.Token)
-	if maxOrderQuantity.IsZero() {
-		maxBuyAmount = maxIncreaseInSupply
-	} else {
-		maxBuyAmount = sdk.MinInt(maxIncreaseInSupply, maxOrderQuantity)
-	}
+		// The number of unbonding entries per validator is limited
+		if k.StakingKeeper.HasMaxUnbondingDelegationEntries(
+			ctx, bond.ReserveAddress, delegation.Validator) {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
 
-	if maxBuyAmount.IsZero() {
-		return types.MsgBuy{}, nil, false
-	}
+		amountInt, err := simulation.RandPositiveInt(r, delegation.Amount.Amount)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+		amount := sdk.NewCoin(delegation.Amount.Denom, amountInt)
 
-	toBuyInt, err := simulation.RandPositiveInt(r, maxBuyAmount)
-	if err != nil {
-		return types.MsgBuy{}, err, false
-	}
-	amountToBuy := sdk.NewCoin(bond.Token, toBuyInt)
+		simAccount, _ := simulation.FindAccount(accs, bond.Creator)
+		address := simAccount.Address
+		account := ak.GetAccount(ctx, address)
 
-	// If not the first buy, create order and check if can afford
-	if bond.CurrentSupply.IsPositive() {
-		_, _, err = k.GetUpdatedBatchPricesAfterBuy(ctx, bond.Token,
-			types.NewBuyOrder(address, amountToBuy, maxPrices))
-		if err != nil {
-			return types.MsgBuy{}, err, true
+		editor := address
+		signers := []sdk.AccAddress{editor}
+		if !bond.RoleAuthorizes(types.RoleStaker, signers) {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
 		}
-	}
 
-	return types.NewMsgBuy(address, amountToBuy, maxPrices), nil, true
-}
+		msg := types.NewMsgUndelegateReserve(token, delegation.Validator, amount, editor, signers)
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil,
+				fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
 
-func getBuyIntoPowerOrSigmoid(r *rand.Rand, ctx sdk.Context, k keeper.Keeper,
-	bond types.Bond, account exported.Account) (msg types.MsgBuy, err error, ok bool) {
-	address := account.GetAddress()
-	spendable := account.SpendableCoins(ctx.BlockTime())
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			sdk.Coins{},
+			gas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
 
-	// Come up with max price based on what is spendable
-	spendableReserve := spendable.AmountOf(bond.ReserveTokens[0])
-	maxPriceInt, err := simulation.RandPositiveInt(r, spendableReserve)
-	if err != nil {
-		return types.MsgBuy{}, err, false
-	}
-	maxPrices := sdk.Coins{sdk.NewCoin(bond.ReserveTokens[0], maxPriceInt)}
+		res := app.Deliver(tx)
+		if !res.IsOK() {
+			return simulation.NoOpMsg(types.ModuleName), nil, errors.New(res.Log)
+		}
 
-	// Get lesser of max possible increase in supply and max order quantity
-	var maxBuyAmount sdk.Int
-	maxIncreaseInSupply := bond.MaxSupply.Sub(bond.CurrentSupply).Amount
-	maxOrderQuantity := bond.OrderQuantityLimits.AmountOf(bond.Token)
-	if maxOrderQuantity.IsZero() {
-		maxBuyAmount = maxIncreaseInSupply
-	} else {
-		maxBuyAmount = sdk.MinInt(maxIncreaseInSupply, maxOrderQuantity)
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
 	}
+}
 
-	if maxBuyAmount.IsZero() {
-		return types.MsgBuy{}, nil, false
-	}
+func SimulateMsgSweepRoundingSurplus(ak auth.AccountKeeper, k keeper.Keeper) simulation.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account, chainID string) (opMsg simulation.OperationMsg, fOpt []simulation.FutureOperation, err error) {
 
-	toBuyInt, err := simulation.RandPositiveInt(r, maxBuyAmount)
-	if err != nil {
-		return types.MsgBuy{}, err, false
-	}
-	amountToBuy := sdk.NewCoin(bond.Token, toBuyInt)
+		// Get random bond
+		token, ok := getRandomBondName(r)
+		if !ok {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		bond, found := k.GetBond(ctx, token)
+		if !found || k.GetSweepableRoundingSurplus(ctx, token).IsZero() {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
 
-	// Create order and check if can afford
-	_, _, err = k.GetUpdatedBatchPricesAfterBuy(ctx, bond.Token,
-		types.NewBuyOrder(address, amountToBuy, maxPrices))
-	if err != nil {
-		return types.MsgBuy{}, err, true
-	}
+		simAccount, _ := simulation.FindAccount(accs, bond.Creator)
+		address := simAccount.Address
+		account := ak.GetAccount(ctx, address)
+
+		editor := address
+		signers := []sdk.AccAddress{editor}
+		if !bond.RoleAuthorizes(types.RoleFeeManager, signers) {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		msg := types.NewMsgSweepRoundingSurplus(token, editor, signers)
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil,
+				fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			sdk.Coins{},
+			gas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+
+		res := app.Deliver(tx)
+		if !res.IsOK() {
+			return simulation.NoOpMsg(types.ModuleName), nil, errors.New(res.Log)
+		}
 
-	return types.NewMsgBuy(address, amountToBuy, maxPrices), nil, true
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
 }
 
 func SimulateMsgBuy(ak auth.AccountKeeper, k keeper.Keeper) simulation.Operation {
@@ -340,7 +1462,8 @@ func SimulateMsgBuy(ak auth.AccountKeeper, k keeper.Keeper) simulation.Operation
 			return simulation.NoOpMsg(types.ModuleName), nil, nil
 		}
 		bond, found := k.GetBond(ctx, token)
-		if !found {
+		if !found || bond.IsDissolved() || bond.IsPaused() || bond.IsHalted() ||
+			k.BatchIsFull(ctx, token) {
 			return simulation.NoOpMsg(types.ModuleName), nil, nil
 		}
 
@@ -406,7 +1529,9 @@ func SimulateMsgSell(ak auth.AccountKeeper, k keeper.Keeper) simulation.Operatio
 			return simulation.NoOpMsg(types.ModuleName), nil, nil
 		}
 		bond, found := k.GetBond(ctx, token)
-		if !found || bond.AllowSells == types.FALSE || bond.CurrentSupply.IsZero() {
+		if !found || bond.AllowSells == types.FALSE || bond.CurrentSupply.IsZero() ||
+			bond.IsDissolved() || bond.IsPaused() || bond.IsHalted() ||
+			k.BatchIsFull(ctx, token) {
 			return simulation.NoOpMsg(types.ModuleName), nil, nil
 		}
 
@@ -465,7 +1590,12 @@ func SimulateMsgSwap(ak auth.AccountKeeper, k keeper.Keeper) simulation.Operatio
 		// Get swapper function bonds with some reserve
 		var filteredBonds []string
 		for _, sbToken := range swapperBonds {
-			if !k.GetReserveBalances(ctx, sbToken).IsZero() {
+			if !k.BondExists(ctx, sbToken) {
+				continue // bond might have been closed
+			} else if sb := k.MustGetBond(ctx, sbToken); sb.IsPaused() || sb.IsHalted() {
+				continue
+			}
+			if !k.GetReserveBalances(ctx, sbToken).IsZero() && !k.BatchIsFull(ctx, sbToken) {
 				filteredBonds = append(filteredBonds, sbToken)
 			}
 		}
@@ -0,0 +1,66 @@
+package simulation
+
+import (
+	"fmt"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/ixoworld/bonds/x/bonds/internal/types"
+	"math/rand"
+)
+
+const (
+	keyMaxTxFeePercentage     = "MaxTxFeePercentage"
+	keyMaxExitFeePercentage   = "MaxExitFeePercentage"
+	keyMinBatchBlocks         = "MinBatchBlocks"
+	keyMaxBatchBlocks         = "MaxBatchBlocks"
+	keyMaxOrdersPerBatch      = "MaxOrdersPerBatch"
+	keyCandleIntervalBlocks   = "CandleIntervalBlocks"
+	keyMaxCandles             = "MaxCandles"
+	keyPriceObservationBlocks = "PriceObservationBlocks"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+// on the simulation
+func ParamChanges(r *rand.Rand) []simulation.ParamChange {
+	return []simulation.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, keyMaxTxFeePercentage,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenMaxTxFeePercentage(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, keyMaxExitFeePercentage,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenMaxExitFeePercentage(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, keyMinBatchBlocks,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenMinBatchBlocks(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, keyMaxBatchBlocks,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenMaxBatchBlocks(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, keyMaxOrdersPerBatch,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenMaxOrdersPerBatch(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, keyCandleIntervalBlocks,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenCandleIntervalBlocks(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, keyMaxCandles,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenMaxCandles(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, keyPriceObservationBlocks,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenPriceObservationBlocks(r))
+			},
+		),
+	}
+}
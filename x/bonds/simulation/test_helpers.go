@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 	"github.com/ixoworld/bonds/x/bonds/internal/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
 	"math/rand"
 	"strconv"
 )
@@ -95,3 +96,157 @@ func getDummyNonZeroReserve(reserveTokens []string) (reserve sdk.Coins) {
 	}
 	return reserve
 }
+
+func getRandomFeePercentages(r *rand.Rand, params types.Params) (txFee, exitFee sdk.Dec) {
+	// Max fee is 100, so exit fee uses 100-txFee as max if it is lower
+	txFee = simulation.RandomDecAmount(r, params.MaxTxFeePercentage)
+	maxExitFee := sdk.MinDec(params.MaxExitFeePercentage, sdk.NewDec(100).Sub(txFee))
+	exitFee = simulation.RandomDecAmount(r, maxExitFee)
+	return txFee, exitFee
+}
+
+func getRandomBatchBlocks(r *rand.Rand, params types.Params) sdk.Uint {
+	minBatchBlocks := int(params.MinBatchBlocks.Uint64())
+	maxBatchBlocks := int(params.MaxBatchBlocks.Uint64())
+	return sdk.NewUint(uint64(
+		simulation.RandIntBetween(r, minBatchBlocks, maxBatchBlocks+1)))
+}
+
+func getRandomCircuitBreakerValues(r *rand.Rand) (maxPriceMove sdk.Dec, mode string, cooldown sdk.Uint) {
+	maxPriceMove = simulation.RandomDecAmount(r, sdk.NewDec(50))
+	if simulation.RandIntBetween(r, 0, 2) == 0 {
+		return maxPriceMove, types.CircuitBreakerCancel, sdk.ZeroUint()
+	}
+	cooldown = sdk.NewUint(uint64(simulation.RandIntBetween(r, 1, 11)))
+	return maxPriceMove, types.CircuitBreakerHalt, cooldown
+}
+
+func getRandomRoleValues(r *rand.Rand, accs []simulation.Account, creator sdk.AccAddress) (
+	role string, addresses []sdk.AccAddress, threshold sdk.Uint) {
+	nonAdminRoles := []string{types.RoleMetadataEditor,
+		types.RoleFeeManager, types.RolePauser, types.RoleWithdrawer, types.RoleStaker}
+	role = nonAdminRoles[simulation.RandIntBetween(r, 0, len(nonAdminRoles))]
+
+	addresses = []sdk.AccAddress{creator}
+	other, _ := simulation.RandomAcc(r, accs)
+	if !other.Address.Equals(creator) {
+		addresses = append(addresses, other.Address)
+	}
+	return role, addresses, sdk.OneUint()
+}
+
+func getRandomFeeRecipients(r *rand.Rand, feeAddress sdk.AccAddress) (feeRecipients types.FeeRecipients) {
+	// Split 100 into between 1 and 3 positive whole-number shares, with the
+	// first share going to the fee address and the rest to new addresses
+	numberOfRecipients := simulation.RandIntBetween(r, 1, 4)
+	remainingShare := 100
+	for i := 0; i < numberOfRecipients; i++ {
+		share := remainingShare
+		if i < numberOfRecipients-1 {
+			share = simulation.RandIntBetween(r, 1, remainingShare-(numberOfRecipients-i-1)+1)
+		}
+		remainingShare -= share
+
+		address := feeAddress
+		if i > 0 {
+			address = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+		}
+		feeRecipients = append(feeRecipients,
+			types.NewFeeRecipient(address, sdk.NewDec(int64(share))))
+	}
+	return feeRecipients
+}
+
+func getRandomHolderRewardsPercentage(r *rand.Rand) sdk.Dec {
+	// Between 1 and 100 percent, with up to two decimal places
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 100, 10001)), 2)
+}
+
+func getRandomLiquidityFeePercentage(r *rand.Rand) sdk.Dec {
+	// Between 1 and 100 percent, with up to two decimal places
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 100, 10001)), 2)
+}
+
+func getRandomReferralFeePercentage(r *rand.Rand) sdk.Dec {
+	// Between 1 and 100 percent, with up to two decimal places
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 100, 10001)), 2)
+}
+
+func getRandomReferrer(r *rand.Rand) sdk.AccAddress {
+	// Half of the time, buys are referred by a new address
+	if simulation.RandIntBetween(r, 0, 2) == 0 {
+		return nil
+	}
+	return sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+}
+
+func getRandomHoldingPeriodExitFee(r *rand.Rand, exitFeePercentage sdk.Dec) (sdk.Dec, sdk.Uint) {
+	// Min exit fee between 0 and 100 percent of the exit fee, decreasing
+	// over a holding period of between 1 and 50 blocks
+	minExitFeePercentage := exitFeePercentage.MulInt64(
+		int64(simulation.RandIntBetween(r, 0, 101))).QuoInt64(100)
+	holdingPeriod := sdk.NewUint(uint64(simulation.RandIntBetween(r, 1, 51)))
+	return minExitFeePercentage, holdingPeriod
+}
+
+func getRandomFeeSchedule(r *rand.Rand) types.FeeSchedule {
+	// Between 0 and 2 size tiers that discount fees for larger orders, and
+	// between 0 and 2 volatility tiers that increase fees by up to double
+	var sizeTiers, volatilityTiers types.FeeTiers
+	threshold := 0
+	multiplier := 100
+	numberOfSizeTiers := simulation.RandIntBetween(r, 0, 3)
+	for i := 0; i < numberOfSizeTiers; i++ {
+		threshold += simulation.RandIntBetween(r, 1, 1000)
+		multiplier = simulation.RandIntBetween(r, 0, multiplier+1)
+		sizeTiers = append(sizeTiers, types.NewFeeTier(
+			sdk.NewInt(int64(threshold)).ToDec(), sdk.NewDecWithPrec(int64(multiplier), 2)))
+	}
+	threshold = 0
+	multiplier = 100
+	numberOfVolatilityTiers := simulation.RandIntBetween(r, 0, 3)
+	for i := 0; i < numberOfVolatilityTiers; i++ {
+		threshold += simulation.RandIntBetween(r, 1, 20)
+		multiplier = simulation.RandIntBetween(r, multiplier, 201)
+		volatilityTiers = append(volatilityTiers, types.NewFeeTier(
+			sdk.NewInt(int64(threshold)).ToDec(), sdk.NewDecWithPrec(int64(multiplier), 2)))
+	}
+
+	volatilityBatches := sdk.ZeroUint()
+	if len(volatilityTiers) != 0 {
+		volatilityBatches = sdk.NewUint(uint64(simulation.RandIntBetween(
+			r, types.MinVolatilityBatches, 11)))
+	}
+	return types.NewFeeSchedule(sizeTiers, volatilityTiers, volatilityBatches)
+}
+
+func getRandomTapValues(r *rand.Rand, accs []simulation.Account, minFloorPercentage sdk.Dec) (
+	beneficiary sdk.AccAddress, rate sdk.Int, floorPercentage sdk.Dec) {
+	// Rate of between 0 and 1000 per block, with a floor of between the
+	// minimum floor percentage and 100 percent
+	account, _ := simulation.RandomAcc(r, accs)
+	rate = sdk.NewInt(int64(simulation.RandIntBetween(r, 0, 1001)))
+	floorPercentage = minFloorPercentage
+	if maxIncrease := sdk.NewDec(100).Sub(minFloorPercentage); maxIncrease.IsPositive() {
+		floorPercentage = floorPercentage.Add(simulation.RandomDecAmount(r, maxIncrease))
+	}
+	return account.Address, rate, floorPercentage
+}
+
+func getRandomTapVoteOption(r *rand.Rand) string {
+	// Votes to dissolve are less likely, since they end the bond's trading
+	if simulation.RandIntBetween(r, 0, 10) == 0 {
+		return types.TapVoteDissolve
+	}
+	return types.TapVoteRaise
+}
+
+func getRandomReserveStakingValues(r *rand.Rand) (capPercentage sdk.Dec, rewardsToReserve string) {
+	// Cap of between 0 and 100 percent of the reserve
+	capPercentage = simulation.RandomDecAmount(r, sdk.NewDec(100))
+	rewardsToReserve = types.FALSE
+	if simulation.RandIntBetween(r, 0, 2) == 0 {
+		rewardsToReserve = types.TRUE
+	}
+	return capPercentage, rewardsToReserve
+}